@@ -6,6 +6,7 @@ package zip
 
 import (
 	"bufio"
+	"compress/flate"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -182,6 +183,57 @@ func (f *File) Open() (io.ReadCloser, error) {
 	return rc, nil
 }
 
+// BuildIndex reads the File's contents, which must be compressed with
+// the Deflate method, and returns an index of them for OpenReaderAt. The
+// access points are about span bytes apart in the contents; a span of
+// zero or less selects 1 MB. The index may be saved with its
+// MarshalBinary method and reused while the archive is unchanged.
+func (f *File) BuildIndex(span int64) (*flate.Index, error) {
+	if f.Method != Deflate {
+		return nil, ErrAlgorithm
+	}
+	bodyOffset, err := f.findBodyOffset()
+	if err != nil {
+		return nil, err
+	}
+	r := io.NewSectionReader(f.zipr, f.headerOffset+bodyOffset, int64(f.CompressedSize64))
+	x := new(flate.Index)
+	hash := crc32.NewIEEE()
+	if _, err := x.AddStream(bufio.NewReader(r), 0, span, hash); err != nil {
+		return nil, err
+	}
+	if uint64(x.Size) != f.UncompressedSize64 || f.CRC32 != 0 && hash.Sum32() != f.CRC32 {
+		return nil, ErrChecksum
+	}
+	return x, nil
+}
+
+// OpenReaderAt returns a SectionReader of the File's contents, which
+// can read them at any offset and may be used concurrently. Stored files
+// need no index, and x is ignored. For files compressed with the Deflate
+// method, x is an index made by BuildIndex; if it is nil, OpenReaderAt
+// builds one. Other methods are not supported. Unlike Open, the contents
+// are not verified against the checksum.
+func (f *File) OpenReaderAt(x *flate.Index) (*io.SectionReader, error) {
+	bodyOffset, err := f.findBodyOffset()
+	if err != nil {
+		return nil, err
+	}
+	r := io.NewSectionReader(f.zipr, f.headerOffset+bodyOffset, int64(f.CompressedSize64))
+	switch f.Method {
+	case Store:
+		return r, nil
+	case Deflate:
+		if x == nil {
+			if x, err = f.BuildIndex(0); err != nil {
+				return nil, err
+			}
+		}
+		return flate.NewReaderAt(r, x), nil
+	}
+	return nil, ErrAlgorithm
+}
+
 type checksumReader struct {
 	rc    io.ReadCloser
 	hash  hash.Hash32
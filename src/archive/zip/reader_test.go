@@ -1052,3 +1052,63 @@ func TestIssue12449(t *testing.T) {
 		t.Errorf("Error reading the archive: %v", err)
 	}
 }
+
+func TestOpenReaderAt(t *testing.T) {
+	data, err := ioutil.ReadFile("../../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, method := range []uint16{Deflate, Store} {
+		fw, err := w.CreateHeader(&FileHeader{Name: "file", Method: method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write(data)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, err := zr.File[0].BuildIndex(64 << 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := zr.File[1].BuildIndex(0); err != ErrAlgorithm {
+		t.Errorf("BuildIndex of stored file: got %v, want %v", err, ErrAlgorithm)
+	}
+	for i, f := range zr.File {
+		r, err := f.OpenReaderAt(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Size() != int64(len(data)) {
+			t.Errorf("file %d: Size() = %d, want %d", i, r.Size(), len(data))
+		}
+		for _, off := range []int{0, 100000, 300000, len(data) - 10} {
+			p := make([]byte, 40000)
+			n, err := r.ReadAt(p, int64(off))
+			want := data[off:]
+			if len(want) > len(p) {
+				want = want[:len(p)]
+			}
+			if !bytes.Equal(p[:n], want) || n < len(p) && err != io.EOF {
+				t.Errorf("file %d: ReadAt at %d = %d, %v; data differs", i, off, n, err)
+			}
+		}
+	}
+
+	// Without an index, one is built.
+	r, err := zr.File[0].OpenReaderAt(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ioutil.ReadAll(r); err != nil || !bytes.Equal(got, data) {
+		t.Errorf("ReadAll = %d bytes, %v; want %d bytes", len(got), err, len(data))
+	}
+}
@@ -27,8 +27,9 @@ import (
 
 // Compression methods.
 const (
-	Store   uint16 = 0 // no compression
-	Deflate uint16 = 8 // DEFLATE compressed
+	Store   uint16 = 0  // no compression
+	Deflate uint16 = 8  // DEFLATE compressed
+	Zstd    uint16 = 93 // Zstandard compressed
 )
 
 const (
@@ -56,6 +57,7 @@ const (
 	// Version numbers.
 	zipVersion20 = 20 // 2.0
 	zipVersion45 = 45 // 4.5 (reads and writes zip64 archives)
+	zipVersion63 = 63 // 6.3 (reads Zstandard compressed files)
 
 	// Limits for non zip64 files.
 	uint16max = (1 << 16) - 1
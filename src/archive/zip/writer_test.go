@@ -57,6 +57,12 @@ var writeTests = []WriteTest{
 		Method: Deflate,
 		Mode:   0755 | os.ModeSymlink,
 	},
+	{
+		Name:   "zstd",
+		Data:   []byte("Rabbits, guinea pigs, gophers, marsupial rats, and quolls. Rabbits, guinea pigs, gophers."),
+		Method: Zstd,
+		Mode:   0644,
+	},
 }
 
 func TestWriter(t *testing.T) {
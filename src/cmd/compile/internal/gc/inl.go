@@ -45,6 +45,11 @@ const (
 
 	inlineBigFunctionNodes   = 5000 // Functions with this many nodes are considered "big".
 	inlineBigFunctionMaxCost = 20   // Max cost of inlinee when inlining into a "big" function.
+
+	// inlineHotMaxBudget is the budget for functions that a -pgoprofile
+	// profile shows are called from hot call sites. Such functions are
+	// only inlined at the hot call sites themselves.
+	inlineHotMaxBudget = 2000
 )
 
 // Get the function's package. For ordinary functions it's on the ->sym, but for imported methods
@@ -188,8 +193,13 @@ func caninl(fn *Node) {
 	// locals, and we use this map to produce a pruned Inline.Dcl
 	// list. See issue 25249 for more context.
 
+	budget := int32(inlineMaxBudget)
+	if pgoProfile != nil && pgoProfile.IsHotCallee(pgoFuncName(n)) {
+		budget = inlineHotMaxBudget
+	}
+
 	visitor := hairyVisitor{
-		budget:        inlineMaxBudget,
+		budget:        budget,
 		extraCallCost: cc,
 		usedLocals:    make(map[*Node]bool),
 	}
@@ -198,12 +208,12 @@ func caninl(fn *Node) {
 		return
 	}
 	if visitor.budget < 0 {
-		reason = fmt.Sprintf("function too complex: cost %d exceeds budget %d", inlineMaxBudget-visitor.budget, inlineMaxBudget)
+		reason = fmt.Sprintf("function too complex: cost %d exceeds budget %d", budget-visitor.budget, budget)
 		return
 	}
 
 	n.Func.Inl = &Inline{
-		Cost: inlineMaxBudget - visitor.budget,
+		Cost: budget - visitor.budget,
 		Dcl:  inlcopylist(pruneUnusedAutos(n.Name.Defn.Func.Dcl, &visitor)),
 		Body: inlcopylist(fn.Nbody.Slice()),
 	}
@@ -824,7 +834,13 @@ func mkinlcall(n, fn *Node, maxCost int32) *Node {
 	if fn.Func.Inl.Cost > maxCost {
 		// The inlined function body is too big. Typically we use this check to restrict
 		// inlining into very big functions.  See issue 26546 and 17566.
-		return n
+		// Calls that the profile shows are hot get the larger budget.
+		if fn.Func.Inl.Cost > inlineHotMaxBudget || !isHotCallSite(n, fn) {
+			return n
+		}
+		if Debug['m'] > 1 {
+			fmt.Printf("%v: inlining hot call to %v with cost %d\n", n.Line(), fn, fn.Func.Inl.Cost)
+		}
 	}
 
 	if fn == Curfn || fn.Name.Defn == Curfn {
@@ -223,6 +223,7 @@ func Main(archInit func(*Arch)) {
 	flag.StringVar(&outfile, "o", "", "write output to `file`")
 	flag.StringVar(&myimportpath, "p", "", "set expected package import `path`")
 	flag.BoolVar(&writearchive, "pack", false, "write to file.a instead of file.o")
+	flag.StringVar(&pgoprofile, "pgoprofile", "", "read profile from `file` for profile-guided optimization")
 	objabi.Flagcount("r", "debug generated wrappers", &Debug['r'])
 	if sys.RaceDetectorSupported(objabi.GOOS, objabi.GOARCH) {
 		flag.BoolVar(&flag_race, "race", false, "enable race detector")
@@ -598,6 +599,19 @@ func Main(archInit func(*Arch)) {
 		}
 	}
 
+	if pgoprofile != "" {
+		readPGOProfile()
+		if Debug['l'] != 0 {
+			// Devirtualize hot interface calls so that
+			// the resulting direct calls can be inlined.
+			for _, n := range xtop {
+				if n.Op == ODCLFUNC {
+					devirtualize(n)
+				}
+			}
+		}
+	}
+
 	if Debug['l'] != 0 {
 		// Find functions that can be inlined and clone them before walk expands them.
 		visitBottomUp(xtop, func(list []*Node, recursive bool) {
@@ -0,0 +1,267 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Profile-guided optimization.
+//
+// When compiling with -pgoprofile, the compiler reads a CPU profile
+// of the program being built and uses it in two ways. Functions that
+// are called from hot call sites get a larger inlining budget, and are
+// inlined at those call sites (see caninl and mkinlcall). Interface
+// method calls whose hot callees are dominated by a single concrete
+// method are devirtualized: the call is guarded by a type assertion to
+// the concrete type, so that the common case is a direct call that can
+// in turn be inlined.
+
+package gc
+
+import (
+	"cmd/compile/internal/pgo"
+	"cmd/compile/internal/types"
+	"cmd/internal/objabi"
+	"fmt"
+	"strings"
+)
+
+var (
+	pgoprofile string       // -pgoprofile flag
+	pgoProfile *pgo.Profile // profile read from pgoprofile, or nil
+)
+
+// readPGOProfile reads the profile named by the -pgoprofile flag.
+func readPGOProfile() {
+	p, err := pgo.ReadFile(pgoprofile)
+	if err != nil {
+		Fatalf("reading profile: %v", err)
+	}
+	pgoProfile = p
+}
+
+// pgoFuncName returns the linker symbol name of the function
+// or method named by n, as it appears in profiles.
+func pgoFuncName(n *Node) string {
+	pkg := fnpkg(n)
+	path := pkg.Path
+	if pkg == localpkg {
+		path = myimportpath
+	}
+	return objabi.PathToPrefix(path) + "." + n.Sym.Name
+}
+
+// isHotCallSite reports whether the profile shows the call n
+// to fn from the current function as hot.
+func isHotCallSite(n, fn *Node) bool {
+	if pgoProfile == nil || Curfn == nil || Curfn.Func.Nname == nil {
+		return false
+	}
+	return pgoProfile.IsHotCallSite(pgoFuncName(Curfn.Func.Nname), pgoFuncName(fn), int(n.Pos.Line()), int(Curfn.Pos.Line()))
+}
+
+// devirtualize rewrites hot interface method calls in fn
+// whose dominant callee is a single concrete method.
+func devirtualize(fn *Node) {
+	savefn, lno := Curfn, lineno
+	Curfn = fn
+	devirtualizeList(fn.Nbody)
+	Curfn, lineno = savefn, lno
+}
+
+func devirtualizeList(l Nodes) {
+	s := l.Slice()
+	for i, n := range s {
+		if n == nil {
+			continue
+		}
+		devirtualizeList(n.Ninit)
+		devirtualizeList(n.Nbody)
+		switch n.Op {
+		case OIF:
+			devirtualizeList(n.Rlist)
+		case OBLOCK:
+			devirtualizeList(n.List)
+		case OSWITCH, OTYPESW, OSELECT:
+			for _, c := range n.List.Slice() {
+				devirtualizeList(c.Nbody)
+			}
+		}
+		s[i] = devirtualizeStmt(n)
+	}
+}
+
+// devirtualizeStmt returns the rewritten form of the statement n
+// if it contains a hot interface method call, or else n itself.
+// The statement forms recognized are
+//
+//	x.M(args)
+//	v = x.M(args)
+//	v1, v2, ... = x.M(args)
+//
+// where the left-hand sides are simple variables. The rewritten
+// form evaluates x and args into temporaries and then calls
+//
+//	if t, ok := x.(T); ok {
+//		... t.M(args) ...
+//	} else {
+//		... x.M(args) ...
+//	}
+func devirtualizeStmt(n *Node) *Node {
+	var call *Node
+	switch n.Op {
+	case OCALLINTER:
+		call = n
+	case OAS:
+		if n.Left != nil && isSimpleLHS(n.Left) && n.Right != nil && n.Right.Op == OCALLINTER {
+			call = n.Right
+		}
+	case OAS2FUNC:
+		for _, l := range n.List.Slice() {
+			if !isSimpleLHS(l) {
+				return n
+			}
+		}
+		if n.Rlist.First().Op == OCALLINTER {
+			call = n.Rlist.First()
+		}
+	}
+	if call == nil {
+		return n
+	}
+	if call.List.Len() == 1 && call.List.First().Type.IsFuncArgStruct() {
+		// f(g()) with multiple results; leave alone.
+		return n
+	}
+
+	sel := call.Left // ODOTINTER
+	recv := sel.Left
+	typ := pgoConcreteType(call, recv.Type, sel.Sym)
+	if typ == nil {
+		return n
+	}
+	if Debug['m'] != 0 {
+		fmt.Printf("%v: PGO devirtualizing %v to %v\n", call.Line(), sel, typ)
+	}
+
+	lineno = call.Pos
+	var init []*Node
+	init = append(init, n.Ninit.Slice()...)
+	n.Ninit.Set(nil)
+
+	// Evaluate the receiver and arguments once, in order.
+	x := temp(recv.Type)
+	init = append(init, typecheck(nod(OAS, x, recv), ctxStmt))
+	sel.Left = x
+	args := call.List.Slice()
+	for i, a := range args {
+		t := temp(a.Type)
+		init = append(init, typecheck(nod(OAS, t, a), ctxStmt))
+		args[i] = t
+	}
+
+	// t, ok := x.(T)
+	tmp := temp(typ)
+	ok := temp(types.Types[TBOOL])
+	as := nod(OAS2, nil, nil)
+	as.List.Set2(tmp, ok)
+	as.Rlist.Set1(nod(ODOTTYPE, x, typenod(typ)))
+	init = append(init, typecheck(as, ctxStmt))
+
+	direct := nod(OCALL, nodSym(OXDOT, tmp, sel.Sym), nil)
+	direct.List.Set(args)
+	direct.SetIsDDD(call.IsDDD())
+
+	var then *Node
+	switch n.Op {
+	case OCALLINTER:
+		then = direct
+	case OAS:
+		then = nod(OAS, n.Left, direct)
+	case OAS2FUNC:
+		then = nod(OAS2, nil, nil)
+		then.List.Set(n.List.Slice())
+		then.Rlist.Set1(direct)
+	}
+
+	iff := nod(OIF, ok, nil)
+	iff.Ninit.Set(init)
+	iff.Nbody.Set1(then)
+	iff.Rlist.Set1(n)
+	return typecheck(iff, ctxStmt)
+}
+
+// isSimpleLHS reports whether n is a variable or the blank identifier.
+func isSimpleLHS(n *Node) bool {
+	return n.Op == ONAME
+}
+
+// pgoConcreteType returns the concrete type to which the interface
+// method call, on a receiver of interface type iface calling method
+// msym, should be devirtualized, or nil if there is none.
+func pgoConcreteType(call *Node, iface *types.Type, msym *types.Sym) *types.Type {
+	if pgoProfile == nil || Curfn.Func.Nname == nil {
+		return nil
+	}
+	edges := pgoProfile.HotCallees(pgoFuncName(Curfn.Func.Nname), int(call.Pos.Line()), int(Curfn.Pos.Line()))
+	if len(edges) == 0 {
+		return nil
+	}
+	prefix, tname, ptr, method := splitMethodName(edges[0].Callee)
+	if method != msym.Name {
+		return nil
+	}
+
+	var pkg *types.Pkg
+	if prefix == objabi.PathToPrefix(myimportpath) {
+		pkg = localpkg
+	} else {
+		for _, p := range types.ImportedPkgList() {
+			if p.Prefix == prefix {
+				pkg = p
+				break
+			}
+		}
+	}
+	if pkg == nil {
+		return nil
+	}
+	s, existed := pkg.LookupOK(tname)
+	if !existed {
+		return nil
+	}
+	d := resolve(asNode(s.Def))
+	if d == nil || d.Op != OTYPE || d.Type == nil || d.Type.IsInterface() {
+		return nil
+	}
+	typ := d.Type
+	if ptr {
+		typ = types.NewPtr(typ)
+	}
+	var missing, have *types.Field
+	var ptrRecv int
+	if !implements(typ, iface, &missing, &have, &ptrRecv) {
+		return nil
+	}
+	return typ
+}
+
+// splitMethodName splits a method symbol name of the form
+// "path.(*T).M" or "path.T.M" into its parts.
+// It returns method == "" if name is not a method.
+func splitMethodName(name string) (prefix, typ string, ptr bool, method string) {
+	if i := strings.Index(name, ".(*"); i >= 0 {
+		rest := name[i+len(".(*"):]
+		j := strings.Index(rest, ").")
+		if j < 0 {
+			return "", "", false, ""
+		}
+		return name[:i], rest[:j], true, rest[j+len(")."):]
+	}
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "", "", false, ""
+	}
+	j := strings.LastIndex(name[:i], ".")
+	if j < 0 || strings.Contains(name[j:], "/") {
+		return "", "", false, ""
+	}
+	return name[:j], name[j+1 : i], false, name[i+1:]
+}
@@ -0,0 +1,31 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gc
+
+import "testing"
+
+func TestSplitMethodName(t *testing.T) {
+	tests := []struct {
+		name                string
+		prefix, typ, method string
+		ptr                 bool
+	}{
+		{"main.(*T).M", "main", "T", "M", true},
+		{"main.T.M", "main", "T", "M", false},
+		{"example.com/a/b.(*Reader).Read", "example.com/a/b", "Reader", "Read", true},
+		{"example.com/a/b.Reader.Read", "example.com/a/b", "Reader", "Read", false},
+		{"example.com/a%2eb.T.M", "example.com/a%2eb", "T", "M", false},
+		{"main.F", "", "", "", false},
+		{"example.com/a.F", "", "", "", false},
+		{"F", "", "", "", false},
+	}
+	for _, tt := range tests {
+		prefix, typ, ptr, method := splitMethodName(tt.name)
+		if prefix != tt.prefix || typ != tt.typ || ptr != tt.ptr || method != tt.method {
+			t.Errorf("splitMethodName(%q) = %q, %q, %v, %q, want %q, %q, %v, %q",
+				tt.name, prefix, typ, ptr, method, tt.prefix, tt.typ, tt.ptr, tt.method)
+		}
+	}
+}
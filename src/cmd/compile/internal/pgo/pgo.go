@@ -0,0 +1,276 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pgo reads CPU profiles in the pprof format and summarizes
+// them as a weighted call graph for profile-guided optimization.
+//
+// Each edge of the graph records a call from a caller function to a
+// callee function at a particular call site, weighted by the sample
+// value (typically the CPU time) attributed to stacks containing that
+// call. Call sites are identified by the line of the call relative to
+// the first line of the caller, so that profiles remain applicable
+// when code above the caller moves.
+package pgo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+)
+
+// HotCDFThreshold is the fraction of the total edge weight, counted
+// from the heaviest edge down, that is considered hot.
+const HotCDFThreshold = 0.99
+
+// An Edge is a weighted call graph edge.
+type Edge struct {
+	Caller string // linker symbol name of the calling function
+	Callee string // linker symbol name of the called function
+	Line   int    // call line, relative to the start of Caller if known
+	Weight int64
+}
+
+type callSite struct {
+	caller string
+	line   int
+}
+
+type edgeKey struct {
+	callSite
+	callee string
+}
+
+// A Profile is a weighted call graph read from a CPU profile.
+type Profile struct {
+	// TotalWeight is the sum of the weights of all edges.
+	TotalWeight int64
+
+	edges     map[edgeKey]*Edge
+	sites     map[callSite][]*Edge // sorted by decreasing weight
+	startLine map[string]int       // start line of functions, if recorded
+	hot       map[string]bool      // callees of hot edges
+	hotWeight int64                // minimum weight of a hot edge
+}
+
+// ReadFile reads the profile in the named file.
+func ReadFile(name string) (*Profile, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return p, nil
+}
+
+// Read reads a profile in the pprof protocol buffer format,
+// which may be gzip-compressed.
+func Read(r io.Reader) (*Profile, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = ioutil.ReadAll(zr); err != nil {
+			return nil, err
+		}
+	}
+	raw, err := decodeProfile(data)
+	if err != nil {
+		return nil, err
+	}
+	return newProfile(raw)
+}
+
+func newProfile(raw *rawProfile) (*Profile, error) {
+	str := func(i int64) string {
+		if i < 0 || i >= int64(len(raw.strings)) {
+			return ""
+		}
+		return raw.strings[i]
+	}
+
+	// Find the sample value to use: the sample count if present,
+	// otherwise the CPU time.
+	index := -1
+	for i, vt := range raw.sampleType {
+		typ, unit := str(vt.typ), str(vt.unit)
+		if typ == "samples" && unit == "count" {
+			index = i
+			break
+		}
+		if typ == "cpu" && unit == "nanoseconds" && index < 0 {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("profile has no samples/count or cpu/nanoseconds sample type")
+	}
+
+	p := &Profile{
+		edges:     make(map[edgeKey]*Edge),
+		sites:     make(map[callSite][]*Edge),
+		startLine: make(map[string]int),
+		hot:       make(map[string]bool),
+	}
+
+	funcs := make(map[uint64]rawFunction)
+	for _, f := range raw.function {
+		funcs[f.id] = f
+		if f.startLine != 0 {
+			p.startLine[str(f.name)] = int(f.startLine)
+		}
+	}
+	locs := make(map[uint64]rawLocation)
+	for _, loc := range raw.location {
+		locs[loc.id] = loc
+	}
+
+	type frame struct {
+		name string
+		line int // absolute line within name
+	}
+	var stack []frame
+	for _, s := range raw.sample {
+		if index >= len(s.value) || s.value[index] <= 0 {
+			continue
+		}
+		w := s.value[index]
+
+		// Expand the sample's locations into frames, leaf first.
+		// Within a location, inlined calls are listed innermost first.
+		stack = stack[:0]
+		for _, id := range s.locationID {
+			loc, ok := locs[id]
+			if !ok {
+				return nil, fmt.Errorf("sample refers to missing location %d", id)
+			}
+			for _, l := range loc.line {
+				f, ok := funcs[l.functionID]
+				if !ok {
+					return nil, fmt.Errorf("location %d refers to missing function %d", id, l.functionID)
+				}
+				stack = append(stack, frame{str(f.name), int(l.line)})
+			}
+		}
+
+		// Each adjacent pair of frames is a call from the outer frame,
+		// at its line, to the inner frame. Count each edge once per
+		// sample even if recursion repeats it.
+		seen := make(map[edgeKey]bool)
+		for i := 1; i < len(stack); i++ {
+			caller, callee := stack[i], stack[i-1]
+			k := edgeKey{callSite{caller.name, p.relLine(caller.name, caller.line)}, callee.name}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			e := p.edges[k]
+			if e == nil {
+				e = &Edge{Caller: k.caller, Callee: k.callee, Line: k.line}
+				p.edges[k] = e
+				p.sites[k.callSite] = append(p.sites[k.callSite], e)
+			}
+			e.Weight += w
+			p.TotalWeight += w
+		}
+	}
+
+	var all []*Edge
+	for _, list := range p.sites {
+		sort.Sort(byWeight(list))
+		all = append(all, list...)
+	}
+	sort.Sort(byWeight(all))
+
+	// The hot edges are the heaviest edges that together account
+	// for HotCDFThreshold of the total weight.
+	var cum int64
+	for _, e := range all {
+		cum += e.Weight
+		if float64(cum) >= HotCDFThreshold*float64(p.TotalWeight) {
+			p.hotWeight = e.Weight
+			break
+		}
+	}
+	if p.hotWeight == 0 {
+		p.hotWeight = 1
+	}
+	for _, e := range all {
+		if e.Weight < p.hotWeight {
+			break
+		}
+		p.hot[e.Callee] = true
+	}
+	return p, nil
+}
+
+// relLine returns line relative to the start of fn,
+// or line itself if fn's start line is not known.
+func (p *Profile) relLine(fn string, line int) int {
+	if start, ok := p.startLine[fn]; ok {
+		return line - start
+	}
+	return line
+}
+
+// site returns the call site in caller at the given line,
+// where funcLine is the first line of caller.
+func (p *Profile) site(caller string, line, funcLine int) callSite {
+	if _, ok := p.startLine[caller]; ok {
+		line -= funcLine
+	}
+	return callSite{caller, line}
+}
+
+// IsHotCallee reports whether name is the callee of any hot edge.
+func (p *Profile) IsHotCallee(name string) bool {
+	return p.hot[name]
+}
+
+// IsHotCallSite reports whether the call from caller to callee at the
+// given line is hot. funcLine is the first line of caller.
+func (p *Profile) IsHotCallSite(caller, callee string, line, funcLine int) bool {
+	e := p.edges[edgeKey{p.site(caller, line, funcLine), callee}]
+	return e != nil && e.Weight >= p.hotWeight
+}
+
+// HotCallees returns the hot edges of the call site in caller at
+// the given line, heaviest first. funcLine is the first line of caller.
+func (p *Profile) HotCallees(caller string, line, funcLine int) []*Edge {
+	list := p.sites[p.site(caller, line, funcLine)]
+	n := 0
+	for n < len(list) && list[n].Weight >= p.hotWeight {
+		n++
+	}
+	return list[:n]
+}
+
+type byWeight []*Edge
+
+func (x byWeight) Len() int      { return len(x) }
+func (x byWeight) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+func (x byWeight) Less(i, j int) bool {
+	if x[i].Weight != x[j].Weight {
+		return x[i].Weight > x[j].Weight
+	}
+	if x[i].Caller != x[j].Caller {
+		return x[i].Caller < x[j].Caller
+	}
+	if x[i].Line != x[j].Line {
+		return x[i].Line < x[j].Line
+	}
+	return x[i].Callee < x[j].Callee
+}
@@ -0,0 +1,149 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pgo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+)
+
+// An encoder builds protocol buffer messages for tests.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) varint(u uint64) {
+	for u >= 0x80 {
+		e.buf = append(e.buf, byte(u)|0x80)
+		u >>= 7
+	}
+	e.buf = append(e.buf, byte(u))
+}
+
+func (e *encoder) uint64(num int, u uint64) {
+	e.varint(uint64(num)<<3 | wireVarint)
+	e.varint(u)
+}
+
+func (e *encoder) bytes(num int, b []byte) {
+	e.varint(uint64(num)<<3 | wireBytes)
+	e.varint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *encoder) packed(num int, list ...uint64) {
+	var p encoder
+	for _, u := range list {
+		p.varint(u)
+	}
+	e.bytes(num, p.buf)
+}
+
+func msg(f func(e *encoder)) []byte {
+	var e encoder
+	f(&e)
+	return e.buf
+}
+
+// testProfile returns an encoded profile in which main.main,
+// starting at line 10, calls main.hot at line 12 in 95 samples,
+// and main.cold at line 13 in 1 sample. main.hot calls
+// main.leaf, inlined, in 50 of its samples.
+func testProfile() []byte {
+	strs := []string{"", "samples", "count", "cpu", "nanoseconds", "main.main", "main.hot", "main.cold", "main.leaf"}
+	return msg(func(e *encoder) {
+		e.bytes(1, msg(func(e *encoder) { e.uint64(1, 1); e.uint64(2, 2) }))
+		e.bytes(1, msg(func(e *encoder) { e.uint64(1, 3); e.uint64(2, 4) }))
+
+		e.bytes(2, msg(func(e *encoder) { e.packed(1, 2, 1); e.packed(2, 45, 450) }))
+		e.bytes(2, msg(func(e *encoder) { e.packed(1, 4, 1); e.packed(2, 50, 500) }))
+		// Unpacked encoding.
+		e.bytes(2, msg(func(e *encoder) { e.uint64(1, 3); e.uint64(1, 1); e.uint64(2, 1); e.uint64(2, 10) }))
+
+		loc := func(id uint64, lines ...uint64) {
+			e.bytes(4, msg(func(e *encoder) {
+				e.uint64(1, id)
+				for i := 0; i < len(lines); i += 2 {
+					e.bytes(4, msg(func(e *encoder) { e.uint64(1, lines[i]); e.uint64(2, lines[i+1]) }))
+				}
+			}))
+		}
+		loc(1, 1, 12)        // main.main:12
+		loc(2, 2, 21)        // main.hot:21
+		loc(3, 3, 31)        // main.cold:31
+		loc(4, 4, 41, 2, 22) // main.leaf:41 inlined into main.hot:22
+
+		fn := func(id, name, start uint64) {
+			e.bytes(5, msg(func(e *encoder) { e.uint64(1, id); e.uint64(2, name); e.uint64(5, start) }))
+		}
+		fn(1, 5, 10)
+		fn(2, 6, 20)
+		fn(3, 7, 30)
+		fn(4, 8, 40)
+
+		for _, s := range strs {
+			e.bytes(6, []byte(s))
+		}
+	})
+}
+
+func TestRead(t *testing.T) {
+	data := testProfile()
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write(data)
+	zw.Close()
+
+	for _, in := range [][]byte{data, gz.Bytes()} {
+		p, err := Read(bytes.NewReader(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.TotalWeight != 146 {
+			t.Errorf("TotalWeight = %d, want 146", p.TotalWeight)
+		}
+		if !p.IsHotCallSite("main.main", "main.hot", 12, 10) {
+			t.Errorf("main.main -> main.hot at line 12 is not hot")
+		}
+		if p.IsHotCallSite("main.main", "main.hot", 13, 10) {
+			t.Errorf("main.main -> main.hot at line 13 is hot")
+		}
+		if !p.IsHotCallSite("main.hot", "main.leaf", 22, 20) {
+			t.Errorf("inlined main.hot -> main.leaf at line 22 is not hot")
+		}
+		// The call site is relative to the function start,
+		// so moving the whole function keeps it hot.
+		if !p.IsHotCallSite("main.main", "main.hot", 102, 100) {
+			t.Errorf("main.main -> main.hot at relative line 2 is not hot")
+		}
+		if p.IsHotCallSite("main.main", "main.cold", 13, 10) || p.IsHotCallee("main.cold") {
+			t.Errorf("main.cold is hot")
+		}
+		if !p.IsHotCallee("main.hot") || !p.IsHotCallee("main.leaf") {
+			t.Errorf("main.hot or main.leaf is not a hot callee")
+		}
+		edges := p.HotCallees("main.main", 12, 10)
+		if len(edges) != 1 || edges[0].Callee != "main.hot" || edges[0].Weight != 95 {
+			t.Errorf("HotCallees(main.main, 12) = %v, want main.hot with weight 95", edges)
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	data := testProfile()
+	if _, err := Read(bytes.NewReader(data[:len(data)-3])); err == nil {
+		t.Errorf("Read of truncated profile succeeded")
+	}
+	noTypes := msg(func(e *encoder) {
+		e.bytes(1, msg(func(e *encoder) { e.uint64(1, 1); e.uint64(2, 2) }))
+		e.bytes(6, nil)
+		e.bytes(6, []byte("alloc_space"))
+		e.bytes(6, []byte("bytes"))
+	})
+	if _, err := Read(bytes.NewReader(noTypes)); err == nil {
+		t.Errorf("Read of heap profile succeeded")
+	}
+}
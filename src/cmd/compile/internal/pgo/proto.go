@@ -0,0 +1,284 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements a minimal decoder for the parts of the
+// pprof profile.proto format used by profile-guided optimization.
+// See github.com/google/pprof/proto/profile.proto for the format.
+
+package pgo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Protocol buffer wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("truncated profile")
+
+// A decoder reads protocol buffer fields from a message.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+// next reads the next field of the message, reporting its number
+// and wire type. For wireVarint fields, the value is returned in u;
+// for wireBytes fields, the contents are returned in b.
+func (d *decoder) next() (num int, typ int, u uint64, b []byte, ok bool) {
+	if d.err != nil || len(d.data) == 0 {
+		return 0, 0, 0, nil, false
+	}
+	key := d.varint()
+	num, typ = int(key>>3), int(key&7)
+	switch typ {
+	case wireVarint:
+		u = d.varint()
+	case wireFixed64:
+		b = d.bytes(8)
+	case wireFixed32:
+		b = d.bytes(4)
+	case wireBytes:
+		n := d.varint()
+		if n > uint64(len(d.data)) {
+			d.err = errTruncated
+			break
+		}
+		b = d.bytes(int(n))
+	default:
+		d.err = fmt.Errorf("unknown wire type %d", typ)
+	}
+	if d.err != nil {
+		return 0, 0, 0, nil, false
+	}
+	return num, typ, u, b, true
+}
+
+func (d *decoder) varint() uint64 {
+	var u uint64
+	for i := 0; ; i++ {
+		if i >= 10 || i >= len(d.data) {
+			d.err = errTruncated
+			return 0
+		}
+		c := d.data[i]
+		u |= uint64(c&0x7f) << uint(7*i)
+		if c < 0x80 {
+			d.data = d.data[i+1:]
+			return u
+		}
+	}
+}
+
+func (d *decoder) bytes(n int) []byte {
+	if n > len(d.data) {
+		d.err = errTruncated
+		return nil
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+// uint64s appends to list the values of a repeated integer field,
+// which may be encoded either packed or as individual values.
+func (d *decoder) uint64s(list []uint64, typ int, u uint64, b []byte) []uint64 {
+	if typ == wireVarint {
+		return append(list, u)
+	}
+	if typ != wireBytes {
+		d.err = fmt.Errorf("bad wire type %d for repeated integer", typ)
+		return list
+	}
+	packed := decoder{data: b}
+	for len(packed.data) > 0 && packed.err == nil {
+		list = append(list, packed.varint())
+	}
+	if packed.err != nil {
+		d.err = packed.err
+	}
+	return list
+}
+
+// The decoded messages hold only the fields needed for optimization.
+
+type rawProfile struct {
+	sampleType []rawValueType
+	sample     []rawSample
+	location   []rawLocation
+	function   []rawFunction
+	strings    []string
+}
+
+type rawValueType struct {
+	typ, unit int64 // indexes into the string table
+}
+
+type rawSample struct {
+	locationID []uint64
+	value      []int64
+}
+
+type rawLocation struct {
+	id   uint64
+	line []rawLine
+}
+
+type rawLine struct {
+	functionID uint64
+	line       int64
+}
+
+type rawFunction struct {
+	id        uint64
+	name      int64 // index into the string table
+	startLine int64
+}
+
+func decodeProfile(data []byte) (*rawProfile, error) {
+	p := new(rawProfile)
+	d := decoder{data: data}
+	for {
+		num, typ, _, b, ok := d.next()
+		if !ok {
+			break
+		}
+		if typ != wireBytes {
+			continue
+		}
+		switch num {
+		case 1: // sample_type
+			p.sampleType = append(p.sampleType, decodeValueType(&d, b))
+		case 2: // sample
+			p.sample = append(p.sample, decodeSample(&d, b))
+		case 4: // location
+			p.location = append(p.location, decodeLocation(&d, b))
+		case 5: // function
+			p.function = append(p.function, decodeFunction(&d, b))
+		case 6: // string_table
+			p.strings = append(p.strings, string(b))
+		}
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return p, nil
+}
+
+func decodeValueType(outer *decoder, data []byte) rawValueType {
+	var vt rawValueType
+	d := decoder{data: data}
+	for {
+		num, _, u, _, ok := d.next()
+		if !ok {
+			break
+		}
+		switch num {
+		case 1:
+			vt.typ = int64(u)
+		case 2:
+			vt.unit = int64(u)
+		}
+	}
+	if d.err != nil {
+		outer.err = d.err
+	}
+	return vt
+}
+
+func decodeSample(outer *decoder, data []byte) rawSample {
+	var s rawSample
+	d := decoder{data: data}
+	for {
+		num, typ, u, b, ok := d.next()
+		if !ok {
+			break
+		}
+		switch num {
+		case 1:
+			s.locationID = d.uint64s(s.locationID, typ, u, b)
+		case 2:
+			for _, v := range d.uint64s(nil, typ, u, b) {
+				s.value = append(s.value, int64(v))
+			}
+		}
+	}
+	if d.err != nil {
+		outer.err = d.err
+	}
+	return s
+}
+
+func decodeLocation(outer *decoder, data []byte) rawLocation {
+	var loc rawLocation
+	d := decoder{data: data}
+	for {
+		num, typ, u, b, ok := d.next()
+		if !ok {
+			break
+		}
+		switch num {
+		case 1:
+			loc.id = u
+		case 4:
+			if typ == wireBytes {
+				loc.line = append(loc.line, decodeLine(&d, b))
+			}
+		}
+	}
+	if d.err != nil {
+		outer.err = d.err
+	}
+	return loc
+}
+
+func decodeLine(outer *decoder, data []byte) rawLine {
+	var l rawLine
+	d := decoder{data: data}
+	for {
+		num, _, u, _, ok := d.next()
+		if !ok {
+			break
+		}
+		switch num {
+		case 1:
+			l.functionID = u
+		case 2:
+			l.line = int64(u)
+		}
+	}
+	if d.err != nil {
+		outer.err = d.err
+	}
+	return l
+}
+
+func decodeFunction(outer *decoder, data []byte) rawFunction {
+	var f rawFunction
+	d := decoder{data: data}
+	for {
+		num, _, u, _, ok := d.next()
+		if !ok {
+			break
+		}
+		switch num {
+		case 1:
+			f.id = u
+		case 2:
+			f.name = int64(u)
+		case 5:
+			f.startLine = int64(u)
+		}
+	}
+	if d.err != nil {
+		outer.err = d.err
+	}
+	return f
+}
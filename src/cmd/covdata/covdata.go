@@ -0,0 +1,146 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"cmd/internal/cov"
+)
+
+const usageMessage = `usage: go tool covdata <mode> -i=<dir1,dir2,...> [flags]
+
+Modes:
+	merge     merge counter data into a single file in the -o directory
+	subtract  subtract the counter data of later inputs from the first
+	textfmt   convert counter data to the text profile format in the -o file
+	percent   print the percentage of statements covered per package
+
+Run 'go tool covdata <mode> -h' for the flags of each mode.
+`
+
+func usage() {
+	fmt.Fprint(os.Stderr, usageMessage)
+	os.Exit(2)
+}
+
+// A mode is a subcommand of covdata.
+type mode struct {
+	name   string
+	output string // description of -o, or "" if the mode has no output flag
+	run    func(p *cov.Profile, inputs []string, out string) error
+}
+
+var modes = []mode{
+	{"merge", "output directory", runMerge},
+	{"subtract", "output directory", runSubtract},
+	{"textfmt", "output file", runTextfmt},
+	{"percent", "", runPercent},
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("covdata: ")
+
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var m *mode
+	for i := range modes {
+		if modes[i].name == os.Args[1] {
+			m = &modes[i]
+		}
+	}
+	if m == nil {
+		fmt.Fprintf(os.Stderr, "covdata: unknown mode %q\n", os.Args[1])
+		usage()
+	}
+
+	fs := flag.NewFlagSet(m.name, flag.ExitOnError)
+	in := fs.String("i", "", "comma-separated list of input directories")
+	var out *string
+	if m.output != "" {
+		out = fs.String("o", "", m.output)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: go tool covdata %s -i=<dir1,dir2,...>", m.name)
+		if out != nil {
+			fmt.Fprintf(os.Stderr, " -o=<%s>", m.output)
+		}
+		fmt.Fprintf(os.Stderr, "\n")
+		fs.PrintDefaults()
+		os.Exit(2)
+	}
+	fs.Parse(os.Args[2:])
+	if *in == "" || fs.NArg() != 0 || out != nil && *out == "" {
+		fs.Usage()
+	}
+	inputs := strings.Split(*in, ",")
+
+	p, err := cov.ReadDirs(inputs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var o string
+	if out != nil {
+		o = *out
+	}
+	if err := m.run(p, inputs, o); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// runMerge writes the combined counter data of the inputs to dir.
+func runMerge(p *cov.Profile, inputs []string, dir string) error {
+	return p.WriteCounterFile(dir)
+}
+
+// runSubtract writes to dir the counter data of the first input,
+// less the blocks covered by the counter data of the others.
+func runSubtract(_ *cov.Profile, inputs []string, dir string) error {
+	if len(inputs) < 2 {
+		return fmt.Errorf("subtract requires at least two input directories")
+	}
+	p, err := cov.ReadDirs(inputs[:1])
+	if err != nil {
+		return err
+	}
+	q, err := cov.ReadDirs(inputs[1:])
+	if err != nil {
+		return err
+	}
+	p.Subtract(q)
+	return p.WriteCounterFile(dir)
+}
+
+// runTextfmt writes the combined counter data of the inputs
+// to file in the text profile format.
+func runTextfmt(p *cov.Profile, inputs []string, file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := p.WriteText(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// runPercent prints the statement coverage of each package.
+func runPercent(p *cov.Profile, inputs []string, _ string) error {
+	for _, pkg := range p.Packages() {
+		pct := 0.0
+		if pkg.Stmts > 0 {
+			pct = 100 * float64(pkg.Covered) / float64(pkg.Stmts)
+		}
+		fmt.Printf("\t%s\t\tcoverage: %.1f%% of statements\n", pkg.ImportPath, pct)
+	}
+	return nil
+}
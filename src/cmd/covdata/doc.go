@@ -0,0 +1,46 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Covdata is a program for manipulating the coverage counter data files
+written by programs built with "go build -cover".
+
+A program built with -cover writes a counter data file to the directory
+named by the GOCOVERDIR environment variable when it exits, either by
+returning from main.main or by calling os.Exit. Each run of the program
+writes a new file, so a directory can collect the data from many runs of
+one or more programs, such as those of an integration test suite.
+
+Usage:
+
+	go tool covdata <mode> -i=<dir1,dir2,...> [flags]
+
+The modes are:
+
+	merge     merge the counter data in the input directories
+	          into a single counter data file in the directory named by -o
+	subtract  write to the directory named by -o the counter data of the
+	          first input directory, minus the blocks covered by the
+	          counter data in the remaining input directories
+	textfmt   convert the counter data to the text profile format used by
+	          "go test -coverprofile", writing it to the file named by -o
+	percent   print the percentage of statements covered in each package
+
+The text profile written by textfmt can be used with "go tool cover",
+as in "go tool cover -html=profile.txt". The cover tool also accepts
+a counter data directory directly, as in "go tool cover -html=dir".
+
+Examples:
+
+	$ go build -cover -o myprogram .
+	$ mkdir somedata
+	$ GOCOVERDIR=somedata ./myprogram
+	$ go tool covdata percent -i=somedata
+	$ go tool covdata textfmt -i=somedata -o=profile.txt
+	$ go tool cover -html=profile.txt
+
+The counter data files use the text profile format, with one file per
+program run; see "go doc internal/coverage" for details.
+*/
+package main
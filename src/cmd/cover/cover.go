@@ -33,6 +33,10 @@ Open a web browser displaying annotated source code:
 Write out an HTML file instead of launching a web browser:
 	go tool cover -html=c.out -o coverage.html
 
+The profile may also be a directory of counter data files written
+by a program built with 'go build -cover':
+	go tool cover -html=dir
+
 Display coverage percentages to stdout for each function:
 	go tool cover -func=c.out
 
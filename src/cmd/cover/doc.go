@@ -4,9 +4,10 @@
 
 /*
 Cover is a program for analyzing the coverage profiles generated by
-'go test -coverprofile=cover.out'.
+'go test -coverprofile=cover.out', and the counter data written by
+programs built with 'go build -cover' (see 'go doc cmd/covdata').
 
-Cover is also used by 'go test -cover' to rewrite the source code with
+Cover is also used by 'go test -cover' and 'go build -cover' to rewrite the source code with
 annotations to track which parts of each function are executed.
 It operates on one Go source file at a time, computing approximate
 basic block information by studying the source. It is thus more portable
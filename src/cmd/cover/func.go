@@ -38,7 +38,7 @@ import (
 //	total:		(statements)			91.9%
 
 func funcOutput(profile, outputFile string) error {
-	profiles, err := ParseProfiles(profile)
+	profiles, err := readProfiles(profile)
 	if err != nil {
 		return err
 	}
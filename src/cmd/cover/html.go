@@ -22,7 +22,7 @@ import (
 // coverage report, writing it to outfile. If outfile is empty,
 // it writes the report to a temporary file and opens it in a web browser.
 func htmlOutput(profile, outfile string) error {
-	profiles, err := ParseProfiles(profile)
+	profiles, err := readProfiles(profile)
 	if err != nil {
 		return err
 	}
@@ -4,19 +4,25 @@
 
 // This file provides support for parsing coverage profiles
 // generated by "go test -coverprofile=cover.out".
-// It is a copy of golang.org/x/tools/cover/profile.go.
+// It is a copy of golang.org/x/tools/cover/profile.go,
+// extended to read the counter data directories written by
+// programs built with "go build -cover".
 
 package main
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+
+	"cmd/internal/cov"
 )
 
 // Profile represents the profiling data for a specific file.
@@ -47,9 +53,32 @@ func ParseProfiles(fileName string) ([]*Profile, error) {
 		return nil, err
 	}
 	defer pf.Close()
+	return parseProfiles(pf)
+}
+
+// readProfiles returns the profiles in the named file, or, if name is a
+// directory, the combined profiles of the counter data files written there
+// by programs built with "go build -cover".
+func readProfiles(name string) ([]*Profile, error) {
+	fi, err := os.Stat(name)
+	if err != nil || !fi.IsDir() {
+		return ParseProfiles(name)
+	}
+	p, err := cov.ReadDirs([]string{name})
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := p.WriteText(&buf); err != nil {
+		return nil, err
+	}
+	return parseProfiles(&buf)
+}
 
+// parseProfiles parses profile data read from r.
+func parseProfiles(r io.Reader) ([]*Profile, error) {
 	files := make(map[string]*Profile)
-	buf := bufio.NewReader(pf)
+	buf := bufio.NewReader(r)
 	// First line is "mode: foo", where foo is "set", "count", or "atomic".
 	// Rest of file is in the format
 	//	encoding/base64/base64.go:34.44,37.40 3 1
@@ -47,6 +47,7 @@
 // 	importpath  import path syntax
 // 	modules     modules, module versions, and more
 // 	module-get  module-aware go get
+// 	module-private module configuration for non-public modules
 // 	packages    package lists and patterns
 // 	module-auth module authentication using go.sum
 // 	testflag    testing flags
@@ -96,6 +97,18 @@
 //
 // The -i flag installs the packages that are dependencies of the target.
 //
+// The -cover flag builds executables with coverage instrumentation.
+// When such a program exits, either by returning from main or by calling
+// os.Exit, it writes coverage counter data to a new file in the directory
+// named by the GOCOVERDIR environment variable. By default, the packages
+// in the main module are instrumented (in GOPATH mode, the packages named
+// on the command line); the -coverpkg flag instead takes a comma-separated
+// list of patterns selecting the packages to instrument. The -covermode flag
+// sets the mode of coverage analysis, as in 'go test': set (the default,
+// or atomic when -race is enabled), count or atomic. The -covermode and
+// -coverpkg flags imply -cover. Use 'go tool covdata' to merge the counter
+// data and convert it to a coverage profile.
+//
 // The build flags are shared by the build, clean, get, install, list, run,
 // and test commands:
 //
@@ -126,6 +139,14 @@
 // 		arguments to pass on each go tool asm invocation.
 // 	-buildmode mode
 // 		build mode to use. See 'go help buildmode' for more.
+// 	-buildvcs
+// 		whether to stamp binaries with version control information.
+// 		By default, when a binary is built by go build, go install or
+// 		go run from a main package in the main module, and the main
+// 		module is inside a Git or Mercurial checkout, the revision,
+// 		commit time and whether the checkout has uncommitted changes
+// 		are recorded in the binary. Use -buildvcs=false to omit them.
+// 		See 'go help version' for how to read the recorded information.
 // 	-compiler name
 // 		name of compiler to use, as in runtime.Compiler (gccgo or gc).
 // 	-gccgoflags '[pattern=]arg list'
@@ -147,6 +168,12 @@
 // 	-mod mode
 // 		module download mode to use: readonly or vendor.
 // 		See 'go help modules' for more.
+// 	-pgo file
+// 		specify the file path of a CPU profile, in the pprof format, for
+// 		profile-guided optimization. The compiler uses the profile to
+// 		inline hot calls more aggressively and to devirtualize hot
+// 		interface method calls. The special name "off" turns off
+// 		profile-guided optimization.
 // 	-pkgdir dir
 // 		install and load all packages from dir instead of the usual locations.
 // 		For example, when building with a non-standard configuration,
@@ -646,6 +673,8 @@
 //
 // The -i flag installs the dependencies of the named packages as well.
 //
+// The -cover, -covermode and -coverpkg flags are as for 'go build'.
+//
 // For more about the build flags, see 'go help build'.
 // For more about specifying packages, see 'go help packages'.
 //
@@ -830,18 +859,20 @@
 // applied to a Go struct, but now a Module struct:
 //
 //     type Module struct {
-//         Path      string       // module path
-//         Version   string       // module version
-//         Versions  []string     // available module versions (with -versions)
-//         Replace   *Module      // replaced by this module
-//         Time      *time.Time   // time version was created
-//         Update    *Module      // available update, if any (with -u)
-//         Main      bool         // is this the main module?
-//         Indirect  bool         // is this module only an indirect dependency of main module?
-//         Dir       string       // directory holding files for this module, if any
-//         GoMod     string       // path to go.mod file for this module, if any
-//         GoVersion string       // go version used in module
-//         Error     *ModuleError // error loading module
+//         Path       string       // module path
+//         Version    string       // module version
+//         Versions   []string     // available module versions (with -versions)
+//         Replace    *Module      // replaced by this module
+//         Time       *time.Time   // time version was created
+//         Update     *Module      // available update, if any (with -u)
+//         Main       bool         // is this the main module?
+//         Indirect   bool         // is this module only an indirect dependency of main module?
+//         Dir        string       // directory holding files for this module, if any
+//         GoMod      string       // path to go.mod file for this module, if any
+//         GoVersion  string       // go version used in module
+//         Retracted  []string     // retraction information, if any (with -u)
+//         Deprecated string       // deprecation message, if any (with -u)
+//         Error      *ModuleError // error loading module
 //     }
 //
 //     type ModuleError struct {
@@ -878,6 +909,16 @@
 //     golang.org/x/text v0.3.0 [v0.4.0] => /tmp/text
 //     rsc.io/pdf v0.1.1 [v0.1.2]
 //
+// The -u flag also reports versions that have been retracted by the
+// module author and modules that have been deprecated. If the current
+// version of a module is retracted, list -u sets the Module's Retracted
+// field to the author's rationale for retracting it (or to a single
+// empty string if no rationale was given). If the latest version of the
+// module's go.mod file has a "// Deprecated:" comment on its module
+// statement, list -u sets the Module's Deprecated field to the comment's
+// text. The Module's String method marks such modules with "(retracted)"
+// or "(deprecated)". Retracted versions are never reported as updates.
+//
 // (For tools, 'go list -m -u -json all' may be more convenient to parse.)
 //
 // The -versions flag causes list to set the Module's Versions field
@@ -1186,6 +1227,8 @@
 //
 // The exit status of Run is not the exit status of the compiled binary.
 //
+// The -cover, -covermode and -coverpkg flags are as for 'go build'.
+//
 // For more about build flags, see 'go help build'.
 // For more about specifying packages, see 'go help packages'.
 //
@@ -1301,6 +1344,21 @@
 // 	-json
 // 	    Convert test output to JSON suitable for automated processing.
 // 	    See 'go doc test2json' for the encoding details.
+// 	    Also emits build output and build failures as JSON objects
+// 	    interleaved with the test events. Each such object is a
+// 	    BuildEvent:
+//
+// 		type BuildEvent struct {
+// 			ImportPath string
+// 			Action     string
+// 			Output     string
+// 		}
+//
+// 	    The ImportPath field identifies the package being built, in the
+// 	    same form as the FailedBuild field of a test event. The Action
+// 	    field is "build-output" for a portion of the build's output,
+// 	    held in the Output field, or "build-fail" when the build of the
+// 	    package has failed.
 //
 // 	-o file
 // 	    Compile the test binary to the named file.
@@ -1334,9 +1392,35 @@
 //
 // Usage:
 //
-// 	go version
+// 	go version [-m] [-v] [file ...]
+//
+// Version prints the build information for Go executables.
+//
+// Go version reports the Go version used to build each of the named
+// executable files.
+//
+// If no files are named on the command line, go version prints its own
+// version information.
 //
-// Version prints the Go version, as reported by runtime.Version.
+// If a directory is named, go version walks that directory, recursively,
+// looking for recognized Go binaries and reporting their versions.
+// By default, go version does not report unrecognized files found
+// during a directory scan. The -v flag causes it to report unrecognized files.
+//
+// The -m flag causes go version to print each executable's embedded
+// build information. In the output, the build information consists of
+// multiple lines following the version line, each indented by a leading
+// tab character: the main package path, the main module and the module
+// dependencies, and then the build settings, such as the build flags,
+// GOOS and GOARCH, the cgo settings and, when the main module was in a
+// version control checkout, its revision, commit time and whether it had
+// uncommitted changes (see the -buildvcs flag in 'go help build').
+// The same information is available to a running program
+// from runtime/debug.ReadBuildInfo.
+//
+// Build information is recorded only in binaries built in module mode.
+//
+// See also: go doc runtime/debug.BuildInfo.
 //
 //
 // Report likely mistakes in packages
@@ -1362,6 +1446,17 @@
 //   go install golang.org/x/tools/go/analysis/passes/shadow/cmd/shadow
 //   go vet -vettool=$(which shadow)
 //
+// In module mode, the main module's go.mod file may list additional
+// analysis tools to run alongside the vet tool, using vet directives:
+//
+//   vet golang.org/x/tools/go/analysis/passes/shadow/cmd/shadow
+//
+// Each listed package must be a main package implementing the vet tool
+// protocol, such as one built with golang.org/x/tools/go/analysis/unitchecker.
+// The go command builds the tools from the module's build list and runs
+// them on each package being vetted. They receive only the -c and
+// -json flags, and they do not see analysis facts about dependencies.
+//
 // The build flags supported by go vet are those that control package resolution
 // and execution, such as -n, -x, -v, -tags, and -toolexec.
 // For more about these flags, see 'go help build'.
@@ -1445,6 +1540,19 @@
 // The go command periodically deletes cached data that has not been
 // used recently. Running 'go clean -cache' deletes all cached data.
 //
+// Setting the GOCACHEPROG environment variable to a command (with optional
+// space-separated flags) makes the go command use that program, instead of
+// the GOCACHE directory, to store and retrieve cached data. This allows
+// sharing a cache between machines, for example on a build farm. The go
+// command starts the program once and talks to it with newline-delimited
+// JSON messages over its standard input and output: the program first
+// announces the commands it supports ("get", "put" and "close"), and then
+// answers each request of the go command, identified by an ID, by
+// reporting a cache hit or miss for an action ID, or by storing the output
+// of an action, along with the path of a file on the local disk holding
+// that output. See the documentation of ProgCache in the source of the
+// cmd/go/internal/cache package for the details of the protocol.
+//
 // The build cache correctly accounts for changes to Go source files,
 // compilers, compiler options, and so on: cleaning the cache explicitly
 // should not be necessary in typical use. However, the build cache
@@ -1492,16 +1600,36 @@
 // 	GOCACHE
 // 		The directory where the go command will store cached
 // 		information for reuse in future builds.
+// 	GOCACHEPROG
+// 		A command (with optional space-separated flags) that implements an
+// 		external go command build cache. See 'go help cache'.
+// 	GOCOVERDIR
+// 		The directory into which programs built with 'go build -cover'
+// 		write their coverage counter data when they exit.
 // 	GOFLAGS
 // 		A space-separated list of -flag=value settings to apply
 // 		to go commands by default, when the given flag is known by
 // 		the current command. Flags listed on the command line
 // 		are applied after this list and therefore override it.
+// 	GONOPROXY
+// 		Comma-separated list of glob patterns (in the syntax of Go's path.Match)
+// 		of module path prefixes that should always be fetched directly,
+// 		bypassing GOPROXY. Defaults to GOPRIVATE.
+// 		See 'go help module-private'.
+// 	GONOSUMDB
+// 		Comma-separated list of glob patterns (in the syntax of Go's path.Match)
+// 		of module path prefixes that should not be verified using the checksum
+// 		database. Defaults to GOPRIVATE. See 'go help module-private'.
 // 	GOOS
 // 		The operating system for which to compile code.
 // 		Examples are linux, darwin, windows, netbsd.
 // 	GOPATH
 // 		For more details see: 'go help gopath'.
+// 	GOPRIVATE
+// 		Comma-separated list of glob patterns (in the syntax of Go's path.Match)
+// 		of module path prefixes that are private: by default they are fetched
+// 		directly and not verified using the checksum database.
+// 		See 'go help module-private'.
 // 	GOPROXY
 // 		URL of Go module proxy. See 'go help goproxy'.
 // 	GORACE
@@ -1509,6 +1637,9 @@
 // 		See https://golang.org/doc/articles/race_detector.html.
 // 	GOROOT
 // 		The root of the go tree.
+// 	GOSUMDB
+// 		The name of the checksum database to use and optionally its public key and
+// 		URL. See 'go help module-auth'.
 // 	GOTMPDIR
 // 		The directory where the go command will write
 // 		temporary source files, packages, and binaries.
@@ -1662,15 +1793,40 @@
 // 	require new/thing/v2 v2.3.4
 // 	exclude old/thing v1.2.3
 // 	replace bad/thing v1.4.5 => good/thing v1.4.5
+// 	retract [v1.9.0, v1.9.5]
 //
 // The verbs are
 // 	module, to define the module path;
 // 	go, to set the expected language version;
 // 	require, to require a particular module at a given version or later;
-// 	exclude, to exclude a particular module version from use; and
-// 	replace, to replace a module version with a different module version.
-// Exclude and replace apply only in the main module's go.mod and are ignored
-// in dependencies.  See https://research.swtch.com/vgo-mvs for details.
+// 	exclude, to exclude a particular module version from use;
+// 	replace, to replace a module version with a different module version;
+// 	retract, to indicate a previously released version should not be used; and
+// 	vet, to name an additional analysis tool for 'go vet' to run.
+// Exclude, replace, and vet apply only in the main module's go.mod and are
+// ignored in dependencies.  See https://research.swtch.com/vgo-mvs for details.
+//
+// A retract directive lists a single version (retract v1.2.3) or a closed
+// interval of versions (retract [v1.0.0, v1.1.9]) that the module author
+// does not want users to depend on, for example because they were published
+// accidentally or contain a severe problem. Comments on the directive
+// explain the reason for the retraction. Retractions are read from the
+// go.mod file of the latest version of the module, so a module author
+// retracts a version by publishing a newer version whose go.mod lists it.
+// Retracted versions are not selected by version queries like @latest
+// or by 'go get -u', but they remain available to modules that already
+// require them. 'go get' prints a warning when it selects a retracted
+// version, and 'go list -m -u' reports retracted versions.
+//
+// A comment paragraph beginning with "Deprecated:" on the module directive
+// marks the module as deprecated: its author no longer maintains it.
+// The rest of the paragraph is the deprecation message, which should tell
+// users what to use instead. As with retractions, deprecations are read
+// from the go.mod file of the latest version of the module, and 'go get'
+// and 'go list -m -u' report them. For example:
+//
+// 	// Deprecated: use example.com/mod/v2 instead.
+// 	module example.com/mod
 //
 // The leading verb can be factored out of adjacent lines to create a block,
 // like in Go imports:
@@ -1956,7 +2112,8 @@
 // or is the string "direct", downloads use the default direct connection to version
 // control systems. Setting GOPROXY to "off" disallows downloading modules from
 // any source. Otherwise, GOPROXY is expected to be the URL of a module proxy,
-// in which case the go command will fetch all modules from that proxy.
+// in which case the go command will fetch all modules from that proxy,
+// except those with paths matching GONOPROXY (see 'go help module-private').
 // No matter the source of the modules, downloaded modules must match existing
 // entries in go.sum (see 'go help modules' for discussion of verification).
 //
@@ -2432,6 +2589,10 @@
 // main module's go.mod are considered unavailable and cannot
 // be returned by queries.
 //
+// Module versions retracted by the module author (see 'go help go.mod')
+// are skipped by all queries except a fully-specified semantic version
+// or a revision identifier.
+//
 // For example, these commands are all valid:
 //
 // 	go get github.com/gorilla/mux@latest    # same (@latest is default for 'go get')
@@ -2527,6 +2688,10 @@
 // See 'go help goproxy' for details about the proxy and also the format of
 // the cached downloaded packages.
 //
+// Modules that are not publicly available can be excluded from the proxy
+// and from checksum verification by setting GOPRIVATE (or the finer-grained
+// GONOPROXY and GONOSUMDB). See 'go help module-private' for details.
+//
 // Modules and vendoring
 //
 // When using modules, the go command completely ignores vendor directories.
@@ -2547,6 +2712,48 @@
 // are still ignored.
 //
 //
+// Module configuration for non-public modules
+//
+// The go command defaults to downloading modules from the module proxy
+// named by GOPROXY and validating them against the checksum database
+// named by GOSUMDB. Modules that are not publicly available, such as
+// those hosted on a company's internal version control server, cannot be
+// served by a public proxy or listed in a public checksum database.
+//
+// The GOPRIVATE environment variable controls which modules the go command
+// considers to be private (not available publicly) and should therefore
+// not use the proxy or checksum database. The variable is a comma-separated
+// list of glob patterns (in the syntax of Go's path.Match) of module path
+// prefixes. For example,
+//
+// 	GOPRIVATE=*.corp.example.com,rsc.io/private
+//
+// causes the go command to treat as private any module with a path prefix
+// matching either pattern, including git.corp.example.com/xyzzy, rsc.io/private,
+// and rsc.io/private/quux. Private modules are downloaded directly from
+// their version control systems and are not checked against the checksum
+// database.
+//
+// For more fine-grained control over module download and validation,
+// the GONOPROXY and GONOSUMDB environment variables accept the same kind
+// of glob list and override GOPRIVATE for the specific decision of whether
+// to use the proxy and checksum database, respectively.
+//
+// For example, if a company ran a module proxy serving private modules,
+// users would configure go using:
+//
+// 	GOPRIVATE=*.corp.example.com
+// 	GOPROXY=https://proxy.corp.example.com
+// 	GONOPROXY=none
+//
+// This would tell the go command to use the corporate proxy for all modules,
+// including private ones (no module path matches the pattern "none"),
+// while still skipping the checksum database for private modules.
+//
+// The 'go env' command reports the effective values of GONOPROXY and
+// GONOSUMDB, taking the GOPRIVATE default into account.
+//
+//
 // Package lists and patterns
 //
 // Many commands apply to a set of packages:
@@ -2684,32 +2891,49 @@
 // you want to use the same code you used yesterday.
 //
 // If a downloaded module is not yet included in go.sum and it is a publicly
-// available module, the go command consults the Go notary server to fetch
+// available module, the go command consults the Go checksum database to fetch
 // the expected go.sum lines. If the downloaded code does not match those
 // lines, the go command reports the mismatch and exits. Note that the
-// notary is not consulted for module versions already listed in go.sum.
-//
-// The GONOVERIFY environment variable is a comma-separated list of
+// database is not consulted for module versions already listed in go.sum.
+//
+// The checksum database is a transparency log: the go command checks
+// that every go.sum line it is served is included in a signed log tree,
+// and that each new signed tree it sees is consistent with (extends)
+// the trees it has seen before. Verified lookups and log tiles are cached in
+// $GOPATH/pkg/mod/cache/download/sumdb, and the latest known signed tree
+// is recorded in $GOPATH/pkg/sumdb.
+//
+// The GOSUMDB environment variable identifies the name of the checksum database
+// to use and optionally its public key and URL, as in:
+//
+// 	GOSUMDB="sum.golang.org"
+// 	GOSUMDB="sum.golang.org+<publickey>"
+// 	GOSUMDB="sum.golang.org+<publickey> https://sum.golang.org"
+//
+// The go command knows the public key of sum.golang.org; use of any other
+// database requires giving the public key explicitly. The URL defaults to
+// "https://" followed by the database name. If GOPROXY names a module proxy,
+// the go command first asks that proxy whether it can serve the database
+// (by fetching <proxyURL>/sumdb/<name>/supported) and if so, accesses the
+// database through the proxy.
+//
+// If GOSUMDB is set to "off", or if "go get" is invoked with the -insecure flag,
+// the checksum database is never consulted, but note that this defeats the
+// security provided by the database.
+//
+// The GONOSUMDB environment variable is a comma-separated list of
 // patterns (in the syntax of Go's path.Match) of module path prefixes
-// that should not be verified using the notary. For example,
-//
-// 	GONOVERIFY=*.corp.example.com,rsc.io/private
+// that should not be verified using the checksum database. It defaults
+// to the value of GOPRIVATE (see 'go help module-private'). For example,
 //
-// disables notary verification for modules with path prefixes matching
-// either pattern, including "git.corp.example.com/xyzzy", "rsc.io/private",
-// and "rsc.io/private/quux".
+// 	GONOSUMDB=*.corp.example.com,rsc.io/private
 //
-// As a special case, if GONOVERIFY is set to "off", or if "go get" was invoked
-// with the -insecure flag, the notary is never consulted, but note that this
-// defeats the security provided by the notary. A better course of action is
-// to set a narrower GONOVERIFY and, in the case of go.sum mismatches,
-// investigate why the code downloaded code differs from what was
-// downloaded yesterday.
-//
-// NOTE: Early in the Go 1.13 dev cycle, the notary is being simulated by
-// a whitelist of known hashes for popular Go modules, to expose any
-// problems arising from knowing the expected hashes.
-// TODO(rsc): This note should be removed once the real notary is used instead. See #30601.
+// disables checksum database verification for modules with path prefixes
+// matching either pattern, including "git.corp.example.com/xyzzy",
+// "rsc.io/private", and "rsc.io/private/quux". A better course of action
+// than disabling the database entirely is to set a narrower GONOSUMDB and,
+// in the case of go.sum mismatches, investigate why the downloaded code
+// differs from what was downloaded yesterday.
 //
 //
 // Testing flags
@@ -2725,6 +2949,12 @@
 // The following flags are recognized by the 'go test' command and
 // control the execution of any test:
 //
+// 	-artifacts
+// 	    Keep the directories returned by T.ArtifactDir and B.ArtifactDir
+// 	    after the test completes, storing them under _artifacts in the
+// 	    output directory (see -outputdir). Without this flag, artifact
+// 	    directories are temporary and are removed when the test exits.
+//
 // 	-bench regexp
 // 	    Run only those benchmarks matching a regular expression.
 // 	    By default, no benchmarks are run.
@@ -2875,8 +3105,9 @@
 // 	    contended mutex.
 //
 // 	-outputdir directory
-// 	    Place output files from profiling in the specified directory,
-// 	    by default the directory in which "go test" is running.
+// 	    Place output files from profiling and test artifacts in the
+// 	    specified directory, by default the directory in which
+// 	    "go test" is running.
 //
 // 	-trace trace.out
 // 	    Write an execution trace to the specified file before exiting.
@@ -130,7 +130,7 @@ func TestVerifyPanic(t *testing.T) {
 	}
 
 	id := ActionID(dummyID(1))
-	if err := c.PutBytes(id, []byte("abc")); err != nil {
+	if err := PutBytes(c, id, []byte("abc")); err != nil {
 		t.Fatal(err)
 	}
 
@@ -140,7 +140,7 @@ func TestVerifyPanic(t *testing.T) {
 			return
 		}
 	}()
-	c.PutBytes(id, []byte("def"))
+	PutBytes(c, id, []byte("def"))
 	t.Fatal("mismatched Put did not panic in verify mode")
 }
 
@@ -178,9 +178,9 @@ func TestCacheTrim(t *testing.T) {
 	}
 
 	id := ActionID(dummyID(1))
-	c.PutBytes(id, []byte("abc"))
+	PutBytes(c, id, []byte("abc"))
 	entry, _ := c.Get(id)
-	c.PutBytes(ActionID(dummyID(2)), []byte("def"))
+	PutBytes(c, ActionID(dummyID(2)), []byte("def"))
 	mtime := now
 	checkTime(fmt.Sprintf("%x-a", id), mtime)
 	checkTime(fmt.Sprintf("%x-d", entry.OutputID), mtime)
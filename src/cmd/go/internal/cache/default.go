@@ -15,14 +15,16 @@ import (
 )
 
 // Default returns the default cache to use, or nil if no cache should be used.
-func Default() *Cache {
+// It is the cache program named by GOCACHEPROG, if set, and otherwise the
+// directory cache in GOCACHE.
+func Default() Cache {
 	defaultOnce.Do(initDefaultCache)
 	return defaultCache
 }
 
 var (
 	defaultOnce  sync.Once
-	defaultCache *Cache
+	defaultCache Cache
 )
 
 // cacheREADME is a message stored in a README in the cache directory.
@@ -36,6 +38,11 @@ See golang.org to learn more about Go.
 // initDefaultCache does the work of finding the default cache
 // the first time Default is called.
 func initDefaultCache() {
+	if prog := os.Getenv("GOCACHEPROG"); prog != "" {
+		defaultCache = startCacheProg(prog)
+		return
+	}
+
 	dir := DefaultDir()
 	if dir == "off" {
 		if defaultDirErr != nil {
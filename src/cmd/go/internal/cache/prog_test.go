@@ -0,0 +1,169 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"internal/testenv"
+)
+
+// progCacheDirEnv names the environment variable that makes the test binary
+// act as a GOCACHEPROG program backed by the named directory.
+const progCacheDirEnv = "GO_CACHEPROG_TEST_DIR"
+
+// TestProgCacheHelper isn't a real test. It's the reference implementation
+// of a GOCACHEPROG program, run as a subprocess by TestProgCache.
+func TestProgCacheHelper(t *testing.T) {
+	dir := os.Getenv(progCacheDirEnv)
+	if dir == "" {
+		return
+	}
+	if err := serveCacheProg(dir, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "cacheprog: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// serveCacheProg implements the GOCACHEPROG protocol on r and w,
+// storing outputs in a DiskCache in dir.
+func serveCacheProg(dir string, r io.Reader, w io.Writer) error {
+	dc, err := Open(dir)
+	if err != nil {
+		return err
+	}
+	jenc := json.NewEncoder(w)
+	if err := jenc.Encode(&ProgResponse{
+		KnownCommands: []ProgCmd{cmdGet, cmdPut, cmdClose},
+	}); err != nil {
+		return err
+	}
+
+	jdec := json.NewDecoder(r)
+	for {
+		var req ProgRequest
+		if err := jdec.Decode(&req); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		var body []byte
+		if req.Command == cmdPut && req.BodySize > 0 {
+			// The body is a JSON string of base64 data,
+			// which decodes straight into a []byte.
+			if err := jdec.Decode(&body); err != nil {
+				return err
+			}
+			if int64(len(body)) != req.BodySize {
+				return fmt.Errorf("got %d bytes of body, expected %d", len(body), req.BodySize)
+			}
+		}
+
+		res := &ProgResponse{ID: req.ID}
+		switch req.Command {
+		case cmdGet:
+			var id ActionID
+			copy(id[:], req.ActionID)
+			file, entry, err := GetFile(dc, id)
+			if err != nil {
+				res.Miss = true
+				break
+			}
+			res.OutputID = entry.OutputID[:]
+			res.Size = entry.Size
+			res.Time = &entry.Time
+			res.DiskPath = file
+		case cmdPut:
+			var id ActionID
+			copy(id[:], req.ActionID)
+			out, _, err := dc.Put(id, bytes.NewReader(body))
+			if err != nil {
+				res.Err = err.Error()
+				break
+			}
+			if !bytes.Equal(out[:], req.OutputID) {
+				res.Err = fmt.Sprintf("output ID %x does not match body hash %x", req.OutputID, out)
+				break
+			}
+			res.DiskPath = dc.OutputFile(out)
+		case cmdClose:
+			return jenc.Encode(res)
+		default:
+			res.Err = fmt.Sprintf("unknown command %q", req.Command)
+		}
+		if err := jenc.Encode(res); err != nil {
+			return err
+		}
+	}
+}
+
+func startTestCacheProg(t *testing.T, dir string) Cache {
+	t.Helper()
+	defer os.Setenv(progCacheDirEnv, os.Getenv(progCacheDirEnv))
+	os.Setenv(progCacheDirEnv, dir)
+	return startCacheProg("'" + os.Args[0] + "' -test.run=^TestProgCacheHelper$")
+}
+
+func TestProgCache(t *testing.T) {
+	testenv.MustHaveExec(t)
+
+	dir, err := ioutil.TempDir("", "cacheprogtest-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := startTestCacheProg(t, dir)
+	if _, err := c.Get(dummyID(1)); err != errMissing {
+		t.Fatalf("Get(1) on empty cache = %v, want errMissing", err)
+	}
+
+	data := []byte("hello, world\n")
+	out, size, err := c.Put(dummyID(1), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if size != int64(len(data)) {
+		t.Errorf("Put size = %d, want %d", size, len(data))
+	}
+	if file := c.OutputFile(out); file == "" {
+		t.Errorf("OutputFile after Put is empty")
+	}
+
+	got, entry, err := GetBytes(c, dummyID(1))
+	if err != nil {
+		t.Fatalf("GetBytes: %v", err)
+	}
+	if !bytes.Equal(got, data) || entry.OutputID != out || entry.Size != size {
+		t.Errorf("GetBytes = %q, %x, %d, want %q, %x, %d", got, entry.OutputID, entry.Size, data, out, size)
+	}
+
+	// An empty output has no body.
+	if _, _, err := PutNoVerify(c, dummyID(2), bytes.NewReader(nil)); err != nil {
+		t.Fatalf("PutNoVerify of empty output: %v", err)
+	}
+	if got, _, err := GetBytes(c, dummyID(2)); err != nil || len(got) != 0 {
+		t.Errorf("GetBytes of empty output = %q, %v", got, err)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// The outputs outlive the cache program.
+	c = startTestCacheProg(t, dir)
+	defer c.Close()
+	if got, _, err := GetBytes(c, dummyID(1)); err != nil || !bytes.Equal(got, data) {
+		t.Errorf("GetBytes after restart = %q, %v, want %q", got, err, data)
+	}
+}
@@ -20,7 +20,11 @@ import (
 var (
 	BuildA                 bool   // -a flag
 	BuildBuildmode         string // -buildmode flag
+	BuildBuildvcs          = true // -buildvcs flag
 	BuildContext           = defaultContext()
+	BuildCover             bool               // -cover flag
+	BuildCoverMode         string             // -covermode flag
+	BuildCoverPkg          []string           // -coverpkg flag
 	BuildMod               string             // -mod flag
 	BuildI                 bool               // -i flag
 	BuildLinkshared        bool               // -linkshared flag
@@ -28,6 +32,7 @@ var (
 	BuildN                 bool               // -n flag
 	BuildO                 string             // -o flag
 	BuildP                 = runtime.NumCPU() // -p flag
+	BuildPGO               string             // -pgo flag
 	BuildPkgdir            string             // -pkgdir flag
 	BuildRace              bool               // -race flag
 	BuildToolexec          []string           // -toolexec flag
@@ -106,8 +111,22 @@ var (
 	GOMIPS64 = objabi.GOMIPS64
 	GOPPC64  = fmt.Sprintf("%s%d", "power", objabi.GOPPC64)
 	GOWASM   = objabi.GOWASM
+
+	// Module path patterns (see 'go help module-private').
+	GOPRIVATE = os.Getenv("GOPRIVATE")
+	GONOPROXY = envOr("GONOPROXY", GOPRIVATE)
+	GONOSUMDB = envOr("GONOSUMDB", GOPRIVATE)
 )
 
+// envOr returns the value of the named environment variable,
+// or def if the variable is unset or empty.
+func envOr(key, def string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return def
+}
+
 // Update build context to use our computed GOROOT.
 func init() {
 	BuildContext.GOROOT = GOROOT
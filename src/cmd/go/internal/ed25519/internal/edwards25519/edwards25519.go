@@ -0,0 +1,237 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package edwards25519 implements group logic for the twisted Edwards curve
+//
+//	-x^2 + y^2 = 1 + -(121665/121666)*x^2*y^2
+//
+// This is better known as the Edwards curve equivalent to Curve25519, and is
+// the curve used by the Ed25519 signature scheme.
+//
+// Most users don't need this package, and should instead use cmd/go/internal/ed25519
+// for signatures.
+package edwards25519
+
+import "errors"
+
+// Point represents a point on the edwards25519 curve.
+//
+// This type works similarly to math/big.Int, and all arguments and receivers
+// are allowed to alias.
+//
+// The zero value is NOT valid, and it may be used only as a receiver.
+type Point struct {
+	// The point is internally represented in extended coordinates (X, Y, Z, T)
+	// where x = X/Z, y = Y/Z, and xy = T/Z per https://eprint.iacr.org/2008/522.
+	x, y, z, t fieldElement
+}
+
+// identity is the point at infinity.
+var identity = &Point{x: feZero, y: feOne, z: feOne, t: feZero}
+
+// NewIdentityPoint returns a new Point set to the identity.
+func NewIdentityPoint() *Point {
+	p := *identity
+	return &p
+}
+
+// generator is the canonical curve basepoint. See TestGenerator for the
+// correspondence of this encoding with the values in RFC 8032.
+var generator, _ = new(Point).SetBytes([]byte{
+	0x58, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+})
+
+// NewGeneratorPoint returns a new Point set to the canonical generator.
+func NewGeneratorPoint() *Point {
+	p := *generator
+	return &p
+}
+
+// Set sets v = u, and returns v.
+func (v *Point) Set(u *Point) *Point {
+	*v = *u
+	return v
+}
+
+// Bytes returns the canonical 32-byte encoding of v, according to RFC 8032,
+// Section 5.1.2.
+func (v *Point) Bytes() []byte {
+	var zInv, x, y fieldElement
+	zInv.invert(&v.z)
+	x.mul(&v.x, &zInv)
+	y.mul(&v.y, &zInv)
+
+	out := y.bytes()
+	out[31] |= byte(x.isNegative() << 7)
+	return out
+}
+
+// SetBytes sets v = x, where x is a 32-byte encoding of v. If x does not
+// represent a valid point on the curve, SetBytes returns nil and an error and
+// the receiver is unchanged. Otherwise, SetBytes returns v.
+//
+// Note that SetBytes accepts all non-canonical encodings of valid points.
+// That is, it follows decoding rules that match most implementations in
+// the ecosystem rather than RFC 8032.
+func (v *Point) SetBytes(x []byte) (*Point, error) {
+	// Specifically, the non-canonical encodings that are accepted are
+	//   1) the ones where the field element is not reduced (see the
+	//      (*fieldElement).setBytes docs) and
+	//   2) the ones where the x-coordinate is zero and the sign bit is set.
+	if len(x) != 32 {
+		return nil, errors.New("edwards25519: invalid point encoding length")
+	}
+	var y fieldElement
+	y.setBytes(x)
+
+	// -x² + y² = 1 + dx²y²
+	// x² + dx²y² = x²(dy² + 1) = y² - 1
+	// x² = (y² - 1) / (dy² + 1)
+
+	// u = y² - 1
+	var y2, u fieldElement
+	y2.square(&y)
+	u.sub(&y2, &feOne)
+
+	// v = dy² + 1
+	var vv fieldElement
+	vv.mul(&y2, &d)
+	vv.add(&vv, &feOne)
+
+	// x = +√(u/v)
+	var xx fieldElement
+	_, wasSquare := xx.sqrtRatio(&u, &vv)
+	if wasSquare == 0 {
+		return nil, errors.New("edwards25519: invalid point encoding")
+	}
+
+	// Select the negative square root if the sign bit is set.
+	var xxNeg fieldElement
+	xxNeg.neg(&xx)
+	xx.selectFE(&xxNeg, &xx, int(x[31]>>7))
+
+	v.x = xx
+	v.y = y
+	v.z = feOne
+	v.t.mul(&xx, &y) // xy = T / Z
+
+	return v, nil
+}
+
+// Add sets v = p + q, and returns v.
+func (v *Point) Add(p, q *Point) *Point {
+	// Unified addition for a = -1, per RFC 8032, Section 5.1.4. It is
+	// complete, so it also handles doubling and the identity.
+	var a, b, c, dd, e, f, g, h, t fieldElement
+	a.mul(t.sub(&p.y, &p.x), new(fieldElement).sub(&q.y, &q.x))
+	b.mul(t.add(&p.y, &p.x), new(fieldElement).add(&q.y, &q.x))
+	c.mul(c.mul(&p.t, &d2), &q.t)
+	dd.add(&p.z, &p.z)
+	dd.mul(&dd, &q.z)
+	e.sub(&b, &a)
+	f.sub(&dd, &c)
+	g.add(&dd, &c)
+	h.add(&b, &a)
+
+	v.x.mul(&e, &f)
+	v.y.mul(&g, &h)
+	v.t.mul(&e, &h)
+	v.z.mul(&f, &g)
+	return v
+}
+
+// double sets v = p + p, and returns v.
+func (v *Point) double(p *Point) *Point {
+	// Doubling for a = -1, per RFC 8032, Section 5.1.4.
+	var a, b, c, e, f, g, h, t fieldElement
+	a.square(&p.x)
+	b.square(&p.y)
+	c.square(&p.z)
+	c.add(&c, &c)
+	h.add(&a, &b)
+	t.add(&p.x, &p.y)
+	e.sub(&h, t.square(&t))
+	g.sub(&a, &b)
+	f.add(&c, &g)
+
+	v.x.mul(&e, &f)
+	v.y.mul(&g, &h)
+	v.t.mul(&e, &h)
+	v.z.mul(&f, &g)
+	return v
+}
+
+// Subtract sets v = p - q, and returns v.
+func (v *Point) Subtract(p, q *Point) *Point {
+	var qNeg Point
+	return v.Add(p, qNeg.Negate(q))
+}
+
+// Negate sets v = -p, and returns v.
+func (v *Point) Negate(p *Point) *Point {
+	v.x.neg(&p.x)
+	v.y = p.y
+	v.z = p.z
+	v.t.neg(&p.t)
+	return v
+}
+
+// Equal returns 1 if v is equivalent to u, and 0 otherwise.
+func (v *Point) Equal(u *Point) int {
+	var t1, t2, t3, t4 fieldElement
+	t1.mul(&v.x, &u.z)
+	t2.mul(&u.x, &v.z)
+	t3.mul(&v.y, &u.z)
+	t4.mul(&u.y, &v.z)
+	return t1.equal(&t2) & t3.equal(&t4)
+}
+
+// selectPoint sets v to a if cond == 1 and to b if cond == 0.
+func (v *Point) selectPoint(a, b *Point, cond int) *Point {
+	v.x.selectFE(&a.x, &b.x, cond)
+	v.y.selectFE(&a.y, &b.y, cond)
+	v.z.selectFE(&a.z, &b.z, cond)
+	v.t.selectFE(&a.t, &b.t, cond)
+	return v
+}
+
+// ScalarMult sets v = x * q, and returns v.
+//
+// The scalar multiplication is done in constant time.
+func (v *Point) ScalarMult(x *Scalar, q *Point) *Point {
+	// Double-and-always-add, from the most significant bit. The addition
+	// formula is complete, so no special cases are needed.
+	s := x.Bytes()
+	p := *q
+	acc := NewIdentityPoint()
+	var sum Point
+	for i := 255; i >= 0; i-- {
+		acc.double(acc)
+		sum.Add(acc, &p)
+		acc.selectPoint(&sum, acc, int(s[i/8]>>uint(i%8))&1)
+	}
+	return v.Set(acc)
+}
+
+// ScalarBaseMult sets v = x * B, where B is the canonical generator, and
+// returns v.
+//
+// The scalar multiplication is done in constant time.
+func (v *Point) ScalarBaseMult(x *Scalar) *Point {
+	return v.ScalarMult(x, generator)
+}
+
+// VarTimeDoubleScalarBaseMult sets v = a * A + b * B, where B is the canonical
+// generator, and returns v.
+//
+// Execution time depends on the inputs.
+func (v *Point) VarTimeDoubleScalarBaseMult(a *Scalar, A *Point, b *Scalar) *Point {
+	var aA, bB Point
+	aA.ScalarMult(a, A)
+	bB.ScalarBaseMult(b)
+	return v.Add(&aA, &bB)
+}
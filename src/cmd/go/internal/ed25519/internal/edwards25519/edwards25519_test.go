@@ -0,0 +1,222 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edwards25519
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+var p, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed", 16)
+
+var order, _ = new(big.Int).SetString("1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed", 16)
+
+// bigFromLE interprets b as a little-endian integer.
+func bigFromLE(b []byte) *big.Int {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+// randomFieldElement returns a random field element with unreduced limbs,
+// as they can appear between operations.
+func randomFieldElement(r *rand.Rand) fieldElement {
+	var v fieldElement
+	for i := range v {
+		v[i] = r.Uint64() & ((1 << 52) - 1)
+	}
+	return v
+}
+
+func (v *fieldElement) toBig() *big.Int {
+	n := new(big.Int)
+	for i := 4; i >= 0; i-- {
+		n.Lsh(n, 51)
+		n.Add(n, new(big.Int).SetUint64(v[i]))
+	}
+	return n.Mod(n, p)
+}
+
+func TestFieldArithmetic(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		a, b := randomFieldElement(r), randomFieldElement(r)
+		A, B := a.toBig(), b.toBig()
+
+		var v fieldElement
+		check := func(op string, want *big.Int) {
+			t.Helper()
+			want.Mod(want, p)
+			if got := bigFromLE(v.bytes()); got.Cmp(want) != 0 {
+				t.Fatalf("%s(%v, %v) = %v, want %v", op, A, B, got, want)
+			}
+		}
+		v.add(&a, &b)
+		check("add", new(big.Int).Add(A, B))
+		v.sub(&a, &b)
+		check("sub", new(big.Int).Sub(A, B))
+		v.mul(&a, &b)
+		check("mul", new(big.Int).Mul(A, B))
+		v.square(&a)
+		check("square", new(big.Int).Mul(A, A))
+		v.invert(&a)
+		check("invert", new(big.Int).ModInverse(A, p))
+	}
+}
+
+func TestFieldBytes(t *testing.T) {
+	f := func(in [32]byte) bool {
+		var v fieldElement
+		v.setBytes(in[:])
+		in[31] &= 0x7f
+		want := new(big.Int).Mod(bigFromLE(in[:]), p)
+		return bigFromLE(v.bytes()).Cmp(want) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConstants(t *testing.T) {
+	var minusOne, t1 fieldElement
+	minusOne.neg(&feOne)
+	if t1.square(&sqrtM1).equal(&minusOne) != 1 {
+		t.Error("sqrtM1 is not a square root of -1")
+	}
+	var dd, n fieldElement
+	// d * 121666 == -121665
+	dd.mul(&d, &fieldElement{121666, 0, 0, 0, 0})
+	n.neg(&fieldElement{121665, 0, 0, 0, 0})
+	if dd.equal(&n) != 1 {
+		t.Error("d is not -121665/121666")
+	}
+	if n.add(&d, &d).equal(&d2) != 1 {
+		t.Error("d2 is not 2*d")
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	// These are the coordinates of B from RFC 8032, Section 5.1.
+	x := "216936d3cd6e53fec0a4e231fdd6dc5c692cc7609525a7b2c9562d608f25d51a"
+	y := "6666666666666666666666666666666666666666666666666666666666666658"
+	var zInv, gx, gy fieldElement
+	zInv.invert(&generator.z)
+	gx.mul(&generator.x, &zInv)
+	gy.mul(&generator.y, &zInv)
+	if got := bigFromLE(gx.bytes()).Text(16); got != x {
+		t.Errorf("generator x = %s, want %s", got, x)
+	}
+	if got := bigFromLE(gy.bytes()).Text(16); got != y {
+		t.Errorf("generator y = %s, want %s", got, y)
+	}
+}
+
+func TestScalarMultiplyAdd(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() (*Scalar, *big.Int) {
+		var b [64]byte
+		r.Read(b[:])
+		s := NewScalar().SetUniformBytes(b[:])
+		want := new(big.Int).Mod(bigFromLE(b[:]), order)
+		if got := bigFromLE(s.Bytes()); got.Cmp(want) != 0 {
+			t.Fatalf("SetUniformBytes(%x) = %v, want %v", b, got, want)
+		}
+		return s, want
+	}
+	for i := 0; i < 1000; i++ {
+		x, X := random()
+		y, Y := random()
+		z, Z := random()
+		got := NewScalar().MultiplyAdd(x, y, z)
+		want := new(big.Int).Mul(X, Y)
+		want.Add(want, Z).Mod(want, order)
+		if bigFromLE(got.Bytes()).Cmp(want) != 0 {
+			t.Fatalf("MultiplyAdd(%v, %v, %v) = %v, want %v", X, Y, Z, bigFromLE(got.Bytes()), want)
+		}
+	}
+}
+
+func TestScalarSetCanonicalBytes(t *testing.T) {
+	l := (&Scalar{s: l}).Bytes()
+	if _, err := NewScalar().SetCanonicalBytes(l); err == nil {
+		t.Error("SetCanonicalBytes accepted l")
+	}
+	l[0]--
+	s, err := NewScalar().SetCanonicalBytes(l)
+	if err != nil {
+		t.Fatalf("SetCanonicalBytes rejected l - 1: %v", err)
+	}
+	if !bytes.Equal(s.Bytes(), l) {
+		t.Errorf("SetCanonicalBytes(l - 1).Bytes() = %x, want %x", s.Bytes(), l)
+	}
+}
+
+func TestScalarMult(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var b [64]byte
+	r.Read(b[:])
+	x := NewScalar().SetUniformBytes(b[:])
+	r.Read(b[:])
+	y := NewScalar().SetUniformBytes(b[:])
+
+	// [x]([y]B) == [y]([x]B)
+	xB := new(Point).ScalarBaseMult(x)
+	yB := new(Point).ScalarBaseMult(y)
+	p1 := new(Point).ScalarMult(x, yB)
+	p2 := new(Point).ScalarMult(y, xB)
+	if p1.Equal(p2) != 1 {
+		t.Error("[x][y]B != [y][x]B")
+	}
+
+	// [x]B + [y]B == [x + y]B
+	sum := new(Point).Add(xB, yB)
+	one := NewScalar().SetUniformBytes(append([]byte{1}, make([]byte, 63)...))
+	xy := NewScalar().MultiplyAdd(x, one, y)
+	if sum.Equal(new(Point).ScalarBaseMult(xy)) != 1 {
+		t.Error("[x]B + [y]B != [x + y]B")
+	}
+
+	// [x]B - [x]B == identity
+	if new(Point).Subtract(xB, xB).Equal(identity) != 1 {
+		t.Error("[x]B - [x]B != identity")
+	}
+
+	// [l - 1]B + B == identity
+	lMinusOne := &Scalar{s: l}
+	lMinusOne.s[0]--
+	if new(Point).Add(new(Point).ScalarBaseMult(lMinusOne), generator).Equal(identity) != 1 {
+		t.Error("[l - 1]B + B != identity")
+	}
+}
+
+func TestPointBytesRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		var b [64]byte
+		r.Read(b[:])
+		x := NewScalar().SetUniformBytes(b[:])
+		p := new(Point).ScalarBaseMult(x)
+		enc := p.Bytes()
+		q, err := new(Point).SetBytes(enc)
+		if err != nil {
+			t.Fatalf("SetBytes(%x): %v", enc, err)
+		}
+		if q.Equal(p) != 1 {
+			t.Fatalf("SetBytes(%x) decoded a different point", enc)
+		}
+	}
+
+	// y = 2 is not the y-coordinate of any point on the curve.
+	bad, _ := hex.DecodeString("0200000000000000000000000000000000000000000000000000000000000000")
+	if _, err := new(Point).SetBytes(bad); err == nil {
+		t.Error("SetBytes accepted an invalid point")
+	}
+}
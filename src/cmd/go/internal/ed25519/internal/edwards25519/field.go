@@ -0,0 +1,371 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edwards25519
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"math/bits"
+)
+
+// fieldElement represents an element of the field GF(2^255-19). An element
+// t represents the integer t[0] + t[1]*2^51 + t[2]*2^102 + t[3]*2^153 +
+// t[4]*2^204.
+//
+// Between operations, all limbs are expected to be lower than 2^52.
+type fieldElement [5]uint64
+
+const maskLow51Bits uint64 = (1 << 51) - 1
+
+var (
+	feZero = fieldElement{0, 0, 0, 0, 0}
+	feOne  = fieldElement{1, 0, 0, 0, 0}
+
+	// d is the curve constant -121665/121666.
+	d = fieldElement{929955233495203, 466365720129213, 1662059464998953, 2033849074728123, 1442794654840575}
+	// d2 is 2*d.
+	d2 = fieldElement{1859910466990425, 932731440258426, 1072319116312658, 1815898335770999, 633789495995903}
+	// sqrtM1 is 2^((p-1)/4), a square root of -1.
+	sqrtM1 = fieldElement{1718705420411056, 234908883556509, 2233514472574048, 2117202627021982, 765476049583133}
+)
+
+// carryPropagate brings the limbs below 52 bits by applying the reduction
+// identity (a * 2^255 + b = a * 19 + b) to the l4 carry.
+func (v *fieldElement) carryPropagate() *fieldElement {
+	c0 := v[0] >> 51
+	c1 := v[1] >> 51
+	c2 := v[2] >> 51
+	c3 := v[3] >> 51
+	c4 := v[4] >> 51
+
+	v[0] = v[0]&maskLow51Bits + c4*19
+	v[1] = v[1]&maskLow51Bits + c0
+	v[2] = v[2]&maskLow51Bits + c1
+	v[3] = v[3]&maskLow51Bits + c2
+	v[4] = v[4]&maskLow51Bits + c3
+
+	return v
+}
+
+// reduce reduces v modulo 2^255 - 19, to its canonical representation.
+func (v *fieldElement) reduce() *fieldElement {
+	v.carryPropagate()
+
+	// After the light reduction we now have a field element representation
+	// v < 2^255 + 2^13 * 19, but need v < 2^255 - 19.
+
+	// If v >= 2^255 - 19, then v + 19 >= 2^255, which would overflow 2^255 - 1,
+	// generating a carry. That is, c will be 0 if v < 2^255 - 19, and 1 otherwise.
+	c := (v[0] + 19) >> 51
+	c = (v[1] + c) >> 51
+	c = (v[2] + c) >> 51
+	c = (v[3] + c) >> 51
+	c = (v[4] + c) >> 51
+
+	// If v < 2^255 - 19 and c = 0, this will be a no-op. Otherwise, it's
+	// effectively applying the reduction identity to the carry.
+	v[0] += 19 * c
+
+	v[1] += v[0] >> 51
+	v[0] = v[0] & maskLow51Bits
+	v[2] += v[1] >> 51
+	v[1] = v[1] & maskLow51Bits
+	v[3] += v[2] >> 51
+	v[2] = v[2] & maskLow51Bits
+	v[4] += v[3] >> 51
+	v[3] = v[3] & maskLow51Bits
+	// no additional carry
+	v[4] = v[4] & maskLow51Bits
+
+	return v
+}
+
+// add sets v = a + b and returns v.
+func (v *fieldElement) add(a, b *fieldElement) *fieldElement {
+	v[0] = a[0] + b[0]
+	v[1] = a[1] + b[1]
+	v[2] = a[2] + b[2]
+	v[3] = a[3] + b[3]
+	v[4] = a[4] + b[4]
+	return v.carryPropagate()
+}
+
+// sub sets v = a - b and returns v.
+func (v *fieldElement) sub(a, b *fieldElement) *fieldElement {
+	// We first add 2 * p, to guarantee the subtraction won't underflow, and
+	// then subtract b (which can be up to 2^255 + 2^13 * 19).
+	v[0] = (a[0] + 0xFFFFFFFFFFFDA) - b[0]
+	v[1] = (a[1] + 0xFFFFFFFFFFFFE) - b[1]
+	v[2] = (a[2] + 0xFFFFFFFFFFFFE) - b[2]
+	v[3] = (a[3] + 0xFFFFFFFFFFFFE) - b[3]
+	v[4] = (a[4] + 0xFFFFFFFFFFFFE) - b[4]
+	return v.carryPropagate()
+}
+
+// neg sets v = -a and returns v.
+func (v *fieldElement) neg(a *fieldElement) *fieldElement {
+	return v.sub(&feZero, a)
+}
+
+// uint128 holds a 128-bit number as two 64-bit limbs, for use in the
+// multiplication.
+type uint128 struct {
+	lo, hi uint64
+}
+
+// mul64 returns a * b.
+func mul64(a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	return uint128{lo, hi}
+}
+
+// addMul64 returns v + a * b.
+func addMul64(v uint128, a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	lo, c := bits.Add64(lo, v.lo, 0)
+	hi, _ = bits.Add64(hi, v.hi, c)
+	return uint128{lo, hi}
+}
+
+// shiftRightBy51 returns a >> 51. a is assumed to be at most 115 bits.
+func shiftRightBy51(a uint128) uint64 {
+	return (a.hi << (64 - 51)) | (a.lo >> 51)
+}
+
+// mul sets v = a * b and returns v.
+func (v *fieldElement) mul(a, b *fieldElement) *fieldElement {
+	a0, a1, a2, a3, a4 := a[0], a[1], a[2], a[3], a[4]
+	b0, b1, b2, b3, b4 := b[0], b[1], b[2], b[3], b[4]
+
+	// Limb multiplication works like pen-and-paper columnar multiplication,
+	// but with 51-bit limbs instead of digits. Limbs that overflow past
+	// 2^255 are folded back in by multiplying them by 19, as
+	// 2^255 = 19 mod p.
+	a1_19 := a1 * 19
+	a2_19 := a2 * 19
+	a3_19 := a3 * 19
+	a4_19 := a4 * 19
+
+	// r0 = a0×b0 + 19×(a1×b4 + a2×b3 + a3×b2 + a4×b1)
+	r0 := mul64(a0, b0)
+	r0 = addMul64(r0, a1_19, b4)
+	r0 = addMul64(r0, a2_19, b3)
+	r0 = addMul64(r0, a3_19, b2)
+	r0 = addMul64(r0, a4_19, b1)
+
+	// r1 = a0×b1 + a1×b0 + 19×(a2×b4 + a3×b3 + a4×b2)
+	r1 := mul64(a0, b1)
+	r1 = addMul64(r1, a1, b0)
+	r1 = addMul64(r1, a2_19, b4)
+	r1 = addMul64(r1, a3_19, b3)
+	r1 = addMul64(r1, a4_19, b2)
+
+	// r2 = a0×b2 + a1×b1 + a2×b0 + 19×(a3×b4 + a4×b3)
+	r2 := mul64(a0, b2)
+	r2 = addMul64(r2, a1, b1)
+	r2 = addMul64(r2, a2, b0)
+	r2 = addMul64(r2, a3_19, b4)
+	r2 = addMul64(r2, a4_19, b3)
+
+	// r3 = a0×b3 + a1×b2 + a2×b1 + a3×b0 + 19×a4×b4
+	r3 := mul64(a0, b3)
+	r3 = addMul64(r3, a1, b2)
+	r3 = addMul64(r3, a2, b1)
+	r3 = addMul64(r3, a3, b0)
+	r3 = addMul64(r3, a4_19, b4)
+
+	// r4 = a0×b4 + a1×b3 + a2×b2 + a3×b1 + a4×b0
+	r4 := mul64(a0, b4)
+	r4 = addMul64(r4, a1, b3)
+	r4 = addMul64(r4, a2, b2)
+	r4 = addMul64(r4, a3, b1)
+	r4 = addMul64(r4, a4, b0)
+
+	// Each of the rN is at most 2^115, so after shifting the carries are
+	// small enough to be added to the next limb without overflowing.
+	c0 := shiftRightBy51(r0)
+	c1 := shiftRightBy51(r1)
+	c2 := shiftRightBy51(r2)
+	c3 := shiftRightBy51(r3)
+	c4 := shiftRightBy51(r4)
+
+	v[0] = r0.lo&maskLow51Bits + c4*19
+	v[1] = r1.lo&maskLow51Bits + c0
+	v[2] = r2.lo&maskLow51Bits + c1
+	v[3] = r3.lo&maskLow51Bits + c2
+	v[4] = r4.lo&maskLow51Bits + c3
+
+	return v.carryPropagate()
+}
+
+// square sets v = a * a and returns v.
+func (v *fieldElement) square(a *fieldElement) *fieldElement {
+	return v.mul(a, a)
+}
+
+// squareN sets v = a^(2^n) and returns v. n must be at least 1.
+func (v *fieldElement) squareN(a *fieldElement, n int) *fieldElement {
+	v.square(a)
+	for i := 1; i < n; i++ {
+		v.square(v)
+	}
+	return v
+}
+
+// pow2250 returns z^(2^250-1) and z^11, the common prefix of the addition
+// chains of invert and pow22523.
+func pow2250(z *fieldElement) (z2250, z11 fieldElement) {
+	var z2, z9, z2_5_0, z2_10_0, z2_20_0, z2_50_0, z2_100_0, t fieldElement
+
+	z2.square(z)               // 2
+	t.squareN(&z2, 2)          // 8
+	z9.mul(&t, z)              // 9
+	z11.mul(&z9, &z2)          // 11
+	t.square(&z11)             // 22
+	z2_5_0.mul(&t, &z9)        // 2^5 - 2^0 = 31
+	t.squareN(&z2_5_0, 5)      // 2^10 - 2^5
+	z2_10_0.mul(&t, &z2_5_0)   // 2^10 - 2^0
+	t.squareN(&z2_10_0, 10)    // 2^20 - 2^10
+	z2_20_0.mul(&t, &z2_10_0)  // 2^20 - 2^0
+	t.squareN(&z2_20_0, 20)    // 2^40 - 2^20
+	t.mul(&t, &z2_20_0)        // 2^40 - 2^0
+	t.squareN(&t, 10)          // 2^50 - 2^10
+	z2_50_0.mul(&t, &z2_10_0)  // 2^50 - 2^0
+	t.squareN(&z2_50_0, 50)    // 2^100 - 2^50
+	z2_100_0.mul(&t, &z2_50_0) // 2^100 - 2^0
+	t.squareN(&z2_100_0, 100)  // 2^200 - 2^100
+	t.mul(&t, &z2_100_0)       // 2^200 - 2^0
+	t.squareN(&t, 50)          // 2^250 - 2^50
+	z2250.mul(&t, &z2_50_0)    // 2^250 - 2^0
+	return z2250, z11
+}
+
+// invert sets v = 1/z mod p and returns v. If z == 0, invert returns 0.
+func (v *fieldElement) invert(z *fieldElement) *fieldElement {
+	// Inversion is implemented as exponentiation with exponent p - 2,
+	// which is 2^255 - 21 = (2^250 - 1) * 2^5 + 11.
+	z2250, z11 := pow2250(z)
+	var t fieldElement
+	t.squareN(&z2250, 5) // 2^255 - 2^5
+	return v.mul(&t, &z11)
+}
+
+// pow22523 sets v = z^((p-5)/8) and returns v. (p-5)/8 is 2^252 - 3.
+func (v *fieldElement) pow22523(z *fieldElement) *fieldElement {
+	z2250, _ := pow2250(z)
+	var t fieldElement
+	t.squareN(&z2250, 2) // 2^252 - 2^2
+	return v.mul(&t, z)  // 2^252 - 3
+}
+
+// setBytes sets v to x, which must be a 32-byte little-endian encoding.
+// The most significant bit is ignored, as in RFC 7748, Section 5. Values
+// up to 2^255 - 1 are accepted, and reduced.
+func (v *fieldElement) setBytes(x []byte) *fieldElement {
+	if len(x) != 32 {
+		panic("edwards25519: invalid field element input size")
+	}
+
+	// Bits 0:51 (bytes 0:8, bits 0:64, shift 0, mask 51).
+	v[0] = binary.LittleEndian.Uint64(x[0:8])
+	v[0] &= maskLow51Bits
+	// Bits 51:102 (bytes 6:14, bits 48:112, shift 3, mask 51).
+	v[1] = binary.LittleEndian.Uint64(x[6:14]) >> 3
+	v[1] &= maskLow51Bits
+	// Bits 102:153 (bytes 12:20, bits 96:160, shift 6, mask 51).
+	v[2] = binary.LittleEndian.Uint64(x[12:20]) >> 6
+	v[2] &= maskLow51Bits
+	// Bits 153:204 (bytes 19:27, bits 152:216, shift 1, mask 51).
+	v[3] = binary.LittleEndian.Uint64(x[19:27]) >> 1
+	v[3] &= maskLow51Bits
+	// Bits 204:255 (bytes 24:32, bits 192:256, shift 12, mask 51).
+	// Note: not bytes 25:33, shift 4, to avoid overread.
+	v[4] = binary.LittleEndian.Uint64(x[24:32]) >> 12
+	v[4] &= maskLow51Bits
+
+	return v
+}
+
+// bytes returns the canonical 32-byte little-endian encoding of v.
+func (v *fieldElement) bytes() []byte {
+	t := *v
+	t.reduce()
+
+	out := make([]byte, 32)
+	var buf [8]byte
+	for i, l := range t {
+		bitsOffset := i * 51
+		binary.LittleEndian.PutUint64(buf[:], l<<uint(bitsOffset%8))
+		for i, bb := range buf {
+			off := bitsOffset/8 + i
+			if off >= len(out) {
+				break
+			}
+			out[off] |= bb
+		}
+	}
+	return out
+}
+
+// equal returns 1 if v and u are equal, and 0 otherwise.
+func (v *fieldElement) equal(u *fieldElement) int {
+	return subtle.ConstantTimeCompare(v.bytes(), u.bytes())
+}
+
+// isNegative returns 1 if v is negative, and 0 otherwise. A field element
+// is negative if its canonical encoding is odd, per RFC 8032, Section 5.1.2.
+func (v *fieldElement) isNegative() int {
+	return int(v.bytes()[0] & 1)
+}
+
+// mask64Bits returns 0xffffffffffffffff if cond is 1, and 0 otherwise.
+func mask64Bits(cond int) uint64 { return ^(uint64(cond) - 1) }
+
+// selectFE sets v to a if cond == 1, and to b if cond == 0.
+func (v *fieldElement) selectFE(a, b *fieldElement, cond int) *fieldElement {
+	m := mask64Bits(cond)
+	v[0] = (m & a[0]) | (^m & b[0])
+	v[1] = (m & a[1]) | (^m & b[1])
+	v[2] = (m & a[2]) | (^m & b[2])
+	v[3] = (m & a[3]) | (^m & b[3])
+	v[4] = (m & a[4]) | (^m & b[4])
+	return v
+}
+
+// sqrtRatio sets r to the non-negative square root of the ratio of u and v.
+//
+// If u/v is square, sqrtRatio returns r and 1. If u/v is not square,
+// sqrtRatio returns an unspecified value and 0.
+func (r *fieldElement) sqrtRatio(u, v *fieldElement) (rr *fieldElement, wasSquare int) {
+	// r = (u * v^3) * (u * v^7)^((p-5)/8)
+	var v2, uv3, uv7 fieldElement
+	v2.square(v)
+	uv3.mul(u, v2.mul(&v2, v))  // u * v^3
+	uv7.mul(&uv3, v2.square(v)) // u * v^7
+	uv7.mul(&uv7, &v2)
+	r.mul(&uv3, new(fieldElement).pow22523(&uv7))
+
+	var check fieldElement
+	check.mul(v, check.square(r)) // check = v * r^2
+
+	var uNeg fieldElement
+	uNeg.neg(u)
+	correctSignSqrt := check.equal(u)
+	flippedSignSqrt := check.equal(&uNeg)
+	var uNegI fieldElement
+	flippedSignSqrtI := check.equal(uNegI.mul(&uNeg, &sqrtM1))
+
+	var rPrime fieldElement
+	rPrime.mul(r, &sqrtM1) // r_prime = SQRT_M1 * r
+	// r = CT_SELECT(r_prime IF flipped_sign_sqrt | flipped_sign_sqrt_i ELSE r)
+	r.selectFE(&rPrime, r, flippedSignSqrt|flippedSignSqrtI)
+
+	// Choose the non-negative square root.
+	var rNeg fieldElement
+	rNeg.neg(r)
+	r.selectFE(&rNeg, r, r.isNegative())
+
+	return r, correctSignSqrt | flippedSignSqrt
+}
@@ -0,0 +1,172 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edwards25519
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// A Scalar is an integer modulo
+//
+//	l = 2^252 + 27742317777372353535851937790883648493
+//
+// which is the prime order of the edwards25519 group.
+//
+// The zero value is a valid zero element.
+type Scalar struct {
+	// s is the scalar as four 64-bit little-endian limbs.
+	// It is always fully reduced modulo l.
+	s [4]uint64
+}
+
+// l is the order of the edwards25519 group, as four little-endian limbs.
+var l = [4]uint64{0x5812631a5cf5d3ed, 0x14def9dea2f79cd6, 0, 0x1000000000000000}
+
+// NewScalar returns a new zero Scalar.
+func NewScalar() *Scalar {
+	return &Scalar{}
+}
+
+// reduceWide sets s = x mod l, in constant time.
+func (s *Scalar) reduceWide(x *[8]uint64) *Scalar {
+	// Shift the bits of x into r one at a time, from the most significant,
+	// subtracting l whenever r is at least l. r stays below l, so 2r + 1 fits
+	// in 254 bits, and a single conditional subtraction is enough.
+	var r [4]uint64
+	for i := 511; i >= 0; i-- {
+		bit := (x[i/64] >> uint(i%64)) & 1
+		r[3] = r[3]<<1 | r[2]>>63
+		r[2] = r[2]<<1 | r[1]>>63
+		r[1] = r[1]<<1 | r[0]>>63
+		r[0] = r[0]<<1 | bit
+
+		var t [4]uint64
+		var b uint64
+		t[0], b = bits.Sub64(r[0], l[0], 0)
+		t[1], b = bits.Sub64(r[1], l[1], b)
+		t[2], b = bits.Sub64(r[2], l[2], b)
+		t[3], b = bits.Sub64(r[3], l[3], b)
+		// If there was no borrow, r >= l and t is the reduced value.
+		m := b - 1
+		r[0] = t[0]&m | r[0]&^m
+		r[1] = t[1]&m | r[1]&^m
+		r[2] = t[2]&m | r[2]&^m
+		r[3] = t[3]&m | r[3]&^m
+	}
+	s.s = r
+	return s
+}
+
+// MultiplyAdd sets s = x * y + z mod l, and returns s.
+func (s *Scalar) MultiplyAdd(x, y, z *Scalar) *Scalar {
+	var w [8]uint64
+	for i := 0; i < 4; i++ {
+		var carry uint64
+		for j := 0; j < 4; j++ {
+			hi, lo := bits.Mul64(x.s[i], y.s[j])
+			var c uint64
+			lo, c = bits.Add64(lo, w[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			w[i+j] = lo
+			carry = hi
+		}
+		w[i+4] = carry
+	}
+
+	var c uint64
+	w[0], c = bits.Add64(w[0], z.s[0], 0)
+	w[1], c = bits.Add64(w[1], z.s[1], c)
+	w[2], c = bits.Add64(w[2], z.s[2], c)
+	w[3], c = bits.Add64(w[3], z.s[3], c)
+	for i := 4; i < 8; i++ {
+		w[i], c = bits.Add64(w[i], 0, c)
+	}
+
+	return s.reduceWide(&w)
+}
+
+// SetUniformBytes sets s to a uniformly distributed value given 64 uniformly
+// distributed random bytes, interpreted in little-endian order and reduced
+// modulo l. If x is not of the right length, SetUniformBytes panics.
+func (s *Scalar) SetUniformBytes(x []byte) *Scalar {
+	if len(x) != 64 {
+		panic("edwards25519: invalid SetUniformBytes input length")
+	}
+	var w [8]uint64
+	for i := range w {
+		w[i] = binary.LittleEndian.Uint64(x[i*8:])
+	}
+	return s.reduceWide(&w)
+}
+
+// SetCanonicalBytes sets s = x, where x is a 32-byte little-endian encoding of
+// s, and returns s. If x is not a canonical encoding of s, that is if it
+// encodes a value not lower than l, SetCanonicalBytes returns nil and an
+// error, and the receiver is unchanged.
+func (s *Scalar) SetCanonicalBytes(x []byte) (*Scalar, error) {
+	if len(x) != 32 {
+		return nil, errors.New("invalid scalar length")
+	}
+	var r [4]uint64
+	for i := range r {
+		r[i] = binary.LittleEndian.Uint64(x[i*8:])
+	}
+	// Check r < l, as a multi-precision subtraction that must borrow.
+	var b uint64
+	_, b = bits.Sub64(r[0], l[0], 0)
+	_, b = bits.Sub64(r[1], l[1], b)
+	_, b = bits.Sub64(r[2], l[2], b)
+	_, b = bits.Sub64(r[3], l[3], b)
+	if b == 0 {
+		return nil, errors.New("invalid scalar encoding")
+	}
+	s.s = r
+	return s, nil
+}
+
+// SetBytesWithClamping applies the buffer pruning described in RFC 8032,
+// Section 5.1.5 (also known as clamping) and sets s to the result. The input
+// must be 32 bytes, and it is not modified. If x is not of the right length,
+// SetBytesWithClamping panics.
+//
+// Note that since Scalar values are always reduced modulo the prime order of
+// the curve, the resulting value will not preserve any of the cofactor-clearing
+// properties that clamping is meant to provide. It will however work as
+// expected as long as it is applied to points on the prime order subgroup, like
+// in Ed25519. In fact, it is lost to history why RFC 8032 adopted the
+// irrelevant RFC 7748 clamping, but it is now required for compatibility.
+func (s *Scalar) SetBytesWithClamping(x []byte) *Scalar {
+	if len(x) != 32 {
+		panic("edwards25519: invalid SetBytesWithClamping input length")
+	}
+	var wide [64]byte
+	copy(wide[:], x)
+	wide[0] &= 248
+	wide[31] &= 63
+	wide[31] |= 64
+	return s.SetUniformBytes(wide[:])
+}
+
+// Bytes returns the canonical 32-byte little-endian encoding of s.
+func (s *Scalar) Bytes() []byte {
+	out := make([]byte, 32)
+	for i := range s.s {
+		binary.LittleEndian.PutUint64(out[i*8:], s.s[i])
+	}
+	return out
+}
+
+// Equal returns 1 if s and t are equal, and 0 otherwise.
+func (s *Scalar) Equal(t *Scalar) int {
+	var diff uint64
+	for i := range s.s {
+		diff |= s.s[i] ^ t.s[i]
+	}
+	return int((diff|-diff)>>63 ^ 1)
+}
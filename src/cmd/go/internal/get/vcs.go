@@ -5,6 +5,7 @@
 package get
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,8 +17,10 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"cmd/go/internal/base"
 	"cmd/go/internal/cfg"
@@ -43,6 +46,7 @@ type vcsCmd struct {
 
 	remoteRepo  func(v *vcsCmd, rootDir string) (remoteRepo string, err error)
 	resolveRepo func(v *vcsCmd, rootDir, remoteRepo string) (realRepo string, err error)
+	status      func(v *vcsCmd, rootDir string) (VCSStatus, error)
 }
 
 var defaultSecureScheme = map[string]bool{
@@ -130,6 +134,7 @@ var vcsHg = &vcsCmd{
 	scheme:     []string{"https", "http", "ssh"},
 	pingCmd:    "identify {scheme}://{repo}",
 	remoteRepo: hgRemoteRepo,
+	status:     hgStatus,
 }
 
 func hgRemoteRepo(vcsHg *vcsCmd, rootDir string) (remoteRepo string, err error) {
@@ -140,6 +145,59 @@ func hgRemoteRepo(vcsHg *vcsCmd, rootDir string) (remoteRepo string, err error)
 	return strings.TrimSpace(string(out)), nil
 }
 
+func hgStatus(vcsHg *vcsCmd, rootDir string) (VCSStatus, error) {
+	// Output changeset ID and seconds since epoch.
+	out, err := vcsHg.run1(rootDir, `log -l1 -T {node}:{date|hgdate}`, nil, false)
+	if err != nil {
+		return VCSStatus{}, err
+	}
+
+	// Successful execution without output indicates an empty repo (no commits).
+	var rev string
+	var commitTime time.Time
+	if len(out) > 0 {
+		// Strip trailing timezone offset.
+		if i := bytes.IndexByte(out, ' '); i > 0 {
+			out = out[:i]
+		}
+		rev, commitTime, err = parseRevTime(out)
+		if err != nil {
+			return VCSStatus{}, err
+		}
+	}
+
+	// Also look for untracked files.
+	out, err = vcsHg.run1(rootDir, "status", nil, false)
+	if err != nil {
+		return VCSStatus{}, err
+	}
+	uncommitted := len(out) > 0
+
+	return VCSStatus{
+		Revision:    rev,
+		CommitTime:  commitTime,
+		Uncommitted: uncommitted,
+	}, nil
+}
+
+// parseRevTime parses commit details in "revision:seconds" format.
+func parseRevTime(out []byte) (string, time.Time, error) {
+	buf := string(bytes.TrimSpace(out))
+
+	i := strings.IndexByte(buf, ':')
+	if i < 1 {
+		return "", time.Time{}, errors.New("unrecognized VCS tool output")
+	}
+	rev := buf[:i]
+
+	secs, err := strconv.ParseInt(buf[i+1:], 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unrecognized VCS tool output: %v", err)
+	}
+
+	return rev, time.Unix(secs, 0), nil
+}
+
 // vcsGit describes how to use Git.
 var vcsGit = &vcsCmd{
 	name: "Git",
@@ -167,6 +225,7 @@ var vcsGit = &vcsCmd{
 	scheme:     []string{"git", "https", "http", "git+ssh", "ssh"},
 	pingCmd:    "ls-remote {scheme}://{repo}",
 	remoteRepo: gitRemoteRepo,
+	status:     gitStatus,
 }
 
 // scpSyntaxRe matches the SCP-like addresses used by Git to access
@@ -217,6 +276,35 @@ func gitRemoteRepo(vcsGit *vcsCmd, rootDir string) (remoteRepo string, err error
 	return "", errParse
 }
 
+func gitStatus(vcsGit *vcsCmd, rootDir string) (VCSStatus, error) {
+	out, err := vcsGit.run1(rootDir, "status --porcelain", nil, false)
+	if err != nil {
+		return VCSStatus{}, err
+	}
+	uncommitted := len(out) > 0
+
+	// "git status" works for empty repositories, but "git log" does not.
+	// Assume there are no commits in the repo when "git log" fails with
+	// uncommitted files and skip tagging revision / committime.
+	var rev string
+	var commitTime time.Time
+	out, err = vcsGit.run1(rootDir, "-c log.showsignature=false log -1 --format=%H:%ct", nil, false)
+	if err != nil && !uncommitted {
+		return VCSStatus{}, err
+	} else if err == nil {
+		rev, commitTime, err = parseRevTime(out)
+		if err != nil {
+			return VCSStatus{}, err
+		}
+	}
+
+	return VCSStatus{
+		Revision:    rev,
+		CommitTime:  commitTime,
+		Uncommitted: uncommitted,
+	}, nil
+}
+
 // vcsBzr describes how to use Bazaar.
 var vcsBzr = &vcsCmd{
 	name: "Bazaar",
@@ -586,6 +674,46 @@ func vcsFromDir(dir, srcRoot string) (vcs *vcsCmd, root string, err error) {
 	return nil, "", fmt.Errorf("directory %q is not using a known version control system", origDir)
 }
 
+// A VCSStatus describes the state of a version control checkout.
+type VCSStatus struct {
+	Revision    string    // Optional.
+	CommitTime  time.Time // Optional.
+	Uncommitted bool      // Required.
+}
+
+// VCSStatusForDir finds the version control system whose checkout
+// contains dir and reports its name ("git", "hg", etc.), the root
+// directory of the checkout, and the state of the checkout.
+// It returns a nil error and an empty name if dir is not in a
+// checkout, or if the version control system cannot report
+// its state.
+func VCSStatusForDir(dir string) (vcs, root string, st VCSStatus, err error) {
+	dir = filepath.Clean(dir)
+	for {
+		for _, v := range vcsList {
+			if _, err := os.Stat(filepath.Join(dir, "."+v.cmd)); err != nil {
+				continue
+			}
+			if v.status == nil {
+				return "", "", VCSStatus{}, nil
+			}
+			if _, err := exec.LookPath(v.cmd); err != nil {
+				return "", "", VCSStatus{}, fmt.Errorf("directory %q uses %s, but %s is not installed", dir, v.cmd, v.cmd)
+			}
+			st, err := v.status(v, dir)
+			if err != nil {
+				return "", "", VCSStatus{}, err
+			}
+			return v.cmd, dir, st, nil
+		}
+		ndir := filepath.Dir(dir)
+		if len(ndir) >= len(dir) {
+			return "", "", VCSStatus{}, nil
+		}
+		dir = ndir
+	}
+}
+
 // checkNestedVCS checks for an incorrectly-nested VCS-inside-VCS
 // situation for dir, checking parents up until srcRoot.
 func checkNestedVCS(vcs *vcsCmd, dir, srcRoot string) error {
@@ -486,16 +486,36 @@ General-purpose environment variables:
 	GOCACHE
 		The directory where the go command will store cached
 		information for reuse in future builds.
+	GOCACHEPROG
+		A command (with optional space-separated flags) that implements an
+		external go command build cache. See 'go help cache'.
+	GOCOVERDIR
+		The directory into which programs built with 'go build -cover'
+		write their coverage counter data when they exit.
 	GOFLAGS
 		A space-separated list of -flag=value settings to apply
 		to go commands by default, when the given flag is known by
 		the current command. Flags listed on the command line
 		are applied after this list and therefore override it.
+	GONOPROXY
+		Comma-separated list of glob patterns (in the syntax of Go's path.Match)
+		of module path prefixes that should always be fetched directly,
+		bypassing GOPROXY. Defaults to GOPRIVATE.
+		See 'go help module-private'.
+	GONOSUMDB
+		Comma-separated list of glob patterns (in the syntax of Go's path.Match)
+		of module path prefixes that should not be verified using the checksum
+		database. Defaults to GOPRIVATE. See 'go help module-private'.
 	GOOS
 		The operating system for which to compile code.
 		Examples are linux, darwin, windows, netbsd.
 	GOPATH
 		For more details see: 'go help gopath'.
+	GOPRIVATE
+		Comma-separated list of glob patterns (in the syntax of Go's path.Match)
+		of module path prefixes that are private: by default they are fetched
+		directly and not verified using the checksum database.
+		See 'go help module-private'.
 	GOPROXY
 		URL of Go module proxy. See 'go help goproxy'.
 	GORACE
@@ -503,6 +523,9 @@ General-purpose environment variables:
 		See https://golang.org/doc/articles/race_detector.html.
 	GOROOT
 		The root of the go tree.
+	GOSUMDB
+		The name of the checksum database to use and optionally its public key and
+		URL. See 'go help module-auth'.
 	GOTMPDIR
 		The directory where the go command will write
 		temporary source files, packages, and binaries.
@@ -705,6 +728,19 @@ and running 'go env GOCACHE' prints the current cache directory.
 The go command periodically deletes cached data that has not been
 used recently. Running 'go clean -cache' deletes all cached data.
 
+Setting the GOCACHEPROG environment variable to a command (with optional
+space-separated flags) makes the go command use that program, instead of
+the GOCACHE directory, to store and retrieve cached data. This allows
+sharing a cache between machines, for example on a build farm. The go
+command starts the program once and talks to it with newline-delimited
+JSON messages over its standard input and output: the program first
+announces the commands it supports ("get", "put" and "close"), and then
+answers each request of the go command, identified by an ID, by
+reporting a cache hit or miss for an action ID, or by storing the output
+of an action, along with the path of a file on the local disk holding
+that output. See the documentation of ProgCache in the source of the
+cmd/go/internal/cache package for the details of the protocol.
+
 The build cache correctly accounts for changes to Go source files,
 compilers, compiler options, and so on: cleaning the cache explicitly
 should not be necessary in typical use. However, the build cache
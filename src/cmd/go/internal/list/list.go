@@ -202,18 +202,20 @@ When listing modules, the -f flag still specifies a format template
 applied to a Go struct, but now a Module struct:
 
     type Module struct {
-        Path      string       // module path
-        Version   string       // module version
-        Versions  []string     // available module versions (with -versions)
-        Replace   *Module      // replaced by this module
-        Time      *time.Time   // time version was created
-        Update    *Module      // available update, if any (with -u)
-        Main      bool         // is this the main module?
-        Indirect  bool         // is this module only an indirect dependency of main module?
-        Dir       string       // directory holding files for this module, if any
-        GoMod     string       // path to go.mod file for this module, if any
-        GoVersion string       // go version used in module
-        Error     *ModuleError // error loading module
+        Path       string       // module path
+        Version    string       // module version
+        Versions   []string     // available module versions (with -versions)
+        Replace    *Module      // replaced by this module
+        Time       *time.Time   // time version was created
+        Update     *Module      // available update, if any (with -u)
+        Main       bool         // is this the main module?
+        Indirect   bool         // is this module only an indirect dependency of main module?
+        Dir        string       // directory holding files for this module, if any
+        GoMod      string       // path to go.mod file for this module, if any
+        GoVersion  string       // go version used in module
+        Retracted  []string     // retraction information, if any (with -u)
+        Deprecated string       // deprecation message, if any (with -u)
+        Error      *ModuleError // error loading module
     }
 
     type ModuleError struct {
@@ -250,6 +252,16 @@ For example, 'go list -m -u all' might print:
     golang.org/x/text v0.3.0 [v0.4.0] => /tmp/text
     rsc.io/pdf v0.1.1 [v0.1.2]
 
+The -u flag also reports versions that have been retracted by the
+module author and modules that have been deprecated. If the current
+version of a module is retracted, list -u sets the Module's Retracted
+field to the author's rationale for retracting it (or to a single
+empty string if no rationale was given). If the latest version of the
+module's go.mod file has a "// Deprecated:" comment on its module
+statement, list -u sets the Module's Deprecated field to the comment's
+text. The Module's String method marks such modules with "(retracted)"
+or "(deprecated)". Retracted versions are never reported as updates.
+
 (For tools, 'go list -m -u -json all' may be more convenient to parse.)
 
 The -versions flag causes list to set the Module's Versions field
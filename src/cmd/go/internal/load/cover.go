@@ -0,0 +1,199 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package load
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"cmd/go/internal/base"
+	"cmd/go/internal/cfg"
+	"cmd/go/internal/str"
+)
+
+// DeclareCoverVars attaches the required cover variables names
+// to the files, to be used when annotating the files.
+func DeclareCoverVars(p *Package, files ...string) map[string]*CoverVar {
+	coverVars := make(map[string]*CoverVar)
+	coverIndex := 0
+	// We create the cover counters as new top-level variables in the package.
+	// We need to avoid collisions with user variables (GoCover_0 is unlikely but still)
+	// and more importantly with dot imports of other covered packages,
+	// so we append 12 hex digits from the SHA-256 of the import path.
+	// The point is only to avoid accidents, not to defeat users determined to
+	// break things.
+	sum := sha256.Sum256([]byte(p.ImportPath))
+	h := fmt.Sprintf("%x", sum[:6])
+	for _, file := range files {
+		if base.IsTestFile(file) {
+			continue
+		}
+		// For a package that is "local" (imported via ./ import or command line, outside GOPATH),
+		// we record the full path to the file name.
+		// Otherwise we record the import path, then a forward slash, then the file name.
+		// This makes profiles within GOPATH file system-independent.
+		// These names appear in the cmd/cover HTML interface.
+		var longFile string
+		if p.Internal.Local {
+			longFile = filepath.Join(p.Dir, file)
+		} else {
+			longFile = path.Join(p.ImportPath, file)
+		}
+		coverVars[file] = &CoverVar{
+			File: longFile,
+			Var:  fmt.Sprintf("GoCover_%d_%x", coverIndex, h),
+		}
+		coverIndex++
+	}
+	return coverVars
+}
+
+// CoverageRuntime is the package providing the run-time support
+// for binaries built with "go build -cover".
+const CoverageRuntime = "internal/coverage"
+
+// PrepareForCoverageBuild arranges for the packages in pkgs and their
+// dependencies to be built as requested by "go build -cover".
+//
+// The packages selected by -coverpkg, or by default the packages in the
+// main module (in GOPATH mode, the packages named on the command line),
+// are marked for instrumentation with the cover tool. Each main package
+// in pkgs then imports the instrumented packages it depends on along with
+// the coverage run-time support, and is given an additional source file
+// that registers their counters to be written to $GOCOVERDIR when the
+// program exits.
+func PrepareForCoverageBuild(pkgs []*Package) {
+	var match []func(*Package) bool
+	for _, pattern := range cfg.BuildCoverPkg {
+		match = append(match, MatchPackage(pattern, base.Cwd))
+	}
+	matched := make([]bool, len(match))
+	selected := func(p *Package) bool {
+		if match == nil {
+			if cfg.ModulesEnabled {
+				return p.Module != nil && p.Module.Main || p.Internal.CmdlineFiles
+			}
+			return p.Internal.CmdlinePkg
+		}
+		haveMatch := false
+		for i := range match {
+			if match[i](p) {
+				matched[i] = true
+				haveMatch = true
+			}
+		}
+		return haveMatch
+	}
+
+	covered := make(map[*Package]bool)
+	for _, p := range PackageList(pkgs) {
+		if !selected(p) {
+			continue
+		}
+		switch {
+		case p.Standard && p.ImportPath == "unsafe":
+			// There is nothing to cover in package unsafe; it comes from the compiler.
+			continue
+		case p.Standard && (p.ImportPath == CoverageRuntime || p.ImportPath == "runtime" || strings.HasPrefix(p.ImportPath, "runtime/internal")):
+			// The coverage run-time support and the runtime,
+			// which runs it at exit, are not instrumented.
+			continue
+		case cfg.BuildCoverMode == "atomic" && p.Standard && p.ImportPath == "sync/atomic":
+			// Atomic coverage mode uses sync/atomic,
+			// so we can't also do coverage on it.
+			continue
+		}
+		p.Internal.CoverMode = cfg.BuildCoverMode
+		p.Internal.CoverVars = DeclareCoverVars(p, str.StringList(p.GoFiles, p.CgoFiles)...)
+		if cfg.BuildCoverMode == "atomic" {
+			// sync/atomic import is inserted by the cover tool. See #18486
+			addImport(p, "sync/atomic")
+		}
+		covered[p] = true
+	}
+
+	// Warn about -coverpkg arguments that are not actually used.
+	for i := range match {
+		if !matched[i] {
+			fmt.Fprintf(os.Stderr, "warning: no packages being built depend on matches for pattern %s\n", cfg.BuildCoverPkg[i])
+		}
+	}
+
+	for _, p := range pkgs {
+		if p.Name != "main" || p.Internal.ForceLibrary {
+			continue
+		}
+		var deps []*Package
+		for _, p1 := range PackageList([]*Package{p}) {
+			if covered[p1] {
+				deps = append(deps, p1)
+			}
+		}
+		sort.Slice(deps, func(i, j int) bool { return deps[i].ImportPath < deps[j].ImportPath })
+		for _, p1 := range deps {
+			if p1 != p {
+				addImport(p, p1.ImportPath)
+			}
+		}
+		addImport(p, CoverageRuntime)
+		p.Internal.CoverMain = coverMainSource(p, deps)
+	}
+}
+
+// addImport adds the package with the given import path
+// to the imports of p, if it is not already there.
+func addImport(p *Package, path string) {
+	for _, p1 := range p.Internal.Imports {
+		if p1.ImportPath == path {
+			return
+		}
+	}
+	var stk ImportStack
+	p1 := LoadImport(path, "", nil, &stk, nil, 0)
+	if p1.Error != nil {
+		base.Fatalf("load %s: %v", path, p1.Error)
+	}
+	p.Internal.Imports = append(p.Internal.Imports, p1)
+}
+
+// coverMainSource returns the source of the file added to the main
+// package p to register the counters of the instrumented packages deps.
+func coverMainSource(p *Package, deps []*Package) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package main\n\nimport (\n")
+	fmt.Fprintf(&buf, "\t_cover %q\n", CoverageRuntime)
+	for i, p1 := range deps {
+		if p1 != p {
+			fmt.Fprintf(&buf, "\t_cover%d %q\n", i, p1.ImportPath)
+		}
+	}
+	fmt.Fprintf(&buf, ")\n\nfunc init() {\n")
+	for i, p1 := range deps {
+		prefix := fmt.Sprintf("_cover%d.", i)
+		if p1 == p {
+			// The counters of the main package itself
+			// are declared in this package.
+			prefix = ""
+		}
+		var files []string
+		for file := range p1.Internal.CoverVars {
+			files = append(files, file)
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			cover := p1.Internal.CoverVars[file]
+			v := prefix + cover.Var
+			fmt.Fprintf(&buf, "\t_cover.RegisterFile(%q, %s.Count[:], %s.Pos[:], %s.NumStmt[:])\n", cover.File, v, v, v)
+		}
+	}
+	fmt.Fprintf(&buf, "\t_cover.Initialize(%q)\n}\n", cfg.BuildCoverMode)
+	return buf.Bytes()
+}
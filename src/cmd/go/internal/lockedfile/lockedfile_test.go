@@ -172,3 +172,41 @@ func TestCanLockExistingFile(t *testing.T) {
 	f.Close()
 	wait(t)
 }
+
+func TestTransform(t *testing.T) {
+	dir, remove := mustTempDir(t)
+	defer remove()
+	path := filepath.Join(dir, "transform.txt")
+
+	// A missing file is transformed from empty contents.
+	err := lockedfile.Transform(path, func(old []byte) ([]byte, error) {
+		if len(old) != 0 {
+			t.Errorf("initial contents = %q, want empty", old)
+		}
+		return []byte("hello, world\n"), nil
+	})
+	if err != nil {
+		t.Fatalf("Transform: %v", err)
+	}
+
+	// Shrinking the file truncates the old tail.
+	if err := lockedfile.Transform(path, func(old []byte) ([]byte, error) {
+		return old[:5], nil
+	}); err != nil {
+		t.Fatalf("Transform: %v", err)
+	}
+	if data, err := lockedfile.Read(path); err != nil || string(data) != "hello" {
+		t.Fatalf("after shrinking Transform, Read = %q, %v; want %q, nil", data, err, "hello")
+	}
+
+	// A failed transform leaves the contents alone.
+	errFail := os.ErrInvalid
+	if err := lockedfile.Transform(path, func(old []byte) ([]byte, error) {
+		return nil, errFail
+	}); err != errFail {
+		t.Fatalf("failing Transform = %v, want %v", err, errFail)
+	}
+	if data, err := lockedfile.Read(path); err != nil || string(data) != "hello" {
+		t.Fatalf("after failing Transform, Read = %q, %v; want %q, nil", data, err, "hello")
+	}
+}
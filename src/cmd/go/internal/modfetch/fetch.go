@@ -400,8 +400,8 @@ func checkOneSum(mod module.Version, h string) {
 	defer goSum.mu.Unlock()
 	if initGoSum() {
 		checkOneSumLocked(mod, h)
-	} else if useNotary(mod) {
-		checkNotarySum(mod, h)
+	} else if useSumDB(mod) {
+		checkSumDB(mod, h)
 	}
 }
 
@@ -424,9 +424,9 @@ func checkOneSumLocked(mod module.Version, h string) {
 		return
 	}
 
-	if useNotary(mod) {
+	if useSumDB(mod) {
 		goSum.mu.Unlock()
-		checkNotarySum(mod, h) // dies if h is wrong
+		checkSumDB(mod, h) // dies if h is wrong
 		goSum.mu.Lock()
 
 		// Because we dropped the lock, a racing goroutine
@@ -444,16 +444,22 @@ func checkOneSumLocked(mod module.Version, h string) {
 	goSum.dirty = true
 }
 
-// checkNotarySum checks the mod, h pair against the Go notary.
+// checkSumDB checks the mod, h pair against the Go checksum database.
 // It calls base.Fatalf if the hash is to be rejected.
-func checkNotarySum(mod module.Version, h string) {
-	hashes := notaryHashes(mod)
-	for _, vh := range hashes {
-		if h == vh {
+func checkSumDB(mod module.Version, h string) {
+	db, lines, err := lookupSumDB(mod)
+	if err != nil {
+		base.Fatalf("verifying %s@%s: %v", mod.Path, mod.Version, err)
+	}
+
+	have := mod.Path + " " + mod.Version + " " + h
+	prefix := mod.Path + " " + mod.Version + " h1:"
+	for _, line := range lines {
+		if line == have {
 			return
 		}
-		if strings.HasPrefix(vh, "h1:") {
-			base.Fatalf("verifying %s@%s: checksum mismatch\n\tdownloaded: %v\n\tnotary:     %v"+notarySumMismatch, mod.Path, mod.Version, h, vh)
+		if strings.HasPrefix(line, prefix) {
+			base.Fatalf("verifying %s@%s: checksum mismatch\n\tdownloaded: %v\n\t%s: %v"+sumdbMismatch, mod.Path, mod.Version, h, db, line[len(prefix)-len("h1:"):])
 		}
 	}
 }
@@ -587,10 +593,10 @@ have intercepted the download attempt.
 For more information, see 'go help module-auth'.
 `
 
-const notarySumMismatch = `
+const sumdbMismatch = `
 
 SECURITY ERROR
-This download does NOT match the expected download known to the notary.
+This download does NOT match the one reported by the checksum server.
 The bits may have been replaced on the origin server, or an attacker may
 have intercepted the download attempt.
 
@@ -662,31 +668,93 @@ go.sum is wrong or the downloaded code is wrong. Usually go.sum is right:
 you want to use the same code you used yesterday.
 
 If a downloaded module is not yet included in go.sum and it is a publicly
-available module, the go command consults the Go notary server to fetch
+available module, the go command consults the Go checksum database to fetch
 the expected go.sum lines. If the downloaded code does not match those
 lines, the go command reports the mismatch and exits. Note that the
-notary is not consulted for module versions already listed in go.sum.
-
-The GONOVERIFY environment variable is a comma-separated list of
+database is not consulted for module versions already listed in go.sum.
+
+The checksum database is a transparency log: the go command checks
+that every go.sum line it is served is included in a signed log tree,
+and that each new signed tree it sees is consistent with (extends)
+the trees it has seen before. Verified lookups and log tiles are cached in
+$GOPATH/pkg/mod/cache/download/sumdb, and the latest known signed tree
+is recorded in $GOPATH/pkg/sumdb.
+
+The GOSUMDB environment variable identifies the name of the checksum database
+to use and optionally its public key and URL, as in:
+
+	GOSUMDB="sum.golang.org"
+	GOSUMDB="sum.golang.org+<publickey>"
+	GOSUMDB="sum.golang.org+<publickey> https://sum.golang.org"
+
+The go command knows the public key of sum.golang.org; use of any other
+database requires giving the public key explicitly. The URL defaults to
+"https://" followed by the database name. If GOPROXY names a module proxy,
+the go command first asks that proxy whether it can serve the database
+(by fetching <proxyURL>/sumdb/<name>/supported) and if so, accesses the
+database through the proxy.
+
+If GOSUMDB is set to "off", or if "go get" is invoked with the -insecure flag,
+the checksum database is never consulted, but note that this defeats the
+security provided by the database.
+
+The GONOSUMDB environment variable is a comma-separated list of
 patterns (in the syntax of Go's path.Match) of module path prefixes
-that should not be verified using the notary. For example,
+that should not be verified using the checksum database. It defaults
+to the value of GOPRIVATE (see 'go help module-private'). For example,
 
-	GONOVERIFY=*.corp.example.com,rsc.io/private
+	GONOSUMDB=*.corp.example.com,rsc.io/private
 
-disables notary verification for modules with path prefixes matching
-either pattern, including "git.corp.example.com/xyzzy", "rsc.io/private",
-and "rsc.io/private/quux".
-
-As a special case, if GONOVERIFY is set to "off", or if "go get" was invoked
-with the -insecure flag, the notary is never consulted, but note that this
-defeats the security provided by the notary. A better course of action is
-to set a narrower GONOVERIFY and, in the case of go.sum mismatches,
-investigate why the code downloaded code differs from what was
-downloaded yesterday.
+disables checksum database verification for modules with path prefixes
+matching either pattern, including "git.corp.example.com/xyzzy",
+"rsc.io/private", and "rsc.io/private/quux". A better course of action
+than disabling the database entirely is to set a narrower GONOSUMDB and,
+in the case of go.sum mismatches, investigate why the downloaded code
+differs from what was downloaded yesterday.
+`,
+}
 
-NOTE: Early in the Go 1.13 dev cycle, the notary is being simulated by
-a whitelist of known hashes for popular Go modules, to expose any
-problems arising from knowing the expected hashes.
-TODO(rsc): This note should be removed once the real notary is used instead. See #30601.
+var HelpModulePrivate = &base.Command{
+	UsageLine: "module-private",
+	Short:     "module configuration for non-public modules",
+	Long: `
+The go command defaults to downloading modules from the module proxy
+named by GOPROXY and validating them against the checksum database
+named by GOSUMDB. Modules that are not publicly available, such as
+those hosted on a company's internal version control server, cannot be
+served by a public proxy or listed in a public checksum database.
+
+The GOPRIVATE environment variable controls which modules the go command
+considers to be private (not available publicly) and should therefore
+not use the proxy or checksum database. The variable is a comma-separated
+list of glob patterns (in the syntax of Go's path.Match) of module path
+prefixes. For example,
+
+	GOPRIVATE=*.corp.example.com,rsc.io/private
+
+causes the go command to treat as private any module with a path prefix
+matching either pattern, including git.corp.example.com/xyzzy, rsc.io/private,
+and rsc.io/private/quux. Private modules are downloaded directly from
+their version control systems and are not checked against the checksum
+database.
+
+For more fine-grained control over module download and validation,
+the GONOPROXY and GONOSUMDB environment variables accept the same kind
+of glob list and override GOPRIVATE for the specific decision of whether
+to use the proxy and checksum database, respectively.
+
+For example, if a company ran a module proxy serving private modules,
+users would configure go using:
+
+	GOPRIVATE=*.corp.example.com
+	GOPROXY=https://proxy.corp.example.com
+	GONOPROXY=none
+
+This would tell the go command to use the corporate proxy for all modules,
+including private ones (no module path matches the pattern "none"),
+while still skipping the checksum database for private modules.
+
+The 'go env' command reports the effective values of GONOPROXY and
+GONOSUMDB, taking the GOPRIVATE default into account.
 `,
 }
@@ -0,0 +1,25 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build cmd_go_bootstrap
+
+// This code is compiled only into the bootstrap 'go' binary.
+// These stubs avoid importing the checksum database client,
+// which uses net/http.
+
+package modfetch
+
+import (
+	"errors"
+
+	"cmd/go/internal/module"
+)
+
+func useSumDB(mod module.Version) bool {
+	return false
+}
+
+func lookupSumDB(mod module.Version) (string, []string, error) {
+	return "", nil, errors.New("no sumdb in bootstrap go command")
+}
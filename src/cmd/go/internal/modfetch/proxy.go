@@ -29,7 +29,8 @@ further control over the download source. If GOPROXY is unset, is the empty stri
 or is the string "direct", downloads use the default direct connection to version
 control systems. Setting GOPROXY to "off" disallows downloading modules from
 any source. Otherwise, GOPROXY is expected to be the URL of a module proxy,
-in which case the go command will fetch all modules from that proxy.
+in which case the go command will fetch all modules from that proxy,
+except those with paths matching GONOPROXY (see 'go help module-private').
 No matter the source of the modules, downloaded modules must match existing
 entries in go.sum (see 'go help modules' for discussion of verification).
 
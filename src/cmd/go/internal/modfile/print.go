@@ -137,11 +137,7 @@ func (p *printer) expr(x Expr) {
 		p.printf(")")
 
 	case *Line:
-		sep := ""
-		for _, tok := range x.Token {
-			p.printf("%s%s", sep, tok)
-			sep = " "
-		}
+		p.tokens(x.Token)
 
 	case *LineBlock:
 		for _, tok := range x.Token {
@@ -162,3 +158,20 @@ func (p *printer) expr(x Expr) {
 	// reach the end of the line.
 	p.comment = append(p.comment, x.Comment().Suffix...)
 }
+
+// tokens prints the tokens of a line separated by spaces,
+// except inside the brackets of a version interval,
+// which print as [v1.0.0, v1.1.0].
+func (p *printer) tokens(tokens []string) {
+	sep := ""
+	for _, tok := range tokens {
+		if tok == "," || tok == "]" {
+			sep = ""
+		}
+		p.printf("%s%s", sep, tok)
+		sep = " "
+		if tok == "[" {
+			sep = ""
+		}
+	}
+}
@@ -501,11 +501,7 @@ func (in *input) lex(sym *symType) int {
 		in.readRune()
 		return c
 
-	case '(':
-		in.readRune()
-		return c
-
-	case ')':
+	case '(', ')', '[', ']', ',':
 		in.readRune()
 		return c
 
@@ -555,8 +551,13 @@ func (in *input) lex(sym *symType) int {
 }
 
 // isIdent reports whether c is an identifier rune.
-// We treat nearly all runes as identifier runes.
+// We treat nearly all runes as identifier runes,
+// except the brackets and commas used in version intervals.
 func isIdent(c int) bool {
+	switch c {
+	case '[', ']', ',':
+		return false
+	}
 	return c != 0 && !unicode.IsSpace(rune(c))
 }
 
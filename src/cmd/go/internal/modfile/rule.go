@@ -26,14 +26,17 @@ type File struct {
 	Require []*Require
 	Exclude []*Exclude
 	Replace []*Replace
+	Retract []*Retract
+	Vet     []*Vet
 
 	Syntax *FileSyntax
 }
 
 // A Module is the module statement.
 type Module struct {
-	Mod    module.Version
-	Syntax *Line
+	Mod        module.Version
+	Deprecated string // text of "// Deprecated:" comment, if any
+	Syntax     *Line
 }
 
 // A Go is the go statement.
@@ -62,6 +65,28 @@ type Replace struct {
 	Syntax *Line
 }
 
+// A VersionInterval represents a range of versions with upper and lower bounds.
+// Intervals are closed: both bounds are included. When Low is equal to High,
+// the interval may refer to a single version ('v1.2.3') or an interval
+// ('[v1.2.3, v1.2.3]'); both have the same representation.
+type VersionInterval struct {
+	Low, High string
+}
+
+// A Retract is a single retract statement.
+type Retract struct {
+	VersionInterval
+	Rationale string // text of comments on the retract statement
+	Syntax    *Line
+}
+
+// A Vet is a single vet statement, naming the import path of
+// an additional analysis tool for 'go vet' to run.
+type Vet struct {
+	Path   string
+	Syntax *Line
+}
+
 func (f *File) AddModuleStmt(path string) error {
 	if f.Syntax == nil {
 		f.Syntax = new(FileSyntax)
@@ -125,7 +150,7 @@ func parseToFile(file string, data []byte, fix VersionFixer, strict bool) (*File
 	for _, x := range fs.Stmt {
 		switch x := x.(type) {
 		case *Line:
-			f.add(&errs, x, x.Token[0], x.Token[1:], fix, strict)
+			f.add(&errs, nil, x, x.Token[0], x.Token[1:], fix, strict)
 
 		case *LineBlock:
 			if len(x.Token) > 1 {
@@ -140,9 +165,9 @@ func parseToFile(file string, data []byte, fix VersionFixer, strict bool) (*File
 					fmt.Fprintf(&errs, "%s:%d: unknown block type: %s\n", file, x.Start.Line, strings.Join(x.Token, " "))
 				}
 				continue
-			case "module", "require", "exclude", "replace":
+			case "module", "require", "exclude", "replace", "retract", "vet":
 				for _, l := range x.Line {
-					f.add(&errs, l, x.Token[0], l.Token, fix, strict)
+					f.add(&errs, x, l, x.Token[0], l.Token, fix, strict)
 				}
 			}
 		}
@@ -156,16 +181,18 @@ func parseToFile(file string, data []byte, fix VersionFixer, strict bool) (*File
 
 var GoVersionRE = lazyregexp.New(`([1-9][0-9]*)\.(0|[1-9][0-9]*)`)
 
-func (f *File) add(errs *bytes.Buffer, line *Line, verb string, args []string, fix VersionFixer, strict bool) {
+func (f *File) add(errs *bytes.Buffer, block *LineBlock, line *Line, verb string, args []string, fix VersionFixer, strict bool) {
 	// If strict is false, this module is a dependency.
 	// We ignore all unknown directives as well as main-module-only
 	// directives like replace and exclude. It will work better for
 	// forward compatibility if we can depend on modules that have unknown
 	// statements (presumed relevant only when acting as the main module)
 	// and simply ignore those statements.
+	// Retractions are the exception: they are only meaningful
+	// when read from a dependency's go.mod file.
 	if !strict {
 		switch verb {
-		case "module", "require", "go":
+		case "module", "require", "go", "retract":
 			// want these even for dependency go.mods
 		default:
 			return
@@ -204,6 +231,7 @@ func (f *File) add(errs *bytes.Buffer, line *Line, verb string, args []string, f
 			return
 		}
 		f.Module.Mod = module.Version{Path: s}
+		f.Module.Deprecated = parseDeprecation(block, line)
 	case "require", "exclude":
 		if len(args) != 2 {
 			fmt.Fprintf(errs, "%s:%d: usage: %s module/path v1.2.3\n", f.Syntax.Name, line.Start.Line, verb)
@@ -312,7 +340,143 @@ func (f *File) add(errs *bytes.Buffer, line *Line, verb string, args []string, f
 			New:    module.Version{Path: ns, Version: nv},
 			Syntax: line,
 		})
+	case "retract":
+		vi, err := parseVersionInterval(verb, &args)
+		if err != nil {
+			fmt.Fprintf(errs, "%s:%d: %v\n", f.Syntax.Name, line.Start.Line, err)
+			return
+		}
+		if len(args) > 0 {
+			fmt.Fprintf(errs, "%s:%d: unexpected token after version: %q\n", f.Syntax.Name, line.Start.Line, args[0])
+			return
+		}
+		f.Retract = append(f.Retract, &Retract{
+			VersionInterval: vi,
+			Rationale:       parseDirectiveComment(block, line),
+			Syntax:          line,
+		})
+	case "vet":
+		if len(args) != 1 {
+			fmt.Fprintf(errs, "%s:%d: usage: vet import/path/of/tool\n", f.Syntax.Name, line.Start.Line)
+			return
+		}
+		s, err := parseString(&args[0])
+		if err != nil {
+			fmt.Fprintf(errs, "%s:%d: invalid quoted string: %v\n", f.Syntax.Name, line.Start.Line, err)
+			return
+		}
+		if err := module.CheckImportPath(s); err != nil {
+			fmt.Fprintf(errs, "%s:%d: invalid vet tool path: %v\n", f.Syntax.Name, line.Start.Line, err)
+			return
+		}
+		f.Vet = append(f.Vet, &Vet{
+			Path:   s,
+			Syntax: line,
+		})
+	}
+}
+
+// parseVersionInterval parses a single version or a bracketed
+// interval "[low, high]" from the front of *args, advancing *args
+// past the tokens it consumed.
+func parseVersionInterval(verb string, args *[]string) (VersionInterval, error) {
+	toks := *args
+	if len(toks) == 0 || toks[0] == "(" {
+		return VersionInterval{}, fmt.Errorf("expected '[' or version")
+	}
+	if toks[0] != "[" {
+		v, err := parseRetractVersion(verb, &toks[0])
+		if err != nil {
+			return VersionInterval{}, err
+		}
+		*args = toks[1:]
+		return VersionInterval{Low: v, High: v}, nil
+	}
+	toks = toks[1:]
+
+	if len(toks) == 0 {
+		return VersionInterval{}, fmt.Errorf("expected version after '['")
+	}
+	low, err := parseRetractVersion(verb, &toks[0])
+	if err != nil {
+		return VersionInterval{}, err
+	}
+	toks = toks[1:]
+
+	if len(toks) == 0 || toks[0] != "," {
+		return VersionInterval{}, fmt.Errorf("expected ',' after version")
 	}
+	toks = toks[1:]
+
+	if len(toks) == 0 {
+		return VersionInterval{}, fmt.Errorf("expected version after ','")
+	}
+	high, err := parseRetractVersion(verb, &toks[0])
+	if err != nil {
+		return VersionInterval{}, err
+	}
+	toks = toks[1:]
+
+	if len(toks) == 0 || toks[0] != "]" {
+		return VersionInterval{}, fmt.Errorf("expected ']' after version")
+	}
+	toks = toks[1:]
+
+	if semver.Compare(low, high) > 0 {
+		return VersionInterval{}, fmt.Errorf("version interval lower bound %s must not be greater than upper bound %s", low, high)
+	}
+	*args = toks
+	return VersionInterval{Low: low, High: high}, nil
+}
+
+// parseRetractVersion parses a version in a retract statement.
+// Retracted versions must be canonical semantic versions:
+// unlike versions in require statements, they are not
+// passed through a VersionFixer.
+func parseRetractVersion(verb string, s *string) (string, error) {
+	t, err := parseString(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid quoted string: %v", err)
+	}
+	if !semver.IsValid(t) || semver.Canonical(t) != t {
+		return "", fmt.Errorf("%s version must be canonical, like v1.2.3: %q", verb, t)
+	}
+	return t, nil
+}
+
+// parseDirectiveComment extracts the text of comments on a directive.
+// If the directive's line does not have comments and is part of a block that
+// does have comments, the block's comments are used.
+func parseDirectiveComment(block *LineBlock, line *Line) string {
+	comments := line.Comment()
+	if block != nil && len(comments.Before) == 0 && len(comments.Suffix) == 0 {
+		comments = block.Comment()
+	}
+	groups := [][]Comment{comments.Before, comments.Suffix}
+	var lines []string
+	for _, g := range groups {
+		for _, c := range g {
+			if !strings.HasPrefix(c.Token, "//") {
+				continue // blank line
+			}
+			lines = append(lines, strings.TrimSpace(strings.TrimPrefix(c.Token, "//")))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+var deprecatedRE = lazyregexp.New(`(?s)(?:^|\n\n)Deprecated: *(.*?)(?:$|\n\n)`)
+
+// parseDeprecation extracts the text of the first paragraph of the
+// module statement's comments that begins with "Deprecated:".
+// See https://golang.org/wiki/Deprecated.
+func parseDeprecation(block *LineBlock, line *Line) string {
+	text := parseDirectiveComment(block, line)
+	m := deprecatedRE.FindStringSubmatch(text)
+	if m == nil {
+		return ""
+	}
+	return m[1]
 }
 
 // isIndirect reports whether line has a "// indirect" comment,
@@ -474,6 +638,24 @@ func (f *File) Cleanup() {
 	}
 	f.Replace = f.Replace[:w]
 
+	w = 0
+	for _, r := range f.Retract {
+		if r.Low != "" || r.High != "" {
+			f.Retract[w] = r
+			w++
+		}
+	}
+	f.Retract = f.Retract[:w]
+
+	w = 0
+	for _, v := range f.Vet {
+		if v.Path != "" {
+			f.Vet[w] = v
+			w++
+		}
+	}
+	f.Vet = f.Vet[:w]
+
 	f.Syntax.Cleanup()
 }
 
@@ -658,6 +840,79 @@ func (f *File) DropReplace(oldPath, oldVers string) error {
 	return nil
 }
 
+// AddRetract adds a retract statement for the version interval vi,
+// with the given rationale as its comment. If an identical interval
+// is already retracted, only its rationale is updated.
+func (f *File) AddRetract(vi VersionInterval, rationale string) error {
+	var comments Comments
+	if rationale != "" {
+		for _, line := range strings.Split(rationale, "\n") {
+			comments.Before = append(comments.Before, Comment{Token: "// " + line})
+		}
+	}
+
+	for _, r := range f.Retract {
+		if r.VersionInterval == vi {
+			r.Rationale = rationale
+			r.Syntax.Comments.Before = comments.Before
+			r.Syntax.Comments.Suffix = nil
+			return nil
+		}
+	}
+
+	var tokens []string
+	if vi.Low == vi.High {
+		tokens = []string{"retract", AutoQuote(vi.Low)}
+	} else {
+		tokens = []string{"retract", "[", AutoQuote(vi.Low), ",", AutoQuote(vi.High), "]"}
+	}
+	r := &Retract{
+		VersionInterval: vi,
+		Rationale:       rationale,
+		Syntax:          f.Syntax.addLine(nil, tokens...),
+	}
+	r.Syntax.Comments.Before = comments.Before
+	f.Retract = append(f.Retract, r)
+	return nil
+}
+
+// DropRetract removes the retract statement for the version interval vi.
+func (f *File) DropRetract(vi VersionInterval) error {
+	for _, r := range f.Retract {
+		if r.VersionInterval == vi {
+			f.Syntax.removeLine(r.Syntax)
+			*r = Retract{}
+		}
+	}
+	return nil
+}
+
+// AddVet adds a vet statement for the tool with the given import path,
+// unless one is already present.
+func (f *File) AddVet(path string) error {
+	for _, v := range f.Vet {
+		if v.Path == path {
+			return nil
+		}
+	}
+	f.Vet = append(f.Vet, &Vet{
+		Path:   path,
+		Syntax: f.Syntax.addLine(nil, "vet", AutoQuote(path)),
+	})
+	return nil
+}
+
+// DropVet removes the vet statement for the tool with the given import path.
+func (f *File) DropVet(path string) error {
+	for _, v := range f.Vet {
+		if v.Path == path {
+			f.Syntax.removeLine(v.Syntax)
+			*v = Vet{}
+		}
+	}
+	return nil
+}
+
 func (f *File) SortBlocks() {
 	f.removeDups() // otherwise sorting is unsafe
 
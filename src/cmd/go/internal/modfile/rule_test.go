@@ -7,6 +7,8 @@ package modfile
 import (
 	"bytes"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -88,3 +90,293 @@ func TestAddRequire(t *testing.T) {
 		})
 	}
 }
+
+var parseRetractTests = []struct {
+	desc string
+	in   string
+	want []Retract
+	err  string
+}{
+	{
+		"single",
+		`
+		module m
+		retract v1.2.3 // bad build
+		`,
+		[]Retract{{VersionInterval{"v1.2.3", "v1.2.3"}, "bad build", nil}},
+		"",
+	},
+	{
+		"interval",
+		`
+		module m
+		// security hole
+		retract [v1.0.0, v1.1.1]
+		`,
+		[]Retract{{VersionInterval{"v1.0.0", "v1.1.1"}, "security hole", nil}},
+		"",
+	},
+	{
+		"block",
+		`
+		module m
+		// Both broken.
+		retract (
+			v1.0.0
+			// Published accidentally.
+			v1.9.0
+		)
+		`,
+		[]Retract{
+			{VersionInterval{"v1.0.0", "v1.0.0"}, "Both broken.", nil},
+			{VersionInterval{"v1.9.0", "v1.9.0"}, "Published accidentally.", nil},
+		},
+		"",
+	},
+	{
+		"reversed",
+		`
+		module m
+		retract [v1.2.0, v1.1.0]
+		`,
+		nil,
+		"lower bound v1.2.0 must not be greater than upper bound v1.1.0",
+	},
+	{
+		"noncanonical",
+		`
+		module m
+		retract v1.2
+		`,
+		nil,
+		`retract version must be canonical, like v1.2.3: "v1.2"`,
+	},
+	{
+		"unterminated",
+		`
+		module m
+		retract [v1.0.0, v1.1.0
+		`,
+		nil,
+		"expected ']' after version",
+	},
+}
+
+func TestParseRetract(t *testing.T) {
+	for _, tt := range parseRetractTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f, err := Parse("in", []byte(tt.in), nil)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Parse: error %v, want error containing %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(f.Retract) != len(tt.want) {
+				t.Fatalf("got %d retractions, want %d", len(f.Retract), len(tt.want))
+			}
+			for i, r := range f.Retract {
+				if r.VersionInterval != tt.want[i].VersionInterval || r.Rationale != tt.want[i].Rationale {
+					t.Errorf("retraction %d = %v %q, want %v %q", i, r.VersionInterval, r.Rationale, tt.want[i].VersionInterval, tt.want[i].Rationale)
+				}
+			}
+
+			// Dependency go.mod files must keep their retractions.
+			lax, err := ParseLax("in", []byte(tt.in), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(lax.Retract) != len(tt.want) {
+				t.Errorf("ParseLax: got %d retractions, want %d", len(lax.Retract), len(tt.want))
+			}
+		})
+	}
+}
+
+var moduleDeprecatedTests = []struct {
+	in   string
+	want string
+}{
+	{
+		`module m`,
+		"",
+	},
+	{
+		`// Deprecated: use example.com/n instead.
+		module m`,
+		"use example.com/n instead.",
+	},
+	{
+		`module m // Deprecated: in-line comment`,
+		"in-line comment",
+	},
+	{
+		`// Package m is a module.
+		//
+		// Deprecated: second paragraph.
+		module m`,
+		"second paragraph.",
+	},
+	{
+		`// Not Deprecated: not at paragraph start.
+		module m`,
+		"",
+	},
+}
+
+func TestModuleDeprecated(t *testing.T) {
+	for i, tt := range moduleDeprecatedTests {
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			f, err := ParseLax("in", []byte(tt.in), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f.Module.Deprecated != tt.want {
+				t.Errorf("Deprecated = %q, want %q", f.Module.Deprecated, tt.want)
+			}
+		})
+	}
+}
+
+var addRetractTests = []struct {
+	desc      string
+	in        string
+	low, high string
+	rationale string
+	out       string
+}{
+	{
+		"new",
+		`
+		module m
+		`,
+		"v1.2.3", "v1.2.3", "",
+		`
+		module m
+		retract v1.2.3
+		`,
+	},
+	{
+		"interval with rationale",
+		`
+		module m
+		retract v1.0.0
+		`,
+		"v1.2.0", "v1.2.9", "bad builds",
+		`
+		module m
+		retract (
+			v1.0.0
+			// bad builds
+			[v1.2.0, v1.2.9]
+		)
+		`,
+	},
+	{
+		"update rationale",
+		`
+		module m
+		retract v1.2.3 // old
+		`,
+		"v1.2.3", "v1.2.3", "new",
+		`
+		module m
+		// new
+		retract v1.2.3
+		`,
+	},
+}
+
+func TestAddRetract(t *testing.T) {
+	for _, tt := range addRetractTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f, err := Parse("in", []byte(tt.in), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			g, err := Parse("out", []byte(tt.out), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden, err := g.Format()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := f.AddRetract(VersionInterval{tt.low, tt.high}, tt.rationale); err != nil {
+				t.Fatal(err)
+			}
+			out, err := f.Format()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(out, golden) {
+				t.Errorf("have:\n%s\nwant:\n%s", out, golden)
+			}
+
+			if err := f.DropRetract(VersionInterval{tt.low, tt.high}); err != nil {
+				t.Fatal(err)
+			}
+			f.Cleanup()
+			for _, r := range f.Retract {
+				if r.VersionInterval == (VersionInterval{tt.low, tt.high}) {
+					t.Errorf("DropRetract left %v in place", r.VersionInterval)
+				}
+			}
+		})
+	}
+}
+
+func TestParseVet(t *testing.T) {
+	in := `
+	module m
+	vet example.com/analyzers/cmd/nilness
+	vet (
+		example.com/other/cmd/checker
+	)
+	`
+	f, err := Parse("in", []byte(in), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, v := range f.Vet {
+		paths = append(paths, v.Path)
+	}
+	want := []string{"example.com/analyzers/cmd/nilness", "example.com/other/cmd/checker"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("vet paths = %q, want %q", paths, want)
+	}
+
+	// Vet tools are chosen by the main module only.
+	lax, err := ParseLax("in", []byte(in), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lax.Vet) != 0 {
+		t.Errorf("ParseLax: got %d vet tools, want 0", len(lax.Vet))
+	}
+
+	if _, err := Parse("in", []byte("module m\nvet a b\n"), nil); err == nil || !strings.Contains(err.Error(), "usage: vet") {
+		t.Errorf("Parse(vet a b): error %v, want usage error", err)
+	}
+
+	if err := f.DropVet("example.com/other/cmd/checker"); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.AddVet("example.com/third/cmd/lint"); err != nil {
+		t.Fatal(err)
+	}
+	f.Cleanup()
+	out, err := f.Format()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantOut := "module m\n\nvet example.com/analyzers/cmd/nilness\n\nvet example.com/third/cmd/lint\n"
+	if string(out) != wantOut {
+		t.Errorf("Format:\n%s\nwant:\n%s", out, wantOut)
+	}
+}
@@ -510,6 +510,10 @@ func runGet(cmd *base.Command, args []string) {
 	modload.AllowWriteGoMod()
 	modload.WriteGoMod()
 
+	// Warn about modules named on the command line
+	// that their authors have retracted or deprecated.
+	warnRetracted(byPath)
+
 	// If -m was specified, we're done after the module work. No download, no build.
 	if *getM {
 		return
@@ -576,6 +580,46 @@ func getQuery(path, vers string, forceModulePath bool) (module.Version, error) {
 	return m, err
 }
 
+// warnRetracted prints warnings for the modules named on the command line
+// whose selected versions have been retracted or which have been deprecated
+// by their authors. Retractions and deprecations never cause go get to fail.
+func warnRetracted(byPath map[string]*task) {
+	var mods []module.Version
+	for _, m := range modload.BuildList()[1:] {
+		if byPath[m.Path] != nil {
+			mods = append(mods, m)
+		}
+	}
+
+	type warning struct {
+		retracted  error
+		deprecated string
+	}
+	warnings := make([]warning, len(mods))
+	var work par.Work
+	for i := range mods {
+		work.Add(i)
+	}
+	work.Do(10, func(item interface{}) {
+		i := item.(int)
+		warnings[i] = warning{
+			retracted:  modload.CheckRetractions(mods[i]),
+			deprecated: modload.CheckDeprecation(mods[i].Path),
+		}
+	})
+
+	for i, m := range mods {
+		w := warnings[i]
+		if w.retracted != nil {
+			fmt.Fprintf(os.Stderr, "go: warning: %s@%s: %v\n", m.Path, m.Version, w.retracted)
+			fmt.Fprintf(os.Stderr, "go: to switch to the latest unretracted version, run:\n\tgo get %s@latest\n", m.Path)
+		}
+		if w.deprecated != "" {
+			fmt.Fprintf(os.Stderr, "go: warning: module %s is deprecated: %s\n", m.Path, w.deprecated)
+		}
+	}
+}
+
 // An upgrader adapts an underlying mvs.Reqs to apply an
 // upgrade policy to a list of targets and their dependencies.
 // If patch=false, the upgrader implements "get -u".
@@ -8,17 +8,24 @@ import (
 	"bytes"
 	"cmd/go/internal/base"
 	"cmd/go/internal/cfg"
+	"cmd/go/internal/get"
+	"cmd/go/internal/load"
 	"cmd/go/internal/modfetch"
 	"cmd/go/internal/modinfo"
 	"cmd/go/internal/module"
 	"cmd/go/internal/search"
+	"cmd/go/internal/semver"
 	"encoding/hex"
 	"fmt"
 	"internal/goroot"
 	"os"
 	"path/filepath"
+	"runtime"
 	"runtime/debug"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 var (
@@ -73,9 +80,11 @@ func ModuleInfo(path string) *modinfo.ModulePublic {
 }
 
 // addUpdate fills in m.Update if an updated version is available.
+// Because Query skips retracted versions, the latest version may be
+// older than m.Version, which is then not reported as an update.
 func addUpdate(m *modinfo.ModulePublic) {
 	if m.Version != "" {
-		if info, err := Query(m.Path, "latest", Allowed); err == nil && info.Version != m.Version {
+		if info, err := Query(m.Path, "latest", Allowed); err == nil && semver.Compare(info.Version, m.Version) > 0 {
 			m.Update = &modinfo.ModulePublic{
 				Path:    m.Path,
 				Version: info.Version,
@@ -85,6 +94,30 @@ func addUpdate(m *modinfo.ModulePublic) {
 	}
 }
 
+// addRetraction fills in m.Retracted if m.Version has been retracted
+// by the module author.
+func addRetraction(m *modinfo.ModulePublic) {
+	if m.Version == "" {
+		return
+	}
+	err := CheckRetractions(module.Version{Path: m.Path, Version: m.Version})
+	if rerr, ok := err.(*ModuleRetractedError); ok {
+		if len(rerr.Rationale) == 0 {
+			m.Retracted = []string{""}
+		} else {
+			m.Retracted = rerr.Rationale
+		}
+	}
+}
+
+// addDeprecation fills in m.Deprecated if the module has been deprecated
+// by its author.
+func addDeprecation(m *modinfo.ModulePublic) {
+	if m.Version != "" {
+		m.Deprecated = CheckDeprecation(m.Path)
+	}
+}
+
 // addVersions fills in m.Versions with the list of known versions.
 func addVersions(m *modinfo.ModulePublic) {
 	m.Versions, _ = versions(m.Path)
@@ -200,6 +233,7 @@ func PackageBuildInfo(path string, deps []string) string {
 	module.Sort(mods)
 
 	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "go\t%s\n", runtime.Version())
 	fmt.Fprintf(&buf, "path\t%s\n", path)
 	tv := target.Version
 	if tv == "" {
@@ -221,9 +255,125 @@ func PackageBuildInfo(path string, deps []string) string {
 			fmt.Fprintf(&buf, "=>\t%s\t%s\t%s\n", r.Path, r.Version, modfetch.Sum(r))
 		}
 	}
+	writeBuildSettings(&buf, target == Target)
 	return buf.String()
 }
 
+// writeBuildSettings writes the "build" lines of the build information:
+// the build flags and environment that affect the binary and, if
+// inMain is set, the state of the version control checkout holding
+// the main module. The format is parsed by runtime/debug.ParseBuildInfo.
+func writeBuildSettings(buf *bytes.Buffer, inMain bool) {
+	add := func(key, value string) {
+		if strings.ContainsAny(value, " \t\r\n\"`") {
+			value = strconv.Quote(value)
+		}
+		fmt.Fprintf(buf, "build\t%s=%s\n", key, value)
+	}
+
+	if v := load.BuildAsmflags.String(); v != "" {
+		add("-asmflags", v)
+	}
+	add("-buildmode", cfg.BuildBuildmode)
+	add("-compiler", cfg.BuildContext.Compiler)
+	if v := load.BuildGccgoflags.String(); v != "" && cfg.BuildContext.Compiler == "gccgo" {
+		add("-gccgoflags", v)
+	}
+	if v := load.BuildGcflags.String(); v != "" {
+		add("-gcflags", v)
+	}
+	if v := load.BuildLdflags.String(); v != "" {
+		add("-ldflags", v)
+	}
+	if cfg.BuildMSan {
+		add("-msan", "true")
+	}
+	if cfg.BuildPGO != "" {
+		add("-pgo", cfg.BuildPGO)
+	}
+	if cfg.BuildRace {
+		add("-race", "true")
+	}
+	if tags := cfg.BuildContext.BuildTags; len(tags) > 0 {
+		add("-tags", strings.Join(tags, ","))
+	}
+	cgo := "0"
+	if cfg.BuildContext.CgoEnabled {
+		cgo = "1"
+	}
+	add("CGO_ENABLED", cgo)
+	if cfg.BuildContext.CgoEnabled {
+		for _, name := range []string{"CGO_CFLAGS", "CGO_CPPFLAGS", "CGO_CXXFLAGS", "CGO_LDFLAGS"} {
+			v := os.Getenv(name)
+			if v == "" && name != "CGO_CPPFLAGS" {
+				v = "-g -O2" // the default used by work.(*Builder).CFlags
+			}
+			add(name, v)
+		}
+	}
+	add("GOARCH", cfg.BuildContext.GOARCH)
+	switch cfg.BuildContext.GOARCH {
+	case "386":
+		add("GO386", cfg.GO386)
+	case "arm":
+		add("GOARM", cfg.GOARM)
+	case "mips", "mipsle":
+		add("GOMIPS", cfg.GOMIPS)
+	case "mips64", "mips64le":
+		add("GOMIPS64", cfg.GOMIPS64)
+	case "ppc64", "ppc64le":
+		add("GOPPC64", cfg.GOPPC64)
+	case "wasm":
+		add("GOWASM", cfg.GOWASM.String())
+	}
+	add("GOOS", cfg.BuildContext.GOOS)
+
+	if !inMain || !stampVCS() {
+		return
+	}
+	vcsOnce.Do(func() {
+		vcsCmd, _, vcsStatus, vcsErr = get.VCSStatusForDir(ModRoot())
+	})
+	if vcsErr != nil {
+		base.Fatalf("go: error obtaining VCS status: %v\n\tUse -buildvcs=false to disable VCS stamping.", vcsErr)
+	}
+	if vcsCmd == "" {
+		return
+	}
+	add("vcs", vcsCmd)
+	if vcsStatus.Revision != "" {
+		add("vcs.revision", vcsStatus.Revision)
+	}
+	if !vcsStatus.CommitTime.IsZero() {
+		add("vcs.time", vcsStatus.CommitTime.UTC().Format(time.RFC3339Nano))
+	}
+	add("vcs.modified", strconv.FormatBool(vcsStatus.Uncommitted))
+}
+
+// The state of the checkout holding the main module,
+// computed at most once per go command.
+var (
+	vcsOnce   sync.Once
+	vcsCmd    string
+	vcsStatus get.VCSStatus
+	vcsErr    error
+)
+
+// stampVCS reports whether the binaries built by the current
+// command should record the state of the version control checkout.
+// Only the commands that build binaries for use by the user do;
+// test binaries and the results of go list do not.
+func stampVCS() bool {
+	if !cfg.BuildBuildvcs {
+		return false
+	}
+	switch cfg.CmdName {
+	case "build", "install", "run":
+		return true
+	}
+	return false
+}
+
 // findModule returns the module containing the package at path,
 // needed to build the package at target.
 func findModule(target, path string) module.Version {
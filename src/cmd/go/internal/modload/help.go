@@ -244,6 +244,10 @@ Module versions disallowed by exclude statements in the
 main module's go.mod are considered unavailable and cannot
 be returned by queries.
 
+Module versions retracted by the module author (see 'go help go.mod')
+are skipped by all queries except a fully-specified semantic version
+or a revision identifier.
+
 For example, these commands are all valid:
 
 	go get github.com/gorilla/mux@latest    # same (@latest is default for 'go get')
@@ -339,6 +343,10 @@ environment variable.
 See 'go help goproxy' for details about the proxy and also the format of
 the cached downloaded packages.
 
+Modules that are not publicly available can be excluded from the proxy
+and from checksum verification by setting GOPRIVATE (or the finer-grained
+GONOPROXY and GONOSUMDB). See 'go help module-private' for details.
+
 Modules and vendoring
 
 When using modules, the go command completely ignores vendor directories.
@@ -379,15 +387,40 @@ verb followed by arguments. For example:
 	require new/thing/v2 v2.3.4
 	exclude old/thing v1.2.3
 	replace bad/thing v1.4.5 => good/thing v1.4.5
+	retract [v1.9.0, v1.9.5]
 
 The verbs are
 	module, to define the module path;
 	go, to set the expected language version;
 	require, to require a particular module at a given version or later;
-	exclude, to exclude a particular module version from use; and
-	replace, to replace a module version with a different module version.
-Exclude and replace apply only in the main module's go.mod and are ignored
-in dependencies.  See https://research.swtch.com/vgo-mvs for details.
+	exclude, to exclude a particular module version from use;
+	replace, to replace a module version with a different module version;
+	retract, to indicate a previously released version should not be used; and
+	vet, to name an additional analysis tool for 'go vet' to run.
+Exclude, replace, and vet apply only in the main module's go.mod and are
+ignored in dependencies.  See https://research.swtch.com/vgo-mvs for details.
+
+A retract directive lists a single version (retract v1.2.3) or a closed
+interval of versions (retract [v1.0.0, v1.1.9]) that the module author
+does not want users to depend on, for example because they were published
+accidentally or contain a severe problem. Comments on the directive
+explain the reason for the retraction. Retractions are read from the
+go.mod file of the latest version of the module, so a module author
+retracts a version by publishing a newer version whose go.mod lists it.
+Retracted versions are not selected by version queries like @latest
+or by 'go get -u', but they remain available to modules that already
+require them. 'go get' prints a warning when it selects a retracted
+version, and 'go list -m -u' reports retracted versions.
+
+A comment paragraph beginning with "Deprecated:" on the module directive
+marks the module as deprecated: its author no longer maintains it.
+The rest of the paragraph is the deprecation message, which should tell
+users what to use instead. As with retractions, deprecations are read
+from the go.mod file of the latest version of the module, and 'go get'
+and 'go list -m -u' report them. For example:
+
+	// Deprecated: use example.com/mod/v2 instead.
+	module example.com/mod
 
 The leading verb can be factored out of adjacent lines to create a block,
 like in Go imports:
@@ -30,6 +30,8 @@ func ListModules(args []string, listU, listVersions bool) []*modinfo.ModulePubli
 			m := item.(*modinfo.ModulePublic)
 			if listU {
 				addUpdate(m)
+				addRetraction(m)
+				addDeprecation(m)
 			}
 			if listVersions {
 				addVersions(m)
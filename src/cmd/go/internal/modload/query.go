@@ -32,6 +32,9 @@ import (
 //
 // If the allowed function is non-nil, Query excludes any versions for which allowed returns false.
 //
+// Except when the query names a specific version or commit, Query also excludes
+// versions retracted by the module author (see CheckRetractions).
+//
 // If path is the path of the main module and the query is "latest",
 // Query returns Target.Version as the version.
 func Query(path, query string, allowed func(module.Version) bool) (*modfetch.RevInfo, error) {
@@ -146,6 +149,13 @@ func Query(path, query string, allowed func(module.Version) bool) (*modfetch.Rev
 		return nil, err
 	}
 
+	// Skip retracted versions, unless the query names one explicitly.
+	// Retractions are loaded only if some version matches the query.
+	matches := ok
+	ok = func(m module.Version) bool {
+		return matches(m) && !isRetracted(m)
+	}
+
 	if preferOlder {
 		for _, v := range versions {
 			if semver.Prerelease(v) == "" && ok(module.Version{Path: path, Version: v}) {
@@ -174,8 +184,8 @@ func Query(path, query string, allowed func(module.Version) bool) (*modfetch.Rev
 
 	if query == "latest" {
 		// Special case for "latest": if no tags match, use latest commit in repo,
-		// provided it is not excluded.
-		if info, err := repo.Latest(); err == nil && allowed(module.Version{Path: path, Version: info.Version}) {
+		// provided it is not excluded or retracted.
+		if info, err := repo.Latest(); err == nil && ok(module.Version{Path: path, Version: info.Version}) {
 			return info, nil
 		}
 	}
@@ -0,0 +1,149 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package modload
+
+import (
+	"cmd/go/internal/modfetch"
+	"cmd/go/internal/modfile"
+	"cmd/go/internal/module"
+	"cmd/go/internal/par"
+	"cmd/go/internal/semver"
+	"strings"
+	"unicode"
+)
+
+// A ModuleRetractedError indicates that a module version
+// has been retracted by the module author.
+type ModuleRetractedError struct {
+	Rationale []string
+}
+
+func (e *ModuleRetractedError) Error() string {
+	msg := "retracted by module author"
+	if len(e.Rationale) > 0 {
+		// This is meant to be a short error printed on a terminal,
+		// so just print the first rationale.
+		msg += ": " + shortMessage(e.Rationale[0])
+	}
+	return msg
+}
+
+// CheckRetractions returns a *ModuleRetractedError if m
+// has been retracted by the module author, and nil otherwise.
+//
+// Retractions are read from the go.mod file of the latest version of the
+// module, ignoring retractions, so that an author can retract a published
+// version by publishing a newer one. Errors loading that go.mod file are
+// not reported: retractions are advisory and should not cause a command
+// to fail.
+func CheckRetractions(m module.Version) error {
+	if m.Version == "" || m == Target {
+		return nil
+	}
+	f := latestModFile(m.Path)
+	if f == nil {
+		return nil
+	}
+	var rationale []string
+	isRetracted := false
+	for _, r := range f.Retract {
+		if semver.Compare(r.Low, m.Version) <= 0 && semver.Compare(m.Version, r.High) <= 0 {
+			isRetracted = true
+			if r.Rationale != "" {
+				rationale = append(rationale, r.Rationale)
+			}
+		}
+	}
+	if isRetracted {
+		return &ModuleRetractedError{Rationale: rationale}
+	}
+	return nil
+}
+
+// isRetracted reports whether m has been retracted by the module author.
+func isRetracted(m module.Version) bool {
+	return CheckRetractions(m) != nil
+}
+
+// CheckDeprecation returns the deprecation message for the module at path
+// from the "// Deprecated:" comment on the module statement in the go.mod
+// file of the module's latest version, or "" if the module is not deprecated.
+func CheckDeprecation(path string) string {
+	if path == Target.Path {
+		return ""
+	}
+	f := latestModFile(path)
+	if f == nil || f.Module == nil {
+		return ""
+	}
+	return shortMessage(f.Module.Deprecated)
+}
+
+var latestModFileCache par.Cache
+
+// latestModFile returns the parsed go.mod file of the latest version of the
+// module at path, ignoring retractions, or nil if it cannot be loaded.
+func latestModFile(path string) *modfile.File {
+	return latestModFileCache.Do(path, func() interface{} {
+		v := latestVersionIgnoringRetractions(path)
+		if v == "" {
+			return (*modfile.File)(nil)
+		}
+		data, err := modfetch.GoMod(path, v)
+		if err != nil {
+			return (*modfile.File)(nil)
+		}
+		f, err := modfile.ParseLax("go.mod", data, nil)
+		if err != nil {
+			return (*modfile.File)(nil)
+		}
+		return f
+	}).(*modfile.File)
+}
+
+// latestVersionIgnoringRetractions returns the version that the query
+// "latest" would select for the module at path if no version were
+// retracted or excluded, or "" if there is no such version.
+func latestVersionIgnoringRetractions(path string) string {
+	repo, err := modfetch.Lookup(path)
+	if err != nil {
+		return ""
+	}
+	versions, err := repo.Versions("")
+	if err != nil {
+		return ""
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		if semver.Prerelease(versions[i]) == "" {
+			return versions[i]
+		}
+	}
+	if len(versions) > 0 {
+		return versions[len(versions)-1]
+	}
+	if info, err := repo.Latest(); err == nil {
+		return info.Version
+	}
+	return ""
+}
+
+// shortMessage returns a string from go.mod (for example, a retraction
+// rationale or deprecation message) that is safe to print in a terminal.
+//
+// Line breaks are replaced by spaces. If the message is too long or
+// contains non-printable characters, shortMessage returns a hard-coded string.
+func shortMessage(message string) string {
+	const maxLen = 500
+	message = strings.TrimSpace(strings.Replace(message, "\n", " ", -1))
+	if len(message) > maxLen {
+		return "(message omitted: too long)"
+	}
+	for _, r := range message {
+		if !unicode.IsGraphic(r) && !unicode.IsSpace(r) {
+			return "(message omitted: contains non-printable characters)"
+		}
+	}
+	return message
+}
@@ -0,0 +1,290 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package note
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"cmd/go/internal/ed25519"
+)
+
+func TestNewVerifier(t *testing.T) {
+	vkey := "PeterNeumann+c74f20a3+ARpc2QcUPDhMQegwxbzhKqiBfsVkmqq/LDE4izWy10TW"
+	_, err := NewVerifier(vkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Check various manglings are not accepted.
+	badKey := func(k string) {
+		_, err := NewVerifier(k)
+		if err == nil {
+			t.Errorf("NewVerifier(%q) succeeded, should have failed", k)
+		}
+	}
+
+	b := []byte(vkey)
+	for i := 0; i <= len(b); i++ {
+		for j := i + 1; j <= len(b); j++ {
+			if i != 0 || j != len(b) {
+				badKey(string(b[i:j]))
+			}
+		}
+	}
+	for i := 0; i < len(b); i++ {
+		b[i]++
+		badKey(string(b))
+		b[i]--
+	}
+
+	badKey("PeterNeumann+cc469956+ARpc2QcUPDhMQegwxbzhKqiBfsVkmqq/LDE4izWy10TWBADKEY==") // wrong length key, with adjusted key hash
+	badKey("PeterNeumann+173116ae+ZRpc2QcUPDhMQegwxbzhKqiBfsVkmqq/LDE4izWy10TW")         // unknown algorithm, with adjusted key hash
+}
+
+func TestNewSigner(t *testing.T) {
+	skey, vkey, err := GenerateKey(rand.Reader, "PeterNeumann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewSigner(skey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier, err := NewVerifier(vkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.Name() != "PeterNeumann" || verifier.Name() != "PeterNeumann" {
+		t.Errorf("names = %q, %q, want PeterNeumann", signer.Name(), verifier.Name())
+	}
+	if signer.KeyHash() != verifier.KeyHash() {
+		t.Errorf("key hashes = %08x, %08x, want equal", signer.KeyHash(), verifier.KeyHash())
+	}
+
+	msg := []byte("hi")
+	sig, err := signer.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verifier.Verify(msg, sig) {
+		t.Fatalf("signature does not verify")
+	}
+	if verifier.Verify(append(msg, 'x'), sig) {
+		t.Fatalf("signature verifies for different message")
+	}
+
+	// Check various manglings are not accepted.
+	badKey := func(k string) {
+		_, err := NewSigner(k)
+		if err == nil {
+			t.Errorf("NewSigner(%q) succeeded, should have failed", k)
+		}
+	}
+
+	b := []byte(skey)
+	for i := 0; i <= len(b); i++ {
+		for j := i + 1; j <= len(b); j++ {
+			if i != 0 || j != len(b) {
+				badKey(string(b[i:j]))
+			}
+		}
+	}
+	for i := 0; i < len(b); i++ {
+		b[i]++
+		badKey(string(b))
+		b[i]--
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	// With good randomness, GenerateKey must succeed.
+	if _, _, err := GenerateKey(rand.Reader, "EnochRoot"); err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	// With bad randomness, GenerateKey must fail.
+	_, _, err := GenerateKey(iotest.TimeoutReader(strings.NewReader("")), "EnochRoot")
+	if err == nil {
+		t.Fatalf("GenerateKey succeeded with error-returning rand")
+	}
+}
+
+func TestNewEd25519VerifierKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vkey, err := NewEd25519VerifierKey("EnochRoot", pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewVerifier(vkey); err != nil {
+		t.Fatalf("NewVerifier(%q): %v", vkey, err)
+	}
+	if _, err := NewEd25519VerifierKey("EnochRoot", pub[1:]); err == nil {
+		t.Fatalf("NewEd25519VerifierKey succeeded with short key")
+	}
+}
+
+// newKeyPair returns a fresh signer and verifier for the named server.
+func newKeyPair(t *testing.T, name string) (Signer, Verifier) {
+	t.Helper()
+	skey, vkey, err := GenerateKey(rand.Reader, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewSigner(skey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier, err := NewVerifier(vkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer, verifier
+}
+
+func TestSignOpen(t *testing.T) {
+	signer1, verifier1 := newKeyPair(t, "PeterNeumann")
+	signer2, verifier2 := newKeyPair(t, "EnochRoot")
+
+	text := "If you think cryptography is the answer to your problem,\n" +
+		"then you don't know what your problem is.\n"
+	msg, err := Sign(&Note{Text: text}, signer1, signer2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(msg), text+"\n— PeterNeumann ") {
+		t.Fatalf("signed note does not start with text and first signature:\n%s", msg)
+	}
+
+	// Both keys known.
+	n, err := Open(msg, VerifierList(verifier1, verifier2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Text != text {
+		t.Errorf("n.Text = %q, want %q", n.Text, text)
+	}
+	if len(n.Sigs) != 2 || n.Sigs[0].Name != "PeterNeumann" || n.Sigs[1].Name != "EnochRoot" || len(n.UnverifiedSigs) != 0 {
+		t.Errorf("n.Sigs = %+v, n.UnverifiedSigs = %+v, want two verified signatures", n.Sigs, n.UnverifiedSigs)
+	}
+
+	// Only one key known.
+	n, err = Open(msg, VerifierList(verifier2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(n.Sigs) != 1 || n.Sigs[0].Name != "EnochRoot" || len(n.UnverifiedSigs) != 1 || n.UnverifiedSigs[0].Name != "PeterNeumann" {
+		t.Errorf("n.Sigs = %+v, n.UnverifiedSigs = %+v, want one of each", n.Sigs, n.UnverifiedSigs)
+	}
+
+	// Re-signing keeps the signatures already present.
+	msg2, err := Sign(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(msg2, VerifierList(verifier1, verifier2)); err != nil {
+		t.Fatalf("Open of re-signed note: %v", err)
+	}
+
+	// No keys known.
+	_, err = Open(msg, VerifierList())
+	if e, ok := err.(*UnverifiedNoteError); !ok || e.Note.Text != text || len(e.Note.UnverifiedSigs) != 2 {
+		t.Fatalf("Open with no known keys = %v, want UnverifiedNoteError with two unverified signatures", err)
+	}
+	if _, err := Open(msg, nil); err == nil {
+		t.Fatalf("Open with nil Verifiers succeeded")
+	}
+
+	// A known key rejecting its signature is an error, not an unverified signature.
+	tampered := []byte(strings.Replace(string(msg), "answer", "problem", 1))
+	_, err = Open(tampered, VerifierList(verifier1))
+	if e, ok := err.(*InvalidSignatureError); !ok || e.Name != "PeterNeumann" || e.Hash != verifier1.KeyHash() {
+		t.Fatalf("Open of tampered note = %v, want InvalidSignatureError for PeterNeumann", err)
+	}
+
+	// Text must end in newline.
+	if _, err := Sign(&Note{Text: "hello"}, signer1); err == nil {
+		t.Fatalf("Sign of text without final newline succeeded")
+	}
+}
+
+func TestOpenMalformed(t *testing.T) {
+	signer, verifier := newKeyPair(t, "PeterNeumann")
+	msg, err := Sign(&Note{Text: "hello\n"}, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	good := string(msg)
+	sig := good[len("hello\n\n"):]
+
+	for _, bad := range []string{
+		"",
+		"hello\n",
+		"hello\n\n",
+		"hello\n" + sig,                  // missing blank line
+		strings.TrimSuffix(good, "\n"),   // missing final newline
+		"hel\x01lo\n\n" + sig,            // control character
+		"hel\xfflo\n\n" + sig,            // invalid UTF-8
+		"hello\n\n- " + sig[len("— "):],  // wrong signature prefix
+		"hello\n\n— PeterNeumann\n",      // missing signature
+		"hello\n\n— PeterNeumann !!!!\n", // invalid base64
+		"hello\n\n— PeterNeumann AAAA\n", // signature too short
+		"hello\n\n— Peter+Neumann " + sig[len("— PeterNeumann "):], // invalid name
+	} {
+		if _, err := Open([]byte(bad), VerifierList(verifier)); err == nil {
+			t.Errorf("Open(%q) succeeded, want error", bad)
+		}
+	}
+
+	// Too many signatures.
+	many := "hello\n\n" + strings.Repeat(sig, 101)
+	if _, err := Open([]byte(many), VerifierList(verifier)); err != errMalformedNote {
+		t.Errorf("Open with 101 signatures = %v, want %v", err, errMalformedNote)
+	}
+
+	// Repeated signatures are dropped.
+	n, err := Open([]byte(good+sig), VerifierList(verifier))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(n.Sigs) != 1 {
+		t.Errorf("Open with repeated signature returned %d signatures, want 1", len(n.Sigs))
+	}
+}
+
+type errVerifiers struct{}
+
+func (errVerifiers) Verifier(name string, hash uint32) (Verifier, error) {
+	return nil, errMalformedNote
+}
+
+func TestOpenVerifierError(t *testing.T) {
+	signer, _ := newKeyPair(t, "PeterNeumann")
+	msg, err := Sign(&Note{Text: "hello\n"}, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(msg, errVerifiers{}); err != errMalformedNote {
+		t.Fatalf("Open = %v, want %v", err, errMalformedNote)
+	}
+}
+
+func TestVerifierListAmbiguous(t *testing.T) {
+	_, verifier := newKeyPair(t, "PeterNeumann")
+	list := VerifierList(verifier, verifier)
+	_, err := list.Verifier("PeterNeumann", verifier.KeyHash())
+	if _, ok := err.(*ambiguousVerifierError); !ok {
+		t.Fatalf("Verifier for duplicated key = %v, want ambiguousVerifierError", err)
+	}
+	_, err = list.Verifier("EnochRoot", verifier.KeyHash())
+	if _, ok := err.(*UnknownVerifierError); !ok {
+		t.Fatalf("Verifier for unknown key = %v, want UnknownVerifierError", err)
+	}
+}
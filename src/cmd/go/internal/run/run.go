@@ -38,6 +38,8 @@ available.
 
 The exit status of Run is not the exit status of the compiled binary.
 
+The -cover, -covermode and -coverpkg flags are as for 'go build'.
+
 For more about build flags, see 'go help build'.
 For more about specifying packages, see 'go help packages'.
 
@@ -49,6 +51,7 @@ func init() {
 	CmdRun.Run = runRun // break init loop
 
 	work.AddBuildFlags(CmdRun)
+	work.AddCoverFlags(CmdRun)
 	CmdRun.Flag.Var((*base.StringsFlag)(&work.ExecCmd), "exec", "")
 }
 
@@ -116,6 +119,9 @@ func runRun(cmd *base.Command, args []string) {
 	if p.Name != "main" {
 		base.Fatalf("go run: cannot run non-main package")
 	}
+	if cfg.BuildCover {
+		load.PrepareForCoverageBuild([]*load.Package{p})
+	}
 	p.Target = "" // must build - not up to date
 	var src string
 	if len(p.GoFiles) > 0 {
@@ -0,0 +1,42 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package str
+
+import "testing"
+
+var globsMatchPathTests = []struct {
+	globs, target string
+	want          bool
+}{
+	{"", "anything", false},
+	{",", "anything", false},
+	{",foo,", "anything", false},
+	{"[malformed", "anything", false},
+	{"malformed[", "anything", false},
+	{"*.[c]orp.*", "my.corp.example.com", true},
+	{"*.c[^a]rp.*", "my.corp.example.com/foo", true},
+	{"*.corp.*,bar.com", "my.corp.example.com", true},
+	{"*.corp.*,bar.com", "my.corp.example.com/foo", true},
+	{"bar.com,*.corp.*", "my.corp.example.com", true},
+	{"bar.com,*.corp.*", "my.corp.example.com/foo", true},
+	{"*.corp.*", "bar.com", false},
+	{"*.corp.*", "bar.com/foo", false},
+	{"*.corp.*,bar.com", "bar.com", true},
+	{"*.corp.*,bar.com", "bar.com/foo", true},
+	{"bar.com,*.corp.*", "bar.com/foo", true},
+	{"rsc.io/private", "rsc.io", false},
+	{"rsc.io/private", "rsc.io/private", true},
+	{"rsc.io/private", "rsc.io/private/quux", true},
+	{"rsc.io/private", "rsc.io/privateer", false},
+	{"rsc.io/*/quux", "rsc.io/private/quux/v2", true},
+}
+
+func TestGlobsMatchPath(t *testing.T) {
+	for _, tt := range globsMatchPathTests {
+		if got := GlobsMatchPath(tt.globs, tt.target); got != tt.want {
+			t.Errorf("GlobsMatchPath(%q, %q) = %v, want %v", tt.globs, tt.target, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sumdb
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testName        = "localhost.localdev/sumdb"
+	testVerifierKey = "localhost.localdev/sumdb+f5e8495f+AZvBiaRyOvhqlrLZ1cHOULPDS+32FK3qynt8b6edY3e5"
+	testSignerKey   = "PRIVATE+KEY+localhost.localdev/sumdb+f5e8495f+AfhxQLRDqVhwxI9NzC0dQMiwKAM5XtV5HwkaHlWIBb5j"
+)
+
+var testGoSum = map[string]string{
+	"rsc.io/sampler@v1.3.0": "rsc.io/sampler v1.3.0 h1:7uVkIFmeBqHfdjD+gZwtXXI+RODJ2Wc4O7MPEh/QiW4=\n" +
+		"rsc.io/sampler v1.3.0/go.mod h1:T1hPZKmBbMNahiBKFy5HrXp6adAjACjK9JXDnKaTXpA=\n",
+	"golang.org/x/text@v0.3.0": "golang.org/x/text v0.3.0 h1:g61tztE5qeGQ89tm6NTjjM9VPIm088od1l6aSorWRWg=\n" +
+		"golang.org/x/text v0.3.0/go.mod h1:NqM8EUOU14njkJ3fqMW+pc6Ldnwhi/IjpwHt7yyuwOQ=\n",
+	"rsc.io/quote@v1.5.2": "rsc.io/quote v1.5.2 h1:w5fcysjrx7yqtD/aO+QwRjYZOKnaM9Uh2b40tElTs3Y=\n" +
+		"rsc.io/quote v1.5.2/go.mod h1:LzX7hefJvL54yjefDEDHNONDjII0t9xZLPXsUe+TKr0=\n",
+}
+
+func TestClientLookup(t *testing.T) {
+	tc := newTestClient(t)
+	tc.mustHaveLatest(0)
+
+	// Basic lookup.
+	tc.mustLookup("rsc.io/sampler", "v1.3.0", "rsc.io/sampler v1.3.0 h1:7uVkIFmeBqHfdjD+gZwtXXI+RODJ2Wc4O7MPEh/QiW4=")
+	tc.mustHaveLatest(2)
+
+	// Everything should now be cached, both for the original client and a new one.
+	tc.numReadRemote = 0
+	tc.mustLookup("rsc.io/sampler", "v1.3.0", "rsc.io/sampler v1.3.0 h1:7uVkIFmeBqHfdjD+gZwtXXI+RODJ2Wc4O7MPEh/QiW4=")
+	tc.mustLookup("rsc.io/sampler", "v1.3.0/go.mod", "rsc.io/sampler v1.3.0/go.mod h1:T1hPZKmBbMNahiBKFy5HrXp6adAjACjK9JXDnKaTXpA=")
+	tc.newClient()
+	tc.mustLookup("rsc.io/sampler", "v1.3.0", "rsc.io/sampler v1.3.0 h1:7uVkIFmeBqHfdjD+gZwtXXI+RODJ2Wc4O7MPEh/QiW4=")
+	if tc.numReadRemote != 0 {
+		t.Fatalf("cached lookups made %d ReadRemote calls, want 0", tc.numReadRemote)
+	}
+
+	// A record logged later moves the latest tree forward.
+	tc.addRecord("rsc.io/quote@v1.5.2")
+	tc.mustLookup("rsc.io/quote", "v1.5.2", "rsc.io/quote v1.5.2 h1:w5fcysjrx7yqtD/aO+QwRjYZOKnaM9Uh2b40tElTs3Y=")
+	tc.mustHaveLatest(3)
+
+	// Records from the older tree can still be authenticated.
+	tc.newClient()
+	tc.mustLookup("golang.org/x/text", "v0.3.0", "golang.org/x/text v0.3.0 h1:g61tztE5qeGQ89tm6NTjjM9VPIm088od1l6aSorWRWg=")
+	tc.mustHaveLatest(3)
+
+	// An unknown module is an error, not a silent success.
+	if lines, err := tc.client.Lookup("rsc.io/nonexist", "v1.0.0"); err == nil {
+		t.Fatalf("Lookup of unknown module = %q, nil, want error", lines)
+	}
+	if tc.security.Len() != 0 {
+		t.Fatalf("unexpected security errors:\n%s", tc.security.String())
+	}
+}
+
+func TestClientBadTiles(t *testing.T) {
+	tc := newTestClient(t)
+
+	// Bad tiles in initial download.
+	tc.badTiles = true
+	_, err := tc.client.Lookup("rsc.io/sampler", "v1.3.0")
+	if err == nil || !strings.Contains(err.Error(), "rsc.io/sampler@v1.3.0: downloaded inconsistent tile") {
+		t.Fatalf("Lookup with bad tiles = %v, want inconsistent tile error", err)
+	}
+	tc.badTiles = false
+	tc.newClient()
+	tc.mustLookup("rsc.io/sampler", "v1.3.0", "rsc.io/sampler v1.3.0 h1:7uVkIFmeBqHfdjD+gZwtXXI+RODJ2Wc4O7MPEh/QiW4=")
+
+	// Bad tiles after initial download.
+	tc.addRecord("rsc.io/quote@v1.5.2")
+	tc.badTiles = true
+	_, err = tc.client.Lookup("rsc.io/quote", "v1.5.2")
+	if err == nil || !strings.Contains(err.Error(), "rsc.io/quote@v1.5.2: checking tree#2 against tree#3: downloaded inconsistent tile") {
+		t.Fatalf("Lookup with bad tiles = %v, want inconsistent tile error", err)
+	}
+	tc.badTiles = false
+	tc.newClient()
+	tc.mustLookup("rsc.io/quote", "v1.5.2", "rsc.io/quote v1.5.2 h1:w5fcysjrx7yqtD/aO+QwRjYZOKnaM9Uh2b40tElTs3Y=")
+
+	// Bad tiles in the cache are not trusted either.
+	for file, data := range tc.cache {
+		if strings.Contains(file, "/tile/") {
+			tc.cache[file] = flipBits(data)
+		}
+	}
+	tc.config[testName+"/latest"] = nil
+	tc.newClient()
+	_, err = tc.client.Lookup("golang.org/x/text", "v0.3.0")
+	if err == nil || !strings.Contains(err.Error(), "downloaded inconsistent tile") {
+		t.Fatalf("Lookup with bad cached tiles = %v, want inconsistent tile error", err)
+	}
+}
+
+func TestClientFork(t *testing.T) {
+	tc := newTestClient(t)
+	tc2 := newTestClient(t)
+
+	// Give the two servers conflicting third records.
+	tc.gosum["rsc.io/pkg1@v1.5.2"] = "rsc.io/pkg1 v1.5.2 h1:hash\nrsc.io/pkg1 v1.5.2/go.mod h1:hash\n"
+	tc.addRecord("rsc.io/pkg1@v1.5.2")
+	tc2.gosum["rsc.io/pkg1@v1.5.3"] = "rsc.io/pkg1 v1.5.3 h1:hash\nrsc.io/pkg1 v1.5.3/go.mod h1:hash\n"
+	tc2.addRecord("rsc.io/pkg1@v1.5.3")
+	tc2.mustLookup("rsc.io/pkg1", "v1.5.3", "rsc.io/pkg1 v1.5.3 h1:hash")
+
+	// Serve tc2's client a record signed by tc's server.
+	tc2.remote["/lookup/rsc.io/pkg1@v1.5.2"] = tc.serve("/lookup/rsc.io/pkg1@v1.5.2")
+	_, err := tc2.client.Lookup("rsc.io/pkg1", "v1.5.2")
+	if err == nil || !strings.Contains(err.Error(), ErrSecurity.Error()) {
+		t.Fatalf("Lookup of forked record = %v, want ErrSecurity", err)
+	}
+
+	msg := tc2.security.String()
+	for _, want := range []string{"SECURITY ERROR", "old database:", "new database:", "proof of misbehavior:"} {
+		if !strings.Contains(msg, want) {
+			t.Fatalf("SecurityError message lacks %q:\n%s", want, msg)
+		}
+	}
+}
+
+func TestClientGONOSUMDB(t *testing.T) {
+	tc := newTestClient(t)
+	tc.client.SetGONOSUMDB("p,*/q")
+	tc.client.Lookup("rsc.io/sampler", "v1.3.0") // initialize before we turn off network
+	tc.getOK = false
+
+	ok := []string{
+		"abc",
+		"a/p",
+		"pq",
+		"q",
+		"n/o/p/q",
+	}
+	skip := []string{
+		"p",
+		"p/x",
+		"x/q",
+		"x/q/rest",
+	}
+
+	for _, path := range ok {
+		_, err := tc.client.Lookup(path, "v1.0.0")
+		if err == ErrGONOSUMDB {
+			t.Errorf("Lookup(%q): ErrGONOSUMDB, wanted failed actual lookup", path)
+		}
+	}
+	for _, path := range skip {
+		_, err := tc.client.Lookup(path, "v1.0.0")
+		if err != ErrGONOSUMDB {
+			t.Errorf("Lookup(%q): %v, wanted ErrGONOSUMDB", path, err)
+		}
+	}
+}
+
+func TestServerPaths(t *testing.T) {
+	tc := newTestClient(t)
+	for _, tt := range []struct {
+		path string
+		code int
+	}{
+		{"/latest", 200},
+		{"/lookup/rsc.io/sampler@v1.3.0", 200},
+		{"/lookup/rsc.io/sampler", 400},
+		{"/lookup/rsc.io/nonexist@v1.0.0", 404},
+		{"/tile/8/0/000.p/2", 200},
+		{"/tile/8/data/000.p/2", 200},
+		{"/tile/8/0/bad", 400},
+		{"/other", 404},
+	} {
+		w := httptest.NewRecorder()
+		tc.server.ServeHTTP(w, httptest.NewRequest("GET", tt.path, nil))
+		if w.Code != tt.code {
+			t.Errorf("GET %s: %d %s, want %d", tt.path, w.Code, w.Body.Bytes(), tt.code)
+		}
+	}
+}
+
+// A testClient is a self-contained client-side testing environment:
+// it implements ClientOps using in-memory configuration and cache
+// and a TestServer standing in for the remote database.
+type testClient struct {
+	t        *testing.T        // active test
+	client   *Client           // client being tested
+	server   http.Handler      // database server
+	gosum    map[string]string // module@version -> go.sum records for server
+	getOK    bool              // should ReadRemote succeed?
+	badTiles bool              // should ReadRemote corrupt tiles?
+
+	mu            sync.Mutex
+	numReadRemote int               // number of ReadRemote calls
+	config        map[string][]byte // client configuration state
+	cache         map[string][]byte // client cache state
+	remote        map[string][]byte // remote responses overriding the server
+	security      bytes.Buffer      // security errors
+}
+
+// newTestClient returns a new testClient whose server
+// has already logged records for rsc.io/sampler and golang.org/x/text.
+func newTestClient(t *testing.T) *testClient {
+	tc := &testClient{
+		t:      t,
+		gosum:  make(map[string]string),
+		getOK:  true,
+		config: make(map[string][]byte),
+		cache:  make(map[string][]byte),
+		remote: make(map[string][]byte),
+	}
+	for k, v := range testGoSum {
+		tc.gosum[k] = v
+	}
+	tc.config["key"] = []byte(testVerifierKey + "\n")
+	tc.server = NewServer(NewTestServer(testSignerKey, tc.lookupGoSum))
+
+	tc.addRecord("rsc.io/sampler@v1.3.0")
+	tc.addRecord("golang.org/x/text@v0.3.0")
+
+	tc.newClient()
+	return tc
+}
+
+// lookupGoSum implements the TestServer gosum callback.
+func (tc *testClient) lookupGoSum(path, vers string) ([]byte, error) {
+	text, ok := tc.gosum[path+"@"+vers]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return []byte(text), nil
+}
+
+// serve returns the server's response for path,
+// calling t.Fatal if the request fails.
+func (tc *testClient) serve(path string) []byte {
+	tc.t.Helper()
+	data, err := tc.get(path)
+	if err != nil {
+		tc.t.Fatal(err)
+	}
+	return data
+}
+
+// get returns the server's response for path.
+func (tc *testClient) get(path string) ([]byte, error) {
+	w := httptest.NewRecorder()
+	tc.server.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+	if w.Code != 200 {
+		return nil, fmt.Errorf("GET %s: %d %s", path, w.Code, bytes.TrimSpace(w.Body.Bytes()))
+	}
+	return w.Body.Bytes(), nil
+}
+
+// addRecord makes the server log the record for mod,
+// by looking it up as a client would.
+func (tc *testClient) addRecord(mod string) {
+	tc.t.Helper()
+	tc.serve("/lookup/" + mod)
+}
+
+// newClient resets the Client associated with tc.
+// This clears any in-memory cache from the Client
+// but not tc's configuration or on-disk cache.
+func (tc *testClient) newClient() {
+	tc.client = NewClient(tc)
+	tc.client.SetTileHeight(2)
+}
+
+// mustLookup does a lookup for path@vers and checks that the lines that come back match want.
+func (tc *testClient) mustLookup(path, vers, want string) {
+	tc.t.Helper()
+	lines, err := tc.client.Lookup(path, vers)
+	if err != nil {
+		tc.t.Fatal(err)
+	}
+	if strings.Join(lines, "\n") != want {
+		tc.t.Fatalf("Lookup(%q, %q):\n\t%s\nwant:\n\t%s", path, vers, strings.Join(lines, "\n\t"), strings.Replace(want, "\n", "\n\t", -1))
+	}
+}
+
+// mustHaveLatest checks that the stored configuration
+// for latest is a tree of size n.
+func (tc *testClient) mustHaveLatest(n int64) {
+	tc.t.Helper()
+
+	latest := tc.config[testName+"/latest"]
+	if n == 0 {
+		if len(latest) != 0 {
+			tc.t.Fatalf("/latest should be empty, but has:\n%s", latest)
+		}
+		return
+	}
+	lines := strings.Split(string(latest), "\n")
+	if len(lines) < 2 || lines[1] != fmt.Sprint(n) {
+		tc.t.Fatalf("/latest should have tree %d, but has:\n%s", n, latest)
+	}
+}
+
+// flipBits returns a copy of data with the high bit of every byte flipped.
+func flipBits(data []byte) []byte {
+	bad := make([]byte, len(data))
+	for i, b := range data {
+		bad[i] = b ^ 0x80
+	}
+	return bad
+}
+
+func (tc *testClient) ReadRemote(path string) ([]byte, error) {
+	tc.mu.Lock()
+	tc.numReadRemote++
+	getOK, badTiles := tc.getOK, tc.badTiles
+	data, ok := tc.remote[path]
+	tc.mu.Unlock()
+
+	if !getOK {
+		return nil, fmt.Errorf("disallowed remote read %s", path)
+	}
+	if !ok {
+		var err error
+		data, err = tc.get(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if badTiles && strings.HasPrefix(path, "/tile/") {
+		data = flipBits(data)
+	}
+	return data, nil
+}
+
+func (tc *testClient) ReadConfig(file string) ([]byte, error) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	return tc.config[file], nil
+}
+
+func (tc *testClient) WriteConfig(file string, old, new []byte) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if !bytes.Equal(tc.config[file], old) {
+		return ErrWriteConflict
+	}
+	tc.config[file] = new
+	return nil
+}
+
+func (tc *testClient) ReadCache(file string) ([]byte, error) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if data, ok := tc.cache[file]; ok {
+		return data, nil
+	}
+	return nil, fmt.Errorf("no cache for %s", file)
+}
+
+func (tc *testClient) WriteCache(file string, data []byte) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.cache[file] = data
+}
+
+func (tc *testClient) Log(msg string) {
+	tc.t.Log(msg)
+}
+
+func (tc *testClient) SecurityError(msg string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	fmt.Fprintf(&tc.security, "%s\n", strings.TrimRight(msg, "\n"))
+}
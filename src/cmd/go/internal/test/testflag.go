@@ -37,6 +37,7 @@ var testFlagDefn = []*cmdflag.Defn{
 	{Name: "vet"},
 
 	// Passed to 6.out, adding a "test." prefix to the name if necessary: -v becomes -test.v.
+	{Name: "artifacts", BoolVar: &testArtifacts, PassToTest: true},
 	{Name: "bench", PassToTest: true},
 	{Name: "benchmem", BoolVar: new(bool), PassToTest: true},
 	{Name: "benchtime", PassToTest: true},
@@ -136,7 +137,7 @@ func testFlags(usage func(), args []string) (packageNames, passToTest []string)
 			// Arguably should be handled by f.Value, but aren't.
 			switch f.Name {
 			// bool flags.
-			case "c", "i", "v", "cover", "json":
+			case "c", "i", "v", "cover", "json", "artifacts":
 				cmdflag.SetBool(cmd, f.BoolVar, value)
 				if f.Name == "json" && testJSON {
 					passToTest = append(passToTest, "-test.v=true")
@@ -220,8 +221,9 @@ func testFlags(usage func(), args []string) (packageNames, passToTest []string)
 		base.Fatalf(`-covermode must be "atomic", not %q, when -race is enabled`, testCoverMode)
 	}
 
-	// Tell the test what directory we're running in, so it can write the profiles there.
-	if testProfile != "" && testOutputDir == "" {
+	// Tell the test what directory we're running in,
+	// so it can write the profiles and artifacts there.
+	if (testProfile != "" || testArtifacts) && testOutputDir == "" {
 		dir, err := os.Getwd()
 		if err != nil {
 			base.Fatalf("error from os.Getwd: %s", err)
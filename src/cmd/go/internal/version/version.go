@@ -6,23 +6,190 @@
 package version
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"runtime"
+	"runtime/debug"
+	"strings"
 
 	"cmd/go/internal/base"
 )
 
 var CmdVersion = &base.Command{
-	Run:       runVersion,
-	UsageLine: "go version",
+	UsageLine: "go version [-m] [-v] [file ...]",
 	Short:     "print Go version",
-	Long:      `Version prints the Go version, as reported by runtime.Version.`,
+	Long: `
+Version prints the build information for Go executables.
+
+Go version reports the Go version used to build each of the named
+executable files.
+
+If no files are named on the command line, go version prints its own
+version information.
+
+If a directory is named, go version walks that directory, recursively,
+looking for recognized Go binaries and reporting their versions.
+By default, go version does not report unrecognized files found
+during a directory scan. The -v flag causes it to report unrecognized files.
+
+The -m flag causes go version to print each executable's embedded
+build information. In the output, the build information consists of
+multiple lines following the version line, each indented by a leading
+tab character: the main package path, the main module and the module
+dependencies, and then the build settings, such as the build flags,
+GOOS and GOARCH, the cgo settings and, when the main module was in a
+version control checkout, its revision, commit time and whether it had
+uncommitted changes (see the -buildvcs flag in 'go help build').
+The same information is available to a running program
+from runtime/debug.ReadBuildInfo.
+
+Build information is recorded only in binaries built in module mode.
+
+See also: go doc runtime/debug.BuildInfo.
+`,
+}
+
+func init() {
+	CmdVersion.Run = runVersion // break init cycle
 }
 
+var (
+	versionM = CmdVersion.Flag.Bool("m", false, "")
+	versionV = CmdVersion.Flag.Bool("v", false, "")
+)
+
 func runVersion(cmd *base.Command, args []string) {
-	if len(args) != 0 {
-		cmd.Usage()
+	if len(args) == 0 {
+		if *versionM || *versionV {
+			fmt.Fprintf(os.Stderr, "go version: flags can only be used with arguments\n")
+			base.SetExitStatus(2)
+			return
+		}
+		fmt.Printf("go version %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+		return
+	}
+
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			base.SetExitStatus(1)
+			continue
+		}
+		if info.IsDir() {
+			scanDir(arg)
+		} else {
+			scanFile(arg, info, true)
+		}
 	}
+}
+
+// scanDir scans a directory for executables to run scanFile on.
+func scanDir(dir string) {
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if *versionV {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+			}
+			return nil
+		}
+		if info.Mode().IsRegular() || info.Mode()&os.ModeSymlink != 0 {
+			scanFile(path, info, *versionV)
+		}
+		return nil
+	})
+}
+
+// isExe reports whether the file should be considered executable.
+func isExe(file string, info os.FileInfo) bool {
+	if runtime.GOOS == "windows" {
+		return strings.HasSuffix(strings.ToLower(file), ".exe")
+	}
+	return info.Mode().IsRegular() && info.Mode()&0111 != 0
+}
 
-	fmt.Printf("go version %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+// scanFile scans file to try to report the Go and module versions.
+// If mustPrint is true, scanFile will report any error reading file.
+// Otherwise (mustPrint is false, because scanFile is being called
+// by scanDir) scanFile prints nothing for non-Go executables.
+func scanFile(file string, info os.FileInfo, mustPrint bool) {
+	if info.Mode()&os.ModeSymlink != 0 {
+		// Accept file symlinks only.
+		i, err := os.Stat(file)
+		if err != nil || !i.Mode().IsRegular() {
+			if mustPrint {
+				fmt.Fprintf(os.Stderr, "%s: symlink\n", file)
+			}
+			return
+		}
+		info = i
+	}
+
+	if !isExe(file, info) {
+		if mustPrint {
+			fmt.Fprintf(os.Stderr, "%s: not executable file\n", file)
+		}
+		return
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		if mustPrint {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+		}
+		return
+	}
+
+	bi, ok := findBuildInfo(data)
+	if !ok {
+		if mustPrint {
+			fmt.Fprintf(os.Stderr, "%s: no build information found\n", file)
+		}
+		return
+	}
+
+	vers := bi.GoVersion
+	if vers == "" {
+		vers = "unknown"
+	}
+	fmt.Printf("%s: %s\n", file, vers)
+	if *versionM {
+		bi.GoVersion = "" // already printed
+		for _, line := range strings.Split(strings.TrimSuffix(bi.String(), "\n"), "\n") {
+			fmt.Printf("\t%s\n", line)
+		}
+	}
+}
+
+// The sentinels around the build information in a binary.
+// They must match cmd/go/internal/modload.infoStart and infoEnd,
+// and are decoded at run time so that they do not appear
+// literally in the go command itself.
+var (
+	infoStart, _ = hex.DecodeString("3077af0c9274080241e1c107e6d618e6")
+	infoEnd, _   = hex.DecodeString("f932433186182072008242104116d8f2")
+)
+
+// findBuildInfo finds and parses the build information
+// recorded by cmd/go/internal/modload.ModInfoProg in the
+// contents of an executable file.
+func findBuildInfo(data []byte) (*debug.BuildInfo, bool) {
+	i := bytes.Index(data, infoStart)
+	if i < 0 {
+		return nil, false
+	}
+	data = data[i+len(infoStart):]
+	j := bytes.Index(data, infoEnd)
+	if j < 0 {
+		return nil, false
+	}
+	bi, err := debug.ParseBuildInfo(string(data[:j]))
+	if err != nil {
+		return nil, false
+	}
+	return bi, true
 }
@@ -7,6 +7,7 @@ package vet
 
 import (
 	"cmd/go/internal/base"
+	"cmd/go/internal/cfg"
 	"cmd/go/internal/load"
 	"cmd/go/internal/modload"
 	"cmd/go/internal/work"
@@ -36,6 +37,17 @@ For example, the 'shadow' analyzer can be built and run using these commands:
   go install golang.org/x/tools/go/analysis/passes/shadow/cmd/shadow
   go vet -vettool=$(which shadow)
 
+In module mode, the main module's go.mod file may list additional
+analysis tools to run alongside the vet tool, using vet directives:
+
+  vet golang.org/x/tools/go/analysis/passes/shadow/cmd/shadow
+
+Each listed package must be a main package implementing the vet tool
+protocol, such as one built with golang.org/x/tools/go/analysis/unitchecker.
+The go command builds the tools from the module's build list and runs
+them on each package being vetted. They receive only the -c and
+-json flags, and they do not see analysis facts about dependencies.
+
 The build flags supported by go vet are those that control package resolution
 and execution, such as -n, -x, -v, -tags, and -toolexec.
 For more about these flags, see 'go help build'.
@@ -67,6 +79,22 @@ func runVet(cmd *base.Command, args []string) {
 	var b work.Builder
 	b.Init()
 
+	// Build the additional vet tools listed in the main module's go.mod.
+	if cfg.ModulesEnabled && modload.HasModRoot() {
+		var toolPaths []string
+		for _, v := range modload.ModFile().Vet {
+			toolPaths = append(toolPaths, v.Path)
+		}
+		if len(toolPaths) > 0 {
+			for _, p := range load.PackagesForBuild(toolPaths) {
+				if p.Name != "main" {
+					base.Fatalf("go vet: vet tool %s is not a main package", p.ImportPath)
+				}
+				work.VetExtraTools = append(work.VetExtraTools, b.LinkAction(work.ModeBuild, work.ModeBuild, p))
+			}
+		}
+	}
+
 	root := &work.Action{Mode: "go vet"}
 	for _, p := range pkgs {
 		_, ptest, pxtest, err := load.TestPackagesFor(p, nil)
@@ -79,11 +107,19 @@ func runVet(cmd *base.Command, args []string) {
 			continue
 		}
 		if len(ptest.GoFiles) > 0 || len(ptest.CgoFiles) > 0 {
-			root.Deps = append(root.Deps, b.VetAction(work.ModeBuild, work.ModeBuild, ptest))
+			root.Deps = append(root.Deps, vetAction(&b, ptest))
 		}
 		if pxtest != nil {
-			root.Deps = append(root.Deps, b.VetAction(work.ModeBuild, work.ModeBuild, pxtest))
+			root.Deps = append(root.Deps, vetAction(&b, pxtest))
 		}
 	}
 	b.Do(root)
 }
+
+// vetAction returns the action for vetting p,
+// which also runs the additional vet tools.
+func vetAction(b *work.Builder, p *load.Package) *work.Action {
+	a := b.VetAction(work.ModeBuild, work.ModeBuild, p)
+	a.Deps = append(a.Deps, work.VetExtraTools...)
+	return a
+}
@@ -271,7 +271,7 @@ func Get(url string, options ...Option) error {
 		base.Errorf("%s", githubMessage)
 	}
 	if !g.non200ok && g.resp.StatusCode != 200 {
-		return fmt.Errorf("unexpected status (%s): %v", url, g.resp.Status)
+		return &HTTPError{URL: url, Status: g.resp.Status, StatusCode: g.resp.StatusCode}
 	}
 
 	for _, o := range options {
@@ -282,6 +282,23 @@ func Get(url string, options ...Option) error {
 	return err
 }
 
+// An HTTPError describes an HTTP response with a non-200 status.
+type HTTPError struct {
+	URL        string // URL requested
+	Status     string // e.g. "404 Not Found"
+	StatusCode int    // e.g. 404
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("unexpected status (%s): %v", e.URL, e.Status)
+}
+
+// Is reports whether the error matches target.
+// A 404 or 410 response matches os.ErrNotExist.
+func (e *HTTPError) Is(target error) bool {
+	return target == os.ErrNotExist && (e.StatusCode == 404 || e.StatusCode == 410)
+}
+
 var githubMessage = `go: 403 response from api.github.com
 
 GitHub applies fairly small rate limits to unauthenticated users, and
@@ -13,6 +13,7 @@ import (
 	"debug/elf"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -36,6 +37,11 @@ type Builder struct {
 	flagCache   map[[2]string]bool   // a cache of supported compiler flags
 	Print       func(args ...interface{}) (int, error)
 
+	// JSON, if non-nil, receives the build output and failures of
+	// packages as a stream of JSON BuildEvents instead of the text
+	// normally printed with Print. It is set by go test -json.
+	JSON io.Writer
+
 	IsCmdList           bool // running as part of go list; set p.Stale and additional fields below
 	NeedError           bool // list needs p.Error
 	NeedExport          bool // list needs p.Export
@@ -49,6 +49,18 @@ then any resulting executables will be written to that directory.
 
 The -i flag installs the packages that are dependencies of the target.
 
+The -cover flag builds executables with coverage instrumentation.
+When such a program exits, either by returning from main or by calling
+os.Exit, it writes coverage counter data to a new file in the directory
+named by the GOCOVERDIR environment variable. By default, the packages
+in the main module are instrumented (in GOPATH mode, the packages named
+on the command line); the -coverpkg flag instead takes a comma-separated
+list of patterns selecting the packages to instrument. The -covermode flag
+sets the mode of coverage analysis, as in 'go test': set (the default,
+or atomic when -race is enabled), count or atomic. The -covermode and
+-coverpkg flags imply -cover. Use 'go tool covdata' to merge the counter
+data and convert it to a coverage profile.
+
 The build flags are shared by the build, clean, get, install, list, run,
 and test commands:
 
@@ -79,6 +91,14 @@ and test commands:
 		arguments to pass on each go tool asm invocation.
 	-buildmode mode
 		build mode to use. See 'go help buildmode' for more.
+	-buildvcs
+		whether to stamp binaries with version control information.
+		By default, when a binary is built by go build, go install or
+		go run from a main package in the main module, and the main
+		module is inside a Git or Mercurial checkout, the revision,
+		commit time and whether the checkout has uncommitted changes
+		are recorded in the binary. Use -buildvcs=false to omit them.
+		See 'go help version' for how to read the recorded information.
 	-compiler name
 		name of compiler to use, as in runtime.Compiler (gccgo or gc).
 	-gccgoflags '[pattern=]arg list'
@@ -100,6 +120,12 @@ and test commands:
 	-mod mode
 		module download mode to use: readonly or vendor.
 		See 'go help modules' for more.
+	-pgo file
+		specify the file path of a CPU profile, in the pprof format, for
+		profile-guided optimization. The compiler uses the profile to
+		inline hot calls more aggressively and to devirtualize hot
+		interface method calls. The special name "off" turns off
+		profile-guided optimization.
 	-pkgdir dir
 		install and load all packages from dir instead of the usual locations.
 		For example, when building with a non-standard configuration,
@@ -159,6 +185,8 @@ func init() {
 
 	AddBuildFlags(CmdBuild)
 	AddBuildFlags(CmdInstall)
+	AddCoverFlags(CmdBuild)
+	AddCoverFlags(CmdInstall)
 }
 
 // Note that flags consulted by other parts of the code
@@ -218,12 +246,14 @@ func AddBuildFlags(cmd *base.Command) {
 	cmd.Flag.Var(&load.BuildAsmflags, "asmflags", "")
 	cmd.Flag.Var(buildCompiler{}, "compiler", "")
 	cmd.Flag.StringVar(&cfg.BuildBuildmode, "buildmode", "default", "")
+	cmd.Flag.BoolVar(&cfg.BuildBuildvcs, "buildvcs", true, "")
 	cmd.Flag.Var(&load.BuildGcflags, "gcflags", "")
 	cmd.Flag.Var(&load.BuildGccgoflags, "gccgoflags", "")
 	cmd.Flag.StringVar(&cfg.BuildMod, "mod", "", "")
 	cmd.Flag.StringVar(&cfg.BuildContext.InstallSuffix, "installsuffix", "", "")
 	cmd.Flag.Var(&load.BuildLdflags, "ldflags", "")
 	cmd.Flag.BoolVar(&cfg.BuildLinkshared, "linkshared", false, "")
+	cmd.Flag.StringVar(&cfg.BuildPGO, "pgo", "", "")
 	cmd.Flag.StringVar(&cfg.BuildPkgdir, "pkgdir", "", "")
 	cmd.Flag.BoolVar(&cfg.BuildRace, "race", false, "")
 	cmd.Flag.BoolVar(&cfg.BuildMSan, "msan", false, "")
@@ -235,6 +265,48 @@ func AddBuildFlags(cmd *base.Command) {
 	cmd.Flag.StringVar(&cfg.DebugActiongraph, "debug-actiongraph", "", "")
 }
 
+// AddCoverFlags adds the -cover, -covermode and -coverpkg flags
+// to the commands that build executables: build, install and run.
+// The test command has its own coverage flags.
+func AddCoverFlags(cmd *base.Command) {
+	cmd.Flag.BoolVar(&cfg.BuildCover, "cover", false, "")
+	cmd.Flag.Var(coverModeFlag{}, "covermode", "")
+	cmd.Flag.Var(coverPkgFlag{}, "coverpkg", "")
+}
+
+// coverModeFlag implements flag.Value for the -covermode flag,
+// which implies -cover.
+type coverModeFlag struct{}
+
+func (coverModeFlag) String() string { return cfg.BuildCoverMode }
+
+func (coverModeFlag) Set(value string) error {
+	switch value {
+	case "set", "count", "atomic":
+	default:
+		return fmt.Errorf(`valid modes are "set", "count", or "atomic"`)
+	}
+	cfg.BuildCoverMode = value
+	cfg.BuildCover = true
+	return nil
+}
+
+// coverPkgFlag implements flag.Value for the -coverpkg flag,
+// a comma-separated list of package patterns, which implies -cover.
+type coverPkgFlag struct{}
+
+func (coverPkgFlag) String() string { return strings.Join(cfg.BuildCoverPkg, ",") }
+
+func (coverPkgFlag) Set(value string) error {
+	if value == "" {
+		cfg.BuildCoverPkg = nil
+	} else {
+		cfg.BuildCoverPkg = strings.Split(value, ",")
+	}
+	cfg.BuildCover = true
+	return nil
+}
+
 // fileExtSplit expects a filename and returns the name
 // and ext (without the dot). If the file has no
 // extension, ext will be empty.
@@ -309,6 +381,9 @@ func runBuild(cmd *base.Command, args []string) {
 	}
 
 	pkgs = omitTestOnly(pkgsFilter(load.Packages(args)))
+	if cfg.BuildCover {
+		load.PrepareForCoverageBuild(pkgs)
+	}
 
 	// Special case -o /dev/null by not writing at all.
 	if cfg.BuildO == os.DevNull {
@@ -369,6 +444,8 @@ Install compiles and installs the packages named by the import paths.
 
 The -i flag installs the dependencies of the named packages as well.
 
+The -cover, -covermode and -coverpkg flags are as for 'go build'.
+
 For more about the build flags, see 'go help build'.
 For more about specifying packages, see 'go help packages'.
 
@@ -460,6 +537,9 @@ func InstallPackages(patterns []string, pkgs []*load.Package) {
 	}
 
 	pkgs = omitTestOnly(pkgsFilter(pkgs))
+	if cfg.BuildCover {
+		load.PrepareForCoverageBuild(pkgs)
+	}
 	for _, p := range pkgs {
 		if p.Target == "" {
 			switch {
@@ -475,8 +475,8 @@ func (b *Builder) useCache(a *Action, p *load.Package, actionHash cache.ActionID
 					// If it doesn't work, it doesn't work: reusing the cached binary is more
 					// important than reprinting diagnostic information.
 					if c := cache.Default(); c != nil {
-						showStdout(b, c, a.actionID, "stdout")      // compile output
-						showStdout(b, c, a.actionID, "link-stdout") // link output
+						showStdout(b, a, c, a.actionID, "stdout")      // compile output
+						showStdout(b, a, c, a.actionID, "link-stdout") // link output
 					}
 
 					// Poison a.Target to catch uses later in the build.
@@ -500,8 +500,8 @@ func (b *Builder) useCache(a *Action, p *load.Package, actionHash cache.ActionID
 		// If it doesn't work, it doesn't work: reusing the test result is more
 		// important than reprinting diagnostic information.
 		if c := cache.Default(); c != nil {
-			showStdout(b, c, a.Deps[0].actionID, "stdout")      // compile output
-			showStdout(b, c, a.Deps[0].actionID, "link-stdout") // link output
+			showStdout(b, a, c, a.Deps[0].actionID, "stdout")      // compile output
+			showStdout(b, a, c, a.Deps[0].actionID, "link-stdout") // link output
 		}
 
 		// Poison a.Target to catch uses later in the build.
@@ -543,9 +543,9 @@ func (b *Builder) useCache(a *Action, p *load.Package, actionHash cache.ActionID
 	// but we're still happy to use results from the build artifact cache.
 	if c := cache.Default(); c != nil {
 		if !cfg.BuildA {
-			if file, _, err := c.GetFile(actionHash); err == nil {
+			if file, _, err := cache.GetFile(c, actionHash); err == nil {
 				if buildID, err := buildid.ReadFile(file); err == nil {
-					if err := showStdout(b, c, a.actionID, "stdout"); err == nil {
+					if err := showStdout(b, a, c, a.actionID, "stdout"); err == nil {
 						a.built = file
 						a.Target = "DO NOT USE - using cache"
 						a.buildID = buildID
@@ -566,8 +566,8 @@ func (b *Builder) useCache(a *Action, p *load.Package, actionHash cache.ActionID
 	return false
 }
 
-func showStdout(b *Builder, c *cache.Cache, actionID cache.ActionID, key string) error {
-	stdout, stdoutEntry, err := c.GetBytes(cache.Subkey(actionID, key))
+func showStdout(b *Builder, a *Action, c cache.Cache, actionID cache.ActionID, key string) error {
+	stdout, stdoutEntry, err := cache.GetBytes(c, cache.Subkey(actionID, key))
 	if err != nil {
 		return err
 	}
@@ -577,7 +577,7 @@ func showStdout(b *Builder, c *cache.Cache, actionID cache.ActionID, key string)
 			b.Showcmd("", "%s  # internal", joinUnambiguously(str.StringList("cat", c.OutputFile(stdoutEntry.OutputID))))
 		}
 		if !cfg.BuildN {
-			b.Print(string(stdout))
+			b.printOutput(a, string(stdout))
 		}
 	}
 	return nil
@@ -585,7 +585,7 @@ func showStdout(b *Builder, c *cache.Cache, actionID cache.ActionID, key string)
 
 // flushOutput flushes the output being queued in a.
 func (b *Builder) flushOutput(a *Action) {
-	b.Print(string(a.output))
+	b.printOutput(a, string(a.output))
 	a.output = nil
 }
 
@@ -611,7 +611,7 @@ func (b *Builder) updateBuildID(a *Action, target string, rewrite bool) error {
 	if c := cache.Default(); c != nil {
 		switch a.Mode {
 		case "build":
-			c.PutBytes(cache.Subkey(a.actionID, "stdout"), a.output)
+			cache.PutBytes(c, cache.Subkey(a.actionID, "stdout"), a.output)
 		case "link":
 			// Even though we don't cache the binary, cache the linker text output.
 			// We might notice that an installed binary is up-to-date but still
@@ -620,7 +620,7 @@ func (b *Builder) updateBuildID(a *Action, target string, rewrite bool) error {
 			// to make it easier to find when that's all we have.
 			for _, a1 := range a.Deps {
 				if p1 := a1.Package; p1 != nil && p1.Name == "main" {
-					c.PutBytes(cache.Subkey(a1.actionID, "link-stdout"), a.output)
+					cache.PutBytes(c, cache.Subkey(a1.actionID, "link-stdout"), a.output)
 					break
 				}
 			}
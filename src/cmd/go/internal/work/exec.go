@@ -57,7 +57,11 @@ func actionList(root *Action) []*Action {
 func (b *Builder) Do(root *Action) {
 	if c := cache.Default(); c != nil && !b.IsCmdList {
 		// If we're doing real work, take time at the end to trim the cache.
-		defer c.Trim()
+		defer func() {
+			if err := c.Close(); err != nil {
+				base.Fatalf("go: failed to trim cache: %v", err)
+			}
+		}()
 	}
 
 	// Build list of all actions, assigning depth-first post-order priority.
@@ -113,11 +117,18 @@ func (b *Builder) Do(root *Action) {
 		defer b.exec.Unlock()
 
 		if err != nil {
+			json := b.JSON != nil && a.Package != nil
 			if err == errPrintedOutput {
 				base.SetExitStatus(2)
+			} else if json {
+				base.SetExitStatus(1)
+				b.writeBuildEvent(a, "build-output", err.Error()+"\n")
 			} else {
 				base.Errorf("%s", err)
 			}
+			if json {
+				b.writeBuildEvent(a, "build-fail", "")
+			}
 			a.Failed = true
 		}
 
@@ -214,6 +225,9 @@ func (b *Builder) buildActionID(a *Action) cache.ActionID {
 		fmt.Fprintf(h, "cover %q %q\n", p.Internal.CoverMode, b.toolID("cover"))
 	}
 	fmt.Fprintf(h, "modinfo %q\n", p.Internal.BuildInfo)
+	if p.Internal.CoverMain != nil {
+		fmt.Fprintf(h, "covermain %q\n", p.Internal.CoverMain)
+	}
 
 	// Configuration specific to compiler toolchain.
 	switch cfg.BuildToolchainName {
@@ -224,6 +238,9 @@ func (b *Builder) buildActionID(a *Action) cache.ActionID {
 		if len(p.SFiles) > 0 {
 			fmt.Fprintf(h, "asm %q %q %q\n", b.toolID("asm"), forcedAsmflags, p.Internal.Asmflags)
 		}
+		if cfg.BuildPGO != "" {
+			fmt.Fprintf(h, "pgofile %s\n", b.fileHash(cfg.BuildPGO))
+		}
 		// GO386, GOARM, GOMIPS, etc.
 		baseArch := strings.TrimSuffix(cfg.BuildContext.GOARCH, "le")
 		fmt.Fprintf(h, "GO$GOARCH=%s\n", os.Getenv("GO"+strings.ToUpper(baseArch)))
@@ -637,6 +654,13 @@ func (b *Builder) build(a *Action) (err error) {
 		gofiles = append(gofiles, objdir+"_gomod_.go")
 	}
 
+	if p.Internal.CoverMain != nil {
+		if err := b.writeFile(objdir+"_covermain.go", p.Internal.CoverMain); err != nil {
+			return err
+		}
+		gofiles = append(gofiles, objdir+"_covermain.go")
+	}
+
 	// Compile Go.
 	objpkg := objdir + "_pkg_.a"
 	ofile, out, err := BuildToolchain.gc(b, a, objpkg, icfg.Bytes(), symabis, len(sfiles) > 0, gofiles)
@@ -752,7 +776,7 @@ func (b *Builder) build(a *Action) (err error) {
 	return nil
 }
 
-func (b *Builder) cacheObjdirFile(a *Action, c *cache.Cache, name string) error {
+func (b *Builder) cacheObjdirFile(a *Action, c cache.Cache, name string) error {
 	f, err := os.Open(a.Objdir + name)
 	if err != nil {
 		return err
@@ -762,15 +786,15 @@ func (b *Builder) cacheObjdirFile(a *Action, c *cache.Cache, name string) error
 	return err
 }
 
-func (b *Builder) findCachedObjdirFile(a *Action, c *cache.Cache, name string) (string, error) {
-	file, _, err := c.GetFile(cache.Subkey(a.actionID, name))
+func (b *Builder) findCachedObjdirFile(a *Action, c cache.Cache, name string) (string, error) {
+	file, _, err := cache.GetFile(c, cache.Subkey(a.actionID, name))
 	if err != nil {
 		return "", err
 	}
 	return file, nil
 }
 
-func (b *Builder) loadCachedObjdirFile(a *Action, c *cache.Cache, name string) error {
+func (b *Builder) loadCachedObjdirFile(a *Action, c cache.Cache, name string) error {
 	cached, err := b.findCachedObjdirFile(a, c, name)
 	if err != nil {
 		return err
@@ -816,7 +840,7 @@ func (b *Builder) cacheSrcFiles(a *Action, srcfiles []string) {
 			return
 		}
 	}
-	c.PutBytes(cache.Subkey(a.actionID, "srcfiles"), buf.Bytes())
+	cache.PutBytes(c, cache.Subkey(a.actionID, "srcfiles"), buf.Bytes())
 }
 
 func (b *Builder) loadCachedVet(a *Action) bool {
@@ -824,7 +848,7 @@ func (b *Builder) loadCachedVet(a *Action) bool {
 	if c == nil {
 		return false
 	}
-	list, _, err := c.GetBytes(cache.Subkey(a.actionID, "srcfiles"))
+	list, _, err := cache.GetBytes(c, cache.Subkey(a.actionID, "srcfiles"))
 	if err != nil {
 		return false
 	}
@@ -851,7 +875,7 @@ func (b *Builder) loadCachedSrcFiles(a *Action) bool {
 	if c == nil {
 		return false
 	}
-	list, _, err := c.GetBytes(cache.Subkey(a.actionID, "srcfiles"))
+	list, _, err := cache.GetBytes(c, cache.Subkey(a.actionID, "srcfiles"))
 	if err != nil {
 		return false
 	}
@@ -960,6 +984,22 @@ var VetTool string
 // The caller is expected to set them before executing any vet actions.
 var VetFlags []string
 
+// VetExtraTools are the actions building additional vet tools,
+// named by vet directives in the main module's go.mod file.
+// Each tool is run after the vet tool on the packages being vetted,
+// but not on their dependencies, so facts about dependencies are not
+// available to its analyzers.
+// The caller is expected to set them, and to make the top-level vet
+// actions depend on them, before executing any vet actions.
+var VetExtraTools []*Action
+
+// vetCommonFlags are the vet flags understood by every analysis tool,
+// and therefore passed to the VetExtraTools as well.
+var vetCommonFlags = map[string]bool{
+	"c":    true,
+	"json": true,
+}
+
 func (b *Builder) vet(a *Action) error {
 	// a.Deps[0] is the build of the package being vetted.
 	// a.Deps[1] is the build of the "fmt" package.
@@ -1004,7 +1044,7 @@ func (b *Builder) vet(a *Action) error {
 
 	if vcfg.VetxOnly {
 		if c := cache.Default(); c != nil && !cfg.BuildA {
-			if file, _, err := c.GetFile(key); err == nil {
+			if file, _, err := cache.GetFile(c, key); err == nil {
 				a.built = file
 				return nil
 			}
@@ -1061,9 +1101,61 @@ func (b *Builder) vet(a *Action) error {
 		f.Close()
 	}
 
+	if !vcfg.VetxOnly {
+		if err := b.vetExtra(a, vcfg, env); err != nil && runErr == nil {
+			runErr = err
+		}
+	}
+
 	return runErr
 }
 
+// vetExtra runs the VetExtraTools on the package vetted by a,
+// described by vcfg.
+func (b *Builder) vetExtra(a *Action, vcfg *vetConfig, env []string) error {
+	if len(VetExtraTools) == 0 {
+		return nil
+	}
+
+	var flags []string
+	for _, f := range VetFlags {
+		name := strings.TrimLeft(f, "-")
+		if i := strings.Index(name, "="); i >= 0 {
+			name = name[:i]
+		}
+		if vetCommonFlags[name] {
+			flags = append(flags, f)
+		}
+	}
+
+	xcfg := *vcfg
+	xcfg.PackageVetx = nil
+	var firstErr error
+	for i, t := range VetExtraTools {
+		if t.Failed || t.built == "" {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("vet tool %s was not built", t.Package.ImportPath)
+			}
+			continue
+		}
+		name := fmt.Sprintf("vet%d", i+1)
+		xcfg.VetxOutput = a.Objdir + name + ".out"
+		js, err := json.MarshalIndent(&xcfg, "", "\t")
+		if err != nil {
+			return fmt.Errorf("internal error marshaling vet config: %v", err)
+		}
+		js = append(js, '\n')
+		if err := b.writeFile(a.Objdir+name+".cfg", js); err != nil {
+			return err
+		}
+		p := a.Package
+		if err := b.run(a, p.Dir, p.ImportPath, env, cfg.BuildToolexec, t.built, flags, a.Objdir+name+".cfg"); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
 // linkActionID computes the action ID for a link action.
 func (b *Builder) linkActionID(a *Action) cache.ActionID {
 	p := a.Package
@@ -1816,7 +1908,44 @@ func (b *Builder) showOutput(a *Action, dir, desc, out string) {
 
 	b.output.Lock()
 	defer b.output.Unlock()
-	b.Print(prefix, suffix)
+	b.printOutput(a, prefix+suffix)
+}
+
+// A buildEvent is a single JSON event describing the build of a package,
+// written to b.JSON. See 'go help test' for the encoding.
+type buildEvent struct {
+	ImportPath string
+	Action     string
+	Output     string `json:",omitempty"`
+}
+
+// printOutput prints build output generated on behalf of action a.
+// If b.JSON is set and a builds a package, the output is written
+// as a "build-output" event instead of with b.Print.
+func (b *Builder) printOutput(a *Action, out string) {
+	if b.JSON != nil && a != nil && a.Package != nil {
+		if out != "" {
+			b.writeBuildEvent(a, "build-output", out)
+		}
+		return
+	}
+	b.Print(out)
+}
+
+// writeBuildEvent writes a single build event for action a to b.JSON.
+// Each event is written with a single call to Write, so that events
+// from concurrent actions are not interleaved.
+func (b *Builder) writeBuildEvent(a *Action, action, out string) {
+	js, err := json.Marshal(&buildEvent{
+		ImportPath: a.Package.Desc(),
+		Action:     action,
+		Output:     out,
+	})
+	if err != nil {
+		// Should not happen - buildEvent is valid for json.Marshal.
+		base.Fatalf("go: encoding build event: %v", err)
+	}
+	b.JSON.Write(append(js, '\n'))
 }
 
 // errPrintedOutput is a special error indicating that a command failed
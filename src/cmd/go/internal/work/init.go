@@ -22,6 +22,8 @@ func BuildInit() {
 	load.ModInit()
 	instrumentInit()
 	buildModeInit()
+	coverInit()
+	pgoInit()
 
 	// Make sure -pkgdir is absolute, because we run commands
 	// in different directories.
@@ -36,6 +38,55 @@ func BuildInit() {
 	}
 }
 
+// coverInit checks the -cover, -covermode and -coverpkg flags
+// of the build commands and fills in the default coverage mode.
+func coverInit() {
+	if !cfg.BuildCover {
+		return
+	}
+	if cfg.BuildToolchainName == "gccgo" {
+		fmt.Fprintf(os.Stderr, "go %s: -cover is not supported with the gccgo toolchain\n", flag.Args()[0])
+		base.SetExitStatus(2)
+		base.Exit()
+	}
+	if cfg.BuildCoverMode == "" {
+		cfg.BuildCoverMode = "set"
+		if cfg.BuildRace {
+			// Default coverage mode is atomic when -race is set.
+			cfg.BuildCoverMode = "atomic"
+		}
+	}
+	if cfg.BuildRace && cfg.BuildCoverMode != "atomic" {
+		fmt.Fprintf(os.Stderr, "go %s: -covermode must be \"atomic\", not %q, when -race is enabled\n", flag.Args()[0], cfg.BuildCoverMode)
+		base.SetExitStatus(2)
+		base.Exit()
+	}
+}
+
+// pgoInit checks the -pgo flag and makes the profile path absolute,
+// because we run commands in different directories.
+func pgoInit() {
+	if cfg.BuildPGO == "" || cfg.BuildPGO == "off" {
+		cfg.BuildPGO = ""
+		return
+	}
+	if cfg.BuildToolchainName == "gccgo" {
+		fmt.Fprintf(os.Stderr, "go %s: -pgo is not supported with the gccgo toolchain\n", flag.Args()[0])
+		base.SetExitStatus(2)
+		base.Exit()
+	}
+	p, err := filepath.Abs(cfg.BuildPGO)
+	if err == nil {
+		_, err = os.Stat(p)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "go %s: -pgo: %v\n", flag.Args()[0], err)
+		base.SetExitStatus(2)
+		base.Exit()
+	}
+	cfg.BuildPGO = p
+}
+
 func instrumentInit() {
 	if !cfg.BuildRace && !cfg.BuildMSan {
 		return
@@ -71,6 +71,7 @@ func init() {
 		help.HelpImportPath,
 		modload.HelpModules,
 		modget.HelpModuleGet,
+		modfetch.HelpModulePrivate,
 		help.HelpPackages,
 		modfetch.HelpSum,
 		test.HelpTestflag,
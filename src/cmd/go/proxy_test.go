@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net"
@@ -20,11 +21,13 @@ import (
 	"sync"
 	"testing"
 
+	"cmd/go/internal/dirhash"
 	"cmd/go/internal/modfetch"
 	"cmd/go/internal/modfetch/codehost"
 	"cmd/go/internal/module"
 	"cmd/go/internal/par"
 	"cmd/go/internal/semver"
+	"cmd/go/internal/sumdb"
 	"cmd/go/internal/txtar"
 )
 
@@ -96,6 +99,17 @@ func readModList() {
 
 var zipCache par.Cache
 
+const (
+	testSumDBName        = "localhost.localdev/sumdb"
+	testSumDBVerifierKey = "localhost.localdev/sumdb+f5e8495f+AZvBiaRyOvhqlrLZ1cHOULPDS+32FK3qynt8b6edY3e5"
+	testSumDBSignerKey   = "PRIVATE+KEY+localhost.localdev/sumdb+f5e8495f+AfhxQLRDqVhwxI9NzC0dQMiwKAM5XtV5HwkaHlWIBb5j"
+)
+
+var (
+	sumdbServer      = sumdb.NewServer(sumdb.NewTestServer(testSumDBSignerKey, proxyGoSum))
+	sumdbWrongServer = sumdb.NewServer(sumdb.NewTestServer(testSumDBSignerKey, proxyGoSumWrong))
+)
+
 // proxyHandler serves the Go module proxy protocol.
 // See the proxy section of https://research.swtch.com/vgo-module.
 func proxyHandler(w http.ResponseWriter, r *http.Request) {
@@ -104,6 +118,36 @@ func proxyHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	path := strings.TrimPrefix(r.URL.Path, "/mod/")
+
+	// /mod/sumdb-direct/ is the checksum database, accessed directly
+	// (GOSUMDB="<key> $GOPROXY/sumdb-direct").
+	if strings.HasPrefix(path, "sumdb-direct/") {
+		r.URL.Path = path[len("sumdb-direct"):]
+		sumdbServer.ServeHTTP(w, r)
+		return
+	}
+
+	// /mod/sumdb-wrong/ is a checksum database that signs wrong hashes
+	// for every module (GOSUMDB="<key> $GOPROXY/sumdb-wrong").
+	if strings.HasPrefix(path, "sumdb-wrong/") {
+		r.URL.Path = path[len("sumdb-wrong"):]
+		sumdbWrongServer.ServeHTTP(w, r)
+		return
+	}
+
+	// /mod/sumdb/<name>/supported reports that the proxy
+	// can serve the test checksum database, and
+	// /mod/sumdb/<name>/... serves it.
+	if path == "sumdb/"+testSumDBName+"/supported" {
+		w.WriteHeader(200)
+		return
+	}
+	if prefix := "sumdb/" + testSumDBName + "/"; strings.HasPrefix(path, prefix) {
+		r.URL.Path = path[len(prefix)-1:]
+		sumdbServer.ServeHTTP(w, r)
+		return
+	}
+
 	i := strings.Index(path, "/@v/")
 	if i < 0 {
 		http.NotFound(w, r)
@@ -229,6 +273,55 @@ func proxyHandler(w http.ResponseWriter, r *http.Request) {
 	http.NotFound(w, r)
 }
 
+// proxyGoSum returns the two go.sum lines for path@vers,
+// computed from the archive served by the proxy.
+func proxyGoSum(path, vers string) ([]byte, error) {
+	a := readArchive(path, vers)
+	if a == nil {
+		return nil, fmt.Errorf("not found: %s@%s", path, vers)
+	}
+	var names []string
+	files := make(map[string][]byte)
+	var gomod []byte
+	for _, f := range a.Files {
+		if f.Name == ".mod" {
+			gomod = f.Data
+		}
+		if strings.HasPrefix(f.Name, ".") {
+			continue
+		}
+		var name string
+		if strings.HasPrefix(f.Name, "/") {
+			name = f.Name[1:]
+		} else {
+			name = path + "@" + vers + "/" + f.Name
+		}
+		names = append(names, name)
+		files[name] = f.Data
+	}
+	h1, err := dirhash.Hash1(names, func(name string) (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(files[name])), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	h1mod, err := dirhash.Hash1([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(gomod)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	data := []byte(fmt.Sprintf("%s %s %s\n%s %s/go.mod %s\n", path, vers, h1, path, vers, h1mod))
+	return data, nil
+}
+
+// proxyGoSumWrong returns go.sum lines for path@vers
+// in which every hash is wrong.
+func proxyGoSumWrong(path, vers string) ([]byte, error) {
+	data := []byte(fmt.Sprintf("%s %s %s\n%s %s/go.mod %s\n", path, vers, "h1:wrong", path, vers, "h1:wrong"))
+	return data, nil
+}
+
 func findHash(m module.Version) string {
 	a := readArchive(m.Path, m.Version)
 	if a == nil {
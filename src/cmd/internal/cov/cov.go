@@ -0,0 +1,274 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cov reads, combines and writes the coverage counter data
+// files written to $GOCOVERDIR by programs built with "go build -cover".
+//
+// A counter data file has the same format as the profiles written by
+// "go test -coverprofile": a "mode:" line followed by one line per
+// basic block,
+//
+//	name.go:line.column,line.column numberOfStatements count
+//
+// Its name begins with CounterFilePrefix.
+package cov
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CounterFilePrefix is the prefix of the names of counter data files.
+// It must match the prefix used by package internal/coverage.
+const CounterFilePrefix = "covcounters."
+
+// A Block identifies a basic block in a source file.
+type Block struct {
+	File                string
+	StartLine, StartCol int
+	EndLine, EndCol     int
+}
+
+// A Profile holds the combined counters from a set of counter data files.
+type Profile struct {
+	Mode    string        // "set", "count" or "atomic"
+	NumStmt map[Block]int // number of statements in each block
+	Count   map[Block]int // execution count of each block
+}
+
+// NewProfile returns a new, empty profile.
+// The mode is set by the first file added to the profile.
+func NewProfile() *Profile {
+	return &Profile{
+		NumStmt: make(map[Block]int),
+		Count:   make(map[Block]int),
+	}
+}
+
+// CounterFiles returns the names of the counter data files in dir.
+func CounterFiles(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, info := range infos {
+		name := info.Name()
+		if strings.HasPrefix(name, CounterFilePrefix) && !strings.HasSuffix(name, ".tmp") && info.Mode().IsRegular() {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	return files, nil
+}
+
+// ReadDirs returns the profile combining all the counter data files
+// in the directories dirs.
+func ReadDirs(dirs []string) (*Profile, error) {
+	p := NewProfile()
+	for _, dir := range dirs {
+		files, err := CounterFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if err := p.AddFile(file); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return p, nil
+}
+
+// AddFile adds the counters in the named counter data file to p.
+func (p *Profile) AddFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := p.Add(f); err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return nil
+}
+
+var lineRE = regexp.MustCompile(`^(.+):([0-9]+)\.([0-9]+),([0-9]+)\.([0-9]+) ([0-9]+) ([0-9]+)$`)
+
+// Add adds the counters read from r, in the text profile format, to p.
+// In "set" mode a block is covered if it is covered in either profile;
+// otherwise the counts are summed.
+func (p *Profile) Add(r io.Reader) error {
+	s := bufio.NewScanner(r)
+	mode := ""
+	lineno := 0
+	for s.Scan() {
+		line := s.Text()
+		lineno++
+		if mode == "" {
+			const prefix = "mode: "
+			if !strings.HasPrefix(line, prefix) || line == prefix {
+				return fmt.Errorf("line %d: bad mode line: %v", lineno, line)
+			}
+			mode = line[len(prefix):]
+			if p.Mode == "" {
+				p.Mode = mode
+			} else if p.Mode != mode {
+				return fmt.Errorf("line %d: mode %q does not match mode %q of other counter data", lineno, mode, p.Mode)
+			}
+			continue
+		}
+		m := lineRE.FindStringSubmatch(line)
+		if m == nil {
+			return fmt.Errorf("line %d: malformed counter line: %q", lineno, line)
+		}
+		var n [6]int
+		for i := range n {
+			v, err := strconv.Atoi(m[i+2])
+			if err != nil {
+				return fmt.Errorf("line %d: malformed counter line: %q", lineno, line)
+			}
+			n[i] = v
+		}
+		b := Block{File: m[1], StartLine: n[0], StartCol: n[1], EndLine: n[2], EndCol: n[3]}
+		if old, ok := p.NumStmt[b]; ok && old != n[4] {
+			return fmt.Errorf("line %d: inconsistent NumStmt for %s: changed from %d to %d", lineno, b, old, n[4])
+		}
+		p.NumStmt[b] = n[4]
+		if p.Mode == "set" {
+			if n[5] != 0 {
+				p.Count[b] = 1
+			} else if _, ok := p.Count[b]; !ok {
+				p.Count[b] = 0
+			}
+		} else {
+			p.Count[b] += n[5]
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	if mode == "" {
+		return fmt.Errorf("missing mode line")
+	}
+	return nil
+}
+
+// Subtract clears the counts of the blocks in p
+// that are covered in q, leaving the blocks that
+// only p covers.
+func (p *Profile) Subtract(q *Profile) {
+	for b, n := range q.Count {
+		if n != 0 {
+			if _, ok := p.Count[b]; ok {
+				p.Count[b] = 0
+			}
+		}
+	}
+}
+
+// Blocks returns the blocks of p, sorted by file and position.
+func (p *Profile) Blocks() []Block {
+	blocks := make([]Block, 0, len(p.NumStmt))
+	for b := range p.NumStmt {
+		blocks = append(blocks, b)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		bi, bj := blocks[i], blocks[j]
+		if bi.File != bj.File {
+			return bi.File < bj.File
+		}
+		if bi.StartLine != bj.StartLine {
+			return bi.StartLine < bj.StartLine
+		}
+		if bi.StartCol != bj.StartCol {
+			return bi.StartCol < bj.StartCol
+		}
+		if bi.EndLine != bj.EndLine {
+			return bi.EndLine < bj.EndLine
+		}
+		return bi.EndCol < bj.EndCol
+	})
+	return blocks
+}
+
+// WriteText writes p to w in the text profile format.
+func (p *Profile) WriteText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	mode := p.Mode
+	if mode == "" {
+		mode = "set"
+	}
+	fmt.Fprintf(bw, "mode: %s\n", mode)
+	for _, b := range p.Blocks() {
+		fmt.Fprintf(bw, "%s %d %d\n", b, p.NumStmt[b], p.Count[b])
+	}
+	return bw.Flush()
+}
+
+// WriteCounterFile writes p to a new counter data file in dir.
+func (p *Profile) WriteCounterFile(dir string) error {
+	name := filepath.Join(dir, fmt.Sprintf("%s%d.%d", CounterFilePrefix, os.Getpid(), time.Now().UnixNano()))
+	tmp := name + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := p.WriteText(f); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// String returns the block in the form used by the text profile format,
+// name.go:line.column,line.column.
+func (b Block) String() string {
+	return fmt.Sprintf("%s:%d.%d,%d.%d", b.File, b.StartLine, b.StartCol, b.EndLine, b.EndCol)
+}
+
+// A PackageCoverage reports the statement coverage of one package.
+type PackageCoverage struct {
+	ImportPath string
+	Stmts      int // number of statements
+	Covered    int // number of statements executed at least once
+}
+
+// Packages returns the statement coverage of each package in p,
+// sorted by import path. The package of a block is the directory
+// of its file name.
+func (p *Profile) Packages() []PackageCoverage {
+	byPath := make(map[string]*PackageCoverage)
+	for b, n := range p.NumStmt {
+		dir := filepath.ToSlash(filepath.Dir(filepath.FromSlash(b.File)))
+		pc := byPath[dir]
+		if pc == nil {
+			pc = &PackageCoverage{ImportPath: dir}
+			byPath[dir] = pc
+		}
+		pc.Stmts += n
+		if p.Count[b] != 0 {
+			pc.Covered += n
+		}
+	}
+	var pkgs []PackageCoverage
+	for _, pc := range byPath {
+		pkgs = append(pkgs, *pc)
+	}
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].ImportPath < pkgs[j].ImportPath })
+	return pkgs
+}
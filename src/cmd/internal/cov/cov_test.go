@@ -0,0 +1,149 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cov
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	run1 = `mode: set
+p/p.go:3.20,4.11 1 1
+p/p.go:4.11,6.3 1 0
+p/p.go:7.2,7.10 2 1
+`
+	run2 = `mode: set
+p/p.go:3.20,4.11 1 1
+p/p.go:4.11,6.3 1 1
+p/p.go:7.2,7.10 2 0
+q/q.go:1.1,2.2 1 0
+`
+)
+
+func profile(t *testing.T, data ...string) *Profile {
+	t.Helper()
+	p := NewProfile()
+	for _, d := range data {
+		if err := p.Add(strings.NewReader(d)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return p
+}
+
+func text(t *testing.T, p *Profile) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := p.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestMerge(t *testing.T) {
+	p := profile(t, run1, run2)
+	want := `mode: set
+p/p.go:3.20,4.11 1 1
+p/p.go:4.11,6.3 1 1
+p/p.go:7.2,7.10 2 1
+q/q.go:1.1,2.2 1 0
+`
+	if got := text(t, p); got != want {
+		t.Errorf("merged profile:\n%s\nwant:\n%s", got, want)
+	}
+
+	pkgs := p.Packages()
+	wantPkgs := []PackageCoverage{{"p", 4, 4}, {"q", 1, 0}}
+	if len(pkgs) != len(wantPkgs) {
+		t.Fatalf("Packages() = %v, want %v", pkgs, wantPkgs)
+	}
+	for i := range pkgs {
+		if pkgs[i] != wantPkgs[i] {
+			t.Errorf("Packages()[%d] = %v, want %v", i, pkgs[i], wantPkgs[i])
+		}
+	}
+}
+
+func TestMergeCount(t *testing.T) {
+	p := profile(t, "mode: count\na.go:1.1,2.2 1 3\n", "mode: count\na.go:1.1,2.2 1 4\n")
+	if got, want := text(t, p), "mode: count\na.go:1.1,2.2 1 7\n"; got != want {
+		t.Errorf("merged profile:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	p := profile(t, run1)
+	p.Subtract(profile(t, run2))
+	want := `mode: set
+p/p.go:3.20,4.11 1 0
+p/p.go:4.11,6.3 1 0
+p/p.go:7.2,7.10 2 1
+`
+	if got := text(t, p); got != want {
+		t.Errorf("subtracted profile:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestAddErrors(t *testing.T) {
+	for _, tt := range []struct {
+		data, err string
+	}{
+		{"", "missing mode line"},
+		{"p.go:1.1,2.2 1 1\n", "bad mode line"},
+		{"mode: set\np.go:1.1 1 1\n", "malformed counter line"},
+		{"mode: count\n", `mode "count" does not match mode "set"`},
+		{"mode: set\np/p.go:3.20,4.11 2 1\n", "inconsistent NumStmt"},
+	} {
+		p := profile(t, run1)
+		err := p.Add(strings.NewReader(tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Add(%q) = %v, want error containing %q", tt.data, err, tt.err)
+		}
+	}
+}
+
+func TestReadDirs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cov")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		CounterFilePrefix + "1.1":     run1,
+		CounterFilePrefix + "2.2":     run2,
+		CounterFilePrefix + "3.3.tmp": "partial",
+		"other":                       "not counter data",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p, err := ReadDirs([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := filepath.Join(dir, "out")
+	if err := os.Mkdir(out, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.WriteCounterFile(out); err != nil {
+		t.Fatal(err)
+	}
+	q, err := ReadDirs([]string{out})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := text(t, q), text(t, profile(t, run1, run2)); got != want {
+		t.Errorf("round trip:\n%s\nwant:\n%s", got, want)
+	}
+}
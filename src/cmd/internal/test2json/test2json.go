@@ -29,12 +29,17 @@ const (
 
 // event is the JSON struct we emit.
 type event struct {
-	Time    *time.Time `json:",omitempty"`
-	Action  string
-	Package string     `json:",omitempty"`
-	Test    string     `json:",omitempty"`
-	Elapsed *float64   `json:",omitempty"`
-	Output  *textBytes `json:",omitempty"`
+	Time        *time.Time `json:",omitempty"`
+	Action      string
+	Package     string     `json:",omitempty"`
+	Test        string     `json:",omitempty"`
+	Elapsed     *float64   `json:",omitempty"`
+	Output      *textBytes `json:",omitempty"`
+	FailedBuild string     `json:",omitempty"`
+	Cached      bool       `json:",omitempty"`
+	Key         string     `json:",omitempty"`
+	Value       string     `json:",omitempty"`
+	Path        string     `json:",omitempty"`
 }
 
 // textBytes is a hack to get JSON to emit a []byte as a string
@@ -45,19 +50,22 @@ type textBytes []byte
 
 func (b textBytes) MarshalText() ([]byte, error) { return b, nil }
 
-// A converter holds the state of a test-to-JSON conversion.
+// A Converter holds the state of a test-to-JSON conversion.
 // It implements io.WriteCloser; the caller writes test output in,
 // and the converter writes JSON output to w.
-type converter struct {
-	w        io.Writer  // JSON output stream
-	pkg      string     // package to name in events
-	mode     Mode       // mode bits
-	start    time.Time  // time converter started
-	testName string     // name of current test, for output attribution
-	report   []*event   // pending test result reports (nested for subtests)
-	result   string     // overall test result if seen
-	input    lineBuffer // input buffer
-	output   lineBuffer // output buffer
+type Converter struct {
+	w           io.Writer  // JSON output stream
+	pkg         string     // package to name in events
+	mode        Mode       // mode bits
+	start       time.Time  // time converter started
+	started     bool       // whether the "start" event has been written
+	testName    string     // name of current test, for output attribution
+	report      []*event   // pending test result reports (nested for subtests)
+	result      string     // overall test result if seen
+	cached      bool       // overall test result was reported from the cache
+	failedBuild string     // package ID of the build failure, if any
+	input       lineBuffer // input buffer
+	output      lineBuffer // output buffer
 }
 
 // inBuffer and outBuffer are the input and output buffer sizes.
@@ -100,9 +108,9 @@ var (
 //
 // The pkg string, if present, specifies the import path to
 // report in the JSON stream.
-func NewConverter(w io.Writer, pkg string, mode Mode) io.WriteCloser {
-	c := new(converter)
-	*c = converter{
+func NewConverter(w io.Writer, pkg string, mode Mode) *Converter {
+	c := new(Converter)
+	*c = Converter{
 		w:     w,
 		pkg:   pkg,
 		mode:  mode,
@@ -122,11 +130,21 @@ func NewConverter(w io.Writer, pkg string, mode Mode) io.WriteCloser {
 }
 
 // Write writes the test input to the converter.
-func (c *converter) Write(b []byte) (int, error) {
+func (c *Converter) Write(b []byte) (int, error) {
 	c.input.write(b)
 	return len(b), nil
 }
 
+// SetFailedBuild records that the test binary could not be run
+// because the build of the package with the given ID failed.
+// The ID matches the ImportPath of the "build-fail" event
+// that go test -json reports for that package.
+// The final "fail" event for the package records the ID
+// in its FailedBuild field.
+func (c *Converter) SetFailedBuild(pkgID string) {
+	c.failedBuild = pkgID
+}
+
 var (
 	bigPass = []byte("PASS\n")
 	bigFail = []byte("FAIL\n")
@@ -135,6 +153,8 @@ var (
 		[]byte("=== RUN   "),
 		[]byte("=== PAUSE "),
 		[]byte("=== CONT  "),
+		[]byte("=== ATTR  "),
+		[]byte("=== ARTIFACTS "),
 	}
 
 	reports = [][]byte{
@@ -148,12 +168,15 @@ var (
 
 	skipLinePrefix = []byte("?   \t")
 	skipLineSuffix = []byte("\t[no test files]\n")
+
+	okLinePrefix   = []byte("ok  \t")
+	cachedLineMark = []byte("\t(cached)")
 )
 
 // handleInputLine handles a single whole test output line.
 // It must write the line to c.output but may choose to do so
 // before or after emitting other events.
-func (c *converter) handleInputLine(line []byte) {
+func (c *Converter) handleInputLine(line []byte) {
 	// Final PASS or FAIL.
 	if bytes.Equal(line, bigPass) || bytes.Equal(line, bigFail) {
 		c.flushReport(0)
@@ -172,16 +195,25 @@ func (c *converter) handleInputLine(line []byte) {
 		c.result = "skip"
 	}
 
+	// Result replayed from the go test cache: "ok  \tpkgname\t(cached)...".
+	if bytes.HasPrefix(line, okLinePrefix) && bytes.Contains(line, cachedLineMark) && len(c.report) == 0 {
+		c.cached = true
+	}
+
 	// "=== RUN   "
 	// "=== PAUSE "
 	// "=== CONT  "
+	// "=== ATTR  "
+	// "=== ARTIFACTS "
 	actionColon := false
 	origLine := line
 	ok := false
 	indent := 0
+	i := 0
 	for _, magic := range updates {
 		if bytes.HasPrefix(line, magic) {
 			ok = true
+			i = len(magic)
 			break
 		}
 	}
@@ -211,17 +243,35 @@ func (c *converter) handleInputLine(line []byte) {
 	}
 
 	// Parse out action and test name.
-	i := 0
 	if actionColon {
 		i = bytes.IndexByte(line, ':') + 1
 	}
-	if i == 0 {
-		i = len(updates[0])
-	}
 	action := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(string(line[4:i])), ":"))
 	name := strings.TrimSpace(string(line[i:]))
 
 	e := &event{Action: action}
+	switch action {
+	case "attr":
+		// "=== ATTR  TestName key value"
+		// Test names and keys cannot contain spaces; values can.
+		f := strings.SplitN(name, " ", 3)
+		if len(f) < 2 {
+			c.output.write(origLine)
+			return
+		}
+		name, e.Key = f[0], f[1]
+		if len(f) == 3 {
+			e.Value = f[2]
+		}
+	case "artifacts":
+		// "=== ARTIFACTS TestName dir"
+		f := strings.SplitN(name, " ", 2)
+		if len(f) < 2 {
+			c.output.write(origLine)
+			return
+		}
+		name, e.Path = f[0], f[1]
+	}
 	if line[0] == '-' { // PASS or FAIL report
 		// Parse out elapsed time.
 		if i := strings.Index(name, " ("); i >= 0 {
@@ -269,7 +319,7 @@ func (c *converter) handleInputLine(line []byte) {
 }
 
 // flushReport flushes all pending PASS/FAIL reports at levels >= depth.
-func (c *converter) flushReport(depth int) {
+func (c *Converter) flushReport(depth int) {
 	c.testName = ""
 	for len(c.report) > depth {
 		e := c.report[len(c.report)-1]
@@ -281,13 +331,17 @@ func (c *converter) flushReport(depth int) {
 // Close marks the end of the go test output.
 // It flushes any pending input and then output (only partial lines at this point)
 // and then emits the final overall package-level pass/fail event.
-func (c *converter) Close() error {
+func (c *Converter) Close() error {
 	c.input.flush()
 	c.output.flush()
 	e := &event{Action: "fail"}
 	if c.result != "" {
 		e.Action = c.result
 	}
+	if e.Action == "fail" {
+		e.FailedBuild = c.failedBuild
+	}
+	e.Cached = c.cached
 	if c.mode&Timestamp != 0 {
 		dt := time.Since(c.start).Round(1 * time.Millisecond).Seconds()
 		e.Elapsed = &dt
@@ -297,7 +351,7 @@ func (c *converter) Close() error {
 }
 
 // writeOutputEvent writes a single output event with the given bytes.
-func (c *converter) writeOutputEvent(out []byte) {
+func (c *Converter) writeOutputEvent(out []byte) {
 	c.writeEvent(&event{
 		Action: "output",
 		Output: (*textBytes)(&out),
@@ -306,13 +360,18 @@ func (c *converter) writeOutputEvent(out []byte) {
 
 // writeEvent writes a single event.
 // It adds the package, time (if requested), and test name (if needed).
-func (c *converter) writeEvent(e *event) {
+// Before the first event, it writes a "start" event for the package.
+func (c *Converter) writeEvent(e *event) {
+	if !c.started {
+		c.started = true
+		c.writeEvent(&event{Action: "start"})
+	}
 	e.Package = c.pkg
 	if c.mode&Timestamp != 0 {
 		t := time.Now()
 		e.Time = &t
 	}
-	if e.Test == "" {
+	if e.Test == "" && e.Action != "start" {
 		e.Test = c.testName
 	}
 	js, err := json.Marshal(e)
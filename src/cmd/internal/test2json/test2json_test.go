@@ -275,3 +275,19 @@ func TestTrimUTF8(t *testing.T) {
 		}
 	}
 }
+
+func TestFailedBuild(t *testing.T) {
+	var buf bytes.Buffer
+	c := NewConverter(&buf, "p", 0)
+	c.SetFailedBuild("p [p.test]")
+	c.Write([]byte("FAIL\tp [build failed]\n"))
+	c.Close()
+
+	want := `{"Action":"start","Package":"p"}
+{"Action":"output","Package":"p","Output":"FAIL\tp [build failed]\n"}
+{"Action":"fail","Package":"p","FailedBuild":"p [p.test]"}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -28,12 +28,17 @@
 // corresponding to the Go struct:
 //
 //	type TestEvent struct {
-//		Time    time.Time // encodes as an RFC3339-format string
-//		Action  string
-//		Package string
-//		Test    string
-//		Elapsed float64 // seconds
-//		Output  string
+//		Time        time.Time // encodes as an RFC3339-format string
+//		Action      string
+//		Package     string
+//		Test        string
+//		Elapsed     float64 // seconds
+//		Output      string
+//		FailedBuild string
+//		Cached      bool
+//		Key         string
+//		Value       string
+//		Path        string
 //	}
 //
 // The Time field holds the time the event happened.
@@ -41,14 +46,20 @@
 //
 // The Action field is one of a fixed set of action descriptions:
 //
-//	run    - the test has started running
-//	pause  - the test has been paused
-//	cont   - the test has continued running
-//	pass   - the test passed
-//	bench  - the benchmark printed log output but did not fail
-//	fail   - the test or benchmark failed
-//	output - the test printed output
-//	skip   - the test was skipped or the package contained no tests
+//	start     - the overall package test has started
+//	run       - the test has started running
+//	pause     - the test has been paused
+//	cont      - the test has continued running
+//	pass      - the test passed
+//	bench     - the benchmark printed log output but did not fail
+//	fail      - the test or benchmark failed
+//	output    - the test printed output
+//	skip      - the test was skipped or the package contained no tests
+//	attr      - the test recorded an attribute (see testing.T.Attr)
+//	artifacts - the test created an artifact directory (see testing.T.ArtifactDir)
+//
+// Every package test begins with a "start" event, which has no Test field,
+// and ends with a "pass", "fail", or "skip" event without a Test field.
 //
 // The Package field, if present, specifies the package being tested.
 // When the go command runs parallel tests in -json mode, events from
@@ -62,6 +73,22 @@
 // The Elapsed field is set for "pass" and "fail" events. It gives the time
 // elapsed for the specific test or the overall package test that passed or failed.
 //
+// The FailedBuild field is set for Action == "fail" when the package test
+// failed because the test binary could not be built. It gives the
+// ImportPath of the package whose build failed, which matches the
+// ImportPath of the "build-fail" event reported by "go test -json".
+//
+// The Cached field is set for the final package-level event when
+// "go test" reported a result saved in its test cache instead of
+// running the test binary.
+//
+// The Key and Value fields are set for Action == "attr" and hold the
+// key and value of an attribute recorded by the test with t.Attr.
+// The Path field is set for Action == "artifacts" and holds the
+// directory in which the test stores its output files. Artifact
+// directories are only reported when the test binary runs with
+// -test.artifacts (as with "go test -artifacts").
+//
 // The Output field is set for Action == "output" and is a portion of the test's output
 // (standard output and standard error merged together). The output is
 // unmodified except that invalid UTF-8 output from a test is coerced
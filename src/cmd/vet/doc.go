@@ -28,27 +28,34 @@ program correctness.
 
 To list the available checks, run "go tool vet help":
 
-    asmdecl      report mismatches between assembly files and Go declarations
-    assign       check for useless assignments
-    atomic       check for common mistakes using the sync/atomic package
-    bools        check for common mistakes involving boolean operators
-    buildtag     check that +build tags are well-formed and correctly located
-    cgocall      detect some violations of the cgo pointer passing rules
-    composites   check for unkeyed composite literals
-    copylocks    check for locks erroneously passed by value
-    httpresponse check for mistakes using HTTP responses
-    loopclosure  check references to loop variables from within nested functions
-    lostcancel   check cancel func returned by context.WithCancel is called
-    nilfunc      check for useless comparisons between functions and nil
-    printf       check consistency of Printf format strings and arguments
-    shift        check for shifts that equal or exceed the width of the integer
-    stdmethods   check signature of methods of well-known interfaces
-    structtag    check that struct field tags conform to reflect.StructTag.Get
-    tests        check for common mistaken usages of tests and examples
-    unmarshal    report passing non-pointer or non-interface values to unmarshal
-    unreachable  check for unreachable code
-    unsafeptr    check for invalid conversions of uintptr to unsafe.Pointer
-    unusedresult check for unused results of calls to some functions
+    asmdecl          report mismatches between assembly files and Go declarations
+    assign           check for useless assignments
+    atomic           check for common mistakes using the sync/atomic package
+    atomicalign      check for non-64-bits-aligned arguments to sync/atomic functions
+    bools            check for common mistakes involving boolean operators
+    buildtag         check that +build tags are well-formed and correctly located
+    cgocall          detect some violations of the cgo pointer passing rules
+    composites       check for unkeyed composite literals
+    copylocks        check for locks erroneously passed by value
+    errderef         check for dereferences of pointer results after an error
+    errorsas         report passing non-pointer or non-error values to errors.As
+    httpresponse     check for mistakes using HTTP responses
+    loopclosure      check references to loop variables from within nested functions
+    lostcancel       check cancel func returned by context.WithCancel is called
+    nilfunc          check for useless comparisons between functions and nil
+    printf           check consistency of Printf format strings and arguments
+    shift            check for shifts that equal or exceed the width of the integer
+    sortslice        check the argument type of sort.Slice
+    stdmethods       check signature of methods of well-known interfaces
+    stringintconv    check for string(int) conversions
+    structtag        check that struct field tags conform to reflect.StructTag.Get
+    testinggoroutine report calls to (*testing.T).Fatal from goroutines started by a test
+    tests            check for common mistaken usages of tests and examples
+    timeformat       check for calls of (time.Time).Format or time.Parse with bad layouts
+    unmarshal        report passing non-pointer or non-interface values to unmarshal
+    unreachable      check for unreachable code
+    unsafeptr        check for invalid conversions of uintptr to unsafe.Pointer
+    unusedresult     check for unused results of calls to some functions
 
 For details and flags of a particular check, such as printf, run "go tool vet help printf".
 
@@ -0,0 +1,151 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package atomicalign defines an Analyzer that checks for non-64-bit-aligned
+// arguments to sync/atomic functions. On 32-bit platforms, those functions
+// panic if their argument variables are not 64-bit aligned. It is therefore
+// the caller's responsibility to arrange for 64-bit alignment of such variables.
+// See https://golang.org/pkg/sync/atomic/#pkg-note-BUG
+package atomicalign
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const Doc = `check for non-64-bits-aligned arguments to sync/atomic functions
+
+On 386, ARM and 32-bit MIPS, the 64-bit functions of sync/atomic
+panic unless their argument is 64-bit aligned. The first word of an
+allocated struct can be relied upon to be 64-bit aligned; fields at
+other offsets can not. This checker computes struct field offsets
+using the 32-bit layout, regardless of the target architecture, and
+reports the address of a misaligned field passed to a 64-bit atomic
+function. Such code works on 64-bit platforms but panics on 32-bit ones.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "atomicalign",
+	Doc:      Doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// sizes32 is the layout used by 32-bit platforms.
+var sizes32 = types.SizesFor("gc", "386")
+
+// funcs64 lists the sync/atomic functions that require
+// a 64-bit aligned first argument.
+var funcs64 = map[string]bool{
+	"AddInt64":             true,
+	"AddUint64":            true,
+	"LoadInt64":            true,
+	"LoadUint64":           true,
+	"StoreInt64":           true,
+	"StoreUint64":          true,
+	"SwapInt64":            true,
+	"SwapUint64":           true,
+	"CompareAndSwapInt64":  true,
+	"CompareAndSwapUint64": true,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if !imports(pass.Pkg, "sync/atomic") {
+		return nil, nil // fast path
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		fn := typeutil.StaticCallee(pass.TypesInfo, call)
+		if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "sync/atomic" || !funcs64[fn.Name()] {
+			return
+		}
+		if len(call.Args) == 0 {
+			return
+		}
+		arg, ok := unparen(call.Args[0]).(*ast.UnaryExpr)
+		if !ok || arg.Op != token.AND {
+			return
+		}
+		sel, ok := unparen(arg.X).(*ast.SelectorExpr)
+		if !ok {
+			return
+		}
+		if off, ok := offset(pass.TypesInfo, sel); ok && off%8 != 0 {
+			pass.Reportf(arg.Pos(), "address of non 64-bit aligned field .%s passed to atomic.%s (misaligned on 32-bit platforms)", sel.Sel.Name, fn.Name())
+		}
+	})
+	return nil, nil
+}
+
+// offset returns the 32-bit offset of the field selected by sel
+// relative to the start of the enclosing allocation, which is
+// assumed to be 64-bit aligned. It reports false if sel is not
+// a field selection.
+func offset(info *types.Info, sel *ast.SelectorExpr) (int64, bool) {
+	s, ok := info.Selections[sel]
+	if !ok || s.Kind() != types.FieldVal {
+		return 0, false
+	}
+
+	// The base of x.f is aligned unless x is itself a field
+	// of a struct held by value.
+	var base int64
+	if _, isPtr := s.Recv().Underlying().(*types.Pointer); !isPtr {
+		if inner, ok := unparen(sel.X).(*ast.SelectorExpr); ok {
+			base, _ = offset(info, inner)
+		}
+	}
+
+	// Walk the path of (possibly embedded) fields.
+	off := base
+	t := s.Recv()
+	for _, i := range s.Index() {
+		if p, ok := t.Underlying().(*types.Pointer); ok {
+			// An embedded pointer starts a new allocation.
+			t = p.Elem()
+			off = 0
+		}
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return 0, false
+		}
+		fields := make([]*types.Var, st.NumFields())
+		for j := range fields {
+			fields[j] = st.Field(j)
+		}
+		off += sizes32.Offsetsof(fields)[i]
+		t = st.Field(i).Type()
+	}
+	return off, true
+}
+
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
+
+func imports(pkg *types.Package, path string) bool {
+	for _, imp := range pkg.Imports() {
+		if imp.Path() == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package errderef defines an Analyzer that checks for dereferences
+// of pointer results of a call in the code that handles the call's
+// error.
+package errderef
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for dereferences of pointer results after an error
+
+By convention, a function that returns a non-nil error returns zero
+values, such as nil pointers, for its other results. The errderef
+analysis reports uses of a field of a pointer result, and dereferences
+of it, in the block of an if statement that immediately follows the
+call and tests that the call's error is not nil, such as
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("%s: %v", resp.Status, err)
+	}
+
+Method calls are not reported, since methods may accept nil receivers.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "errderef",
+	Doc:      Doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.BlockStmt)(nil),
+		(*ast.CaseClause)(nil),
+		(*ast.CommClause)(nil),
+		(*ast.IfStmt)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var list []ast.Stmt
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			list = n.List
+		case *ast.CaseClause:
+			list = n.Body
+		case *ast.CommClause:
+			list = n.Body
+		case *ast.IfStmt:
+			// if x, err := f(); err != nil { ... }
+			if assign, ok := n.Init.(*ast.AssignStmt); ok {
+				checkIf(pass, assign, n)
+			}
+			return
+		}
+		for i := 1; i < len(list); i++ {
+			assign, ok := list[i-1].(*ast.AssignStmt)
+			if !ok {
+				continue
+			}
+			if ifStmt, ok := list[i].(*ast.IfStmt); ok && ifStmt.Init == nil {
+				checkIf(pass, assign, ifStmt)
+			}
+		}
+	})
+	return nil, nil
+}
+
+var errorType = types.Universe.Lookup("error").Type()
+
+// checkIf reports dereferences of the pointer results assigned by
+// assign in the body of ifStmt, if assign is a call whose last result
+// is an error and the if statement tests that the error is not nil.
+func checkIf(pass *analysis.Pass, assign *ast.AssignStmt, ifStmt *ast.IfStmt) {
+	if len(assign.Rhs) != 1 || len(assign.Lhs) < 2 {
+		return
+	}
+	if _, ok := assign.Rhs[0].(*ast.CallExpr); !ok {
+		return
+	}
+	errObj := identObj(pass, assign.Lhs[len(assign.Lhs)-1])
+	if errObj == nil || !types.Identical(errObj.Type(), errorType) {
+		return
+	}
+	if !isNotNil(pass, ifStmt.Cond, errObj) {
+		return
+	}
+
+	// The pointer results that may be nil.
+	results := make(map[types.Object]bool)
+	for _, lhs := range assign.Lhs[:len(assign.Lhs)-1] {
+		if obj := identObj(pass, lhs); obj != nil {
+			if _, ok := obj.Type().Underlying().(*types.Pointer); ok {
+				results[obj] = true
+			}
+		}
+	}
+	if len(results) == 0 {
+		return
+	}
+
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		if len(results) == 0 {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// The function may run after the result is set.
+			return false
+		case *ast.AssignStmt:
+			// Assigning a result makes it unknown,
+			// once the right-hand side is evaluated.
+			for _, rhs := range n.Rhs {
+				ast.Inspect(rhs, visit)
+			}
+			for _, lhs := range n.Lhs {
+				if obj := identObj(pass, lhs); obj != nil {
+					delete(results, obj)
+				} else {
+					ast.Inspect(lhs, visit)
+				}
+			}
+			return false
+		case *ast.SelectorExpr:
+			if sel, ok := pass.TypesInfo.Selections[n]; ok && sel.Kind() == types.FieldVal {
+				report(pass, results, n.X)
+			}
+		case *ast.StarExpr:
+			if pass.TypesInfo.Types[n].IsValue() {
+				report(pass, results, n.X)
+			}
+		}
+		return true
+	}
+	ast.Inspect(ifStmt.Body, visit)
+}
+
+// report reports a dereference of x, if x is one of results,
+// and removes it from results so that it is reported only once.
+func report(pass *analysis.Pass, results map[types.Object]bool, x ast.Expr) {
+	id, ok := unparen(x).(*ast.Ident)
+	if !ok {
+		return
+	}
+	obj := pass.TypesInfo.Uses[id]
+	if !results[obj] {
+		return
+	}
+	delete(results, obj)
+	pass.Reportf(id.Pos(), "possible nil dereference of %s, set by a call that returned a non-nil error", id.Name)
+}
+
+// identObj returns the variable denoted by e, if e is an identifier.
+func identObj(pass *analysis.Pass, e ast.Expr) types.Object {
+	id, ok := e.(*ast.Ident)
+	if !ok || id.Name == "_" {
+		return nil
+	}
+	if obj, ok := pass.TypesInfo.ObjectOf(id).(*types.Var); ok {
+		return obj
+	}
+	return nil
+}
+
+// isNotNil reports whether cond is the comparison obj != nil.
+func isNotNil(pass *analysis.Pass, cond ast.Expr, obj types.Object) bool {
+	bin, ok := unparen(cond).(*ast.BinaryExpr)
+	if !ok || bin.Op != token.NEQ {
+		return false
+	}
+	x, y := unparen(bin.X), unparen(bin.Y)
+	if pass.TypesInfo.Types[x].IsNil() {
+		x, y = y, x
+	}
+	id, ok := x.(*ast.Ident)
+	return ok && pass.TypesInfo.Uses[id] == obj && pass.TypesInfo.Types[y].IsNil()
+}
+
+// unparen returns e with any enclosing parentheses stripped.
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package stringintconv defines an Analyzer that flags type
+// conversions from integers to strings.
+package stringintconv
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for string(int) conversions
+
+This checker flags conversions of the form string(x) where x is an integer
+(but not byte or rune) type. Such conversions are discouraged because they
+return the UTF-8 representation of the Unicode code point x, and not a decimal
+string representation of x as one might expect. Furthermore, if x denotes an
+invalid code point, the conversion cannot be statically rejected.
+
+For conversions that intend on using the code point, consider replacing them
+with string(rune(x)). Otherwise, strconv.Itoa and its equivalents return the
+string representation of the value in the desired base.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "stringintconv",
+	Doc:      Doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		if len(call.Args) != 1 {
+			return
+		}
+
+		// Retrieve target type name.
+		tv, ok := pass.TypesInfo.Types[call.Fun]
+		if !ok || !tv.IsType() {
+			return
+		}
+		T := tv.Type
+		if T, ok := T.Underlying().(*types.Basic); !ok || T.Kind() != types.String {
+			return
+		}
+
+		// Retrieve source type.
+		arg := call.Args[0]
+		V := pass.TypesInfo.TypeOf(arg)
+		if V == nil {
+			return
+		}
+		vb, ok := V.Underlying().(*types.Basic)
+		if !ok || vb.Info()&types.IsInteger == 0 {
+			return
+		}
+		switch vb.Name() {
+		case "byte", "rune":
+			return
+		}
+		if vb.Kind() == types.UntypedInt || vb.Kind() == types.UntypedRune {
+			// A constant like string(65) is as clear as it gets.
+			return
+		}
+
+		// Qualify type names by package name, not path.
+		source := types.TypeString(V, (*types.Package).Name)
+		target := types.TypeString(T, (*types.Package).Name)
+		pass.Reportf(call.Pos(), "conversion from %s to %s yields a string of one rune, not a string of digits (did you mean fmt.Sprint(x)?)", source, target)
+	})
+	return nil, nil
+}
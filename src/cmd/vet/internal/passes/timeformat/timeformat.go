@@ -0,0 +1,94 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package timeformat defines an Analyzer that checks for the use
+// of time.Format or time.Parse calls with a bad layout.
+package timeformat
+
+import (
+	"go/ast"
+	"go/constant"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const badFormat = "2006-02-01"
+const goodFormat = "2006-01-02"
+
+const Doc = `check for calls of (time.Time).Format or time.Parse with bad layouts
+
+The timeformat checker looks for time layouts that do not use the
+reference time Mon Jan 2 15:04:05 MST 2006 correctly. It reports
+the layout 2006-02-01, which swaps the month and day of the
+yyyy-mm-dd format, and strftime-style directives such as %Y or %d,
+which the time package does not interpret.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "timeformat",
+	Doc:      Doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// layoutArg maps the functions and methods that take a layout
+// to the index of the layout argument.
+var layoutArg = map[string]int{
+	"(time.Time).Format":       0,
+	"(time.Time).AppendFormat": 1,
+	"time.Parse":               0,
+	"time.ParseInLocation":     0,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		fn := typeutil.StaticCallee(pass.TypesInfo, call)
+		if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "time" {
+			return
+		}
+		i, ok := layoutArg[fn.FullName()]
+		if !ok || i >= len(call.Args) {
+			return
+		}
+		arg := call.Args[i]
+		tv := pass.TypesInfo.Types[arg]
+		if tv.Value == nil || tv.Value.Kind() != constant.String {
+			return
+		}
+		layout := constant.StringVal(tv.Value)
+
+		if strings.Contains(layout, badFormat) {
+			pass.Reportf(arg.Pos(), badFormat+" should be "+goodFormat)
+		}
+
+		if d := strftimeDirective(layout); d != "" {
+			pass.Reportf(arg.Pos(), "time layout contains strftime directive %s; use the reference time Mon Jan 2 15:04:05 MST 2006", d)
+		}
+	})
+	return nil, nil
+}
+
+// strftimeDirective returns the first strftime-style directive,
+// such as %Y, in layout, or "" if there is none.
+func strftimeDirective(layout string) string {
+	for i := 0; i+1 < len(layout); i++ {
+		if layout[i] != '%' {
+			continue
+		}
+		switch layout[i+1] {
+		case 'Y', 'y', 'm', 'd', 'H', 'I', 'M', 'S', 'p', 'z', 'Z', 'b', 'B', 'a', 'A', 'j':
+			return layout[i : i+2]
+		}
+	}
+	return ""
+}
@@ -2,6 +2,13 @@ package main
 
 import (
 	"cmd/internal/objabi"
+	"cmd/vet/internal/passes/atomicalign"
+	"cmd/vet/internal/passes/errderef"
+	"cmd/vet/internal/passes/errorsas"
+	"cmd/vet/internal/passes/sortslice"
+	"cmd/vet/internal/passes/stringintconv"
+	"cmd/vet/internal/passes/testinggoroutine"
+	"cmd/vet/internal/passes/timeformat"
 
 	"golang.org/x/tools/go/analysis/unitchecker"
 
@@ -35,20 +42,27 @@ func main() {
 		asmdecl.Analyzer,
 		assign.Analyzer,
 		atomic.Analyzer,
+		atomicalign.Analyzer,
 		bools.Analyzer,
 		buildtag.Analyzer,
 		cgocall.Analyzer,
 		composite.Analyzer,
 		copylock.Analyzer,
+		errderef.Analyzer,
+		errorsas.Analyzer,
 		httpresponse.Analyzer,
 		loopclosure.Analyzer,
 		lostcancel.Analyzer,
 		nilfunc.Analyzer,
 		printf.Analyzer,
 		shift.Analyzer,
+		sortslice.Analyzer,
 		stdmethods.Analyzer,
+		stringintconv.Analyzer,
 		structtag.Analyzer,
+		testinggoroutine.Analyzer,
 		tests.Analyzer,
+		timeformat.Analyzer,
 		unmarshal.Analyzer,
 		unreachable.Analyzer,
 		unsafeptr.Analyzer,
@@ -0,0 +1,41 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the atomicalign checker.
+
+package atomicalign
+
+import "sync/atomic"
+
+type aligned struct {
+	n int64 // first word of an allocation is 64-bit aligned
+	b bool
+}
+
+type misaligned struct {
+	b bool
+	n int64
+	u uint64
+}
+
+type outer struct {
+	x int32
+	a aligned
+	p *aligned
+}
+
+func AtomicAlignTests() {
+	var a aligned
+	atomic.AddInt64(&a.n, 1)
+
+	var m misaligned
+	atomic.AddInt64(&m.n, 1)       // ERROR "address of non 64-bit aligned field .n passed to atomic.AddInt64"
+	atomic.LoadUint64(&m.u)        // ERROR "address of non 64-bit aligned field .u passed to atomic.LoadUint64"
+	atomic.AddInt32(new(int32), 1) // not a 64-bit function
+	_ = atomic.LoadInt64((&m.n))   // ERROR "address of non 64-bit aligned field .n passed to atomic.LoadInt64"
+
+	var o outer
+	atomic.StoreInt64(&o.a.n, 1) // ERROR "address of non 64-bit aligned field .n passed to atomic.StoreInt64"
+	atomic.StoreInt64(&o.p.n, 1) // p points to its own allocation
+}
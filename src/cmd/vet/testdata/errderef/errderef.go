@@ -0,0 +1,73 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the errderef checker.
+
+package errderef
+
+import (
+	"errors"
+	"fmt"
+)
+
+type T struct {
+	Name string
+	Next *T
+}
+
+func (t *T) String() string {
+	if t == nil {
+		return "<nil>"
+	}
+	return t.Name
+}
+
+func find(name string) (*T, error) {
+	return nil, errors.New("not found")
+}
+
+func ErrDerefTests() {
+	t, err := find("a")
+	if err != nil {
+		fmt.Println(t.Name) // ERROR "possible nil dereference of t, set by a call that returned a non-nil error"
+		fmt.Println(t.Next) // reported once
+	}
+
+	if t, err := find("b"); err != nil {
+		_ = *t // ERROR "possible nil dereference of t, set by a call that returned a non-nil error"
+	}
+
+	t, err = find("c")
+	if nil != err {
+		t.Next = nil // ERROR "possible nil dereference of t, set by a call that returned a non-nil error"
+	}
+
+	t, err = find("d")
+	if err != nil {
+		fmt.Println(t.String()) // methods may accept nil receivers
+		fmt.Println(t == nil)
+		f := func() string { return t.Name }
+		t = &T{Name: "default"}
+		fmt.Println(t.Name, f())
+	}
+
+	t, err = find("e")
+	if err == nil {
+		fmt.Println(t.Name)
+	}
+
+	t, err = find("f")
+	fmt.Println(err)
+	if err != nil {
+		fmt.Println(t.Name) // the if statement does not follow the call
+	}
+
+	switch {
+	case true:
+		t, err := find("g")
+		if err != nil {
+			fmt.Println(t.Name) // ERROR "possible nil dereference of t, set by a call that returned a non-nil error"
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the errorsas checker.
+
+package errorsas
+
+import "errors"
+
+type myError int
+
+func (myError) Error() string { return "" }
+
+func ErrorsAsTests(err error) {
+	var (
+		e  error
+		m  myError
+		pm *myError
+		i  interface{}
+		s  notError
+	)
+	errors.As(err, &e)
+	errors.As(err, &m)
+	errors.As(err, &pm)
+	errors.As(err, &s)  // ERROR "second argument to errors.As must be a pointer to an interface or to a type implementing error"
+	errors.As(err, m)   // ERROR "second argument to errors.As must be a pointer to an interface or to a type implementing error"
+	errors.As(err, nil) // ERROR "second argument to errors.As must be a pointer to an interface or to a type implementing error"
+	errors.As(err, i)   // the dynamic type cannot be known
+	var r interface{ Read() }
+	errors.As(err, &r)
+}
+
+type notError struct{}
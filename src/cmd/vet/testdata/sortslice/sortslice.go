@@ -0,0 +1,22 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the sortslice checker.
+
+package sortslice
+
+import "sort"
+
+type S []int
+
+func SortSliceTests(x interface{}) {
+	s := []int{2, 1}
+	sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+	sort.SliceStable(S(s), func(i, j int) bool { return s[i] < s[j] })
+	sort.Slice(x, func(i, j int) bool { return false })
+
+	a := [2]int{2, 1}
+	sort.Slice(a, func(i, j int) bool { return a[i] < a[j] })          // ERROR "sort.Slice's argument must be a slice; is called with \[2\]int"
+	sort.SliceIsSorted(&s, func(i, j int) bool { return s[i] < s[j] }) // ERROR "sort.SliceIsSorted's argument must be a slice; is called with \*\[\]int"
+}
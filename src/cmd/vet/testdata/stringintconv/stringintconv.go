@@ -0,0 +1,34 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the stringintconv checker.
+
+package stringintconv
+
+type A string
+
+type C int
+
+type R rune
+
+func StringTest() {
+	var (
+		i int
+		j rune
+		k byte
+		l C
+		m R
+		n int32
+		o uint64
+	)
+	_ = string(i) // ERROR "conversion from int to string yields a string of one rune"
+	_ = string(j)
+	_ = string(k)
+	_ = string(l) // ERROR "conversion from stringintconv.C to string yields a string of one rune"
+	_ = string(m)
+	_ = string(n) // ERROR "conversion from int32 to string yields a string of one rune"
+	_ = A(o)      // ERROR "conversion from uint64 to stringintconv.A yields a string of one rune"
+	_ = string(65)
+	_ = string(rune(i))
+}
@@ -0,0 +1,40 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the testinggoroutine checker.
+
+package testinggoroutine
+
+import "testing"
+
+func TestBadFatal(t *testing.T) {
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		t.Fatal("failed") // ERROR "call to \(\*testing.T\).Fatal from a non-test goroutine"
+	}()
+	<-done
+}
+
+func TestBadSkip(t *testing.T) {
+	go func() {
+		t.SkipNow() // ERROR "call to \(\*testing.T\).SkipNow from a non-test goroutine"
+	}()
+}
+
+func BenchmarkBadFailNow(b *testing.B) {
+	go func() {
+		b.FailNow() // ERROR "call to \(\*testing.B\).FailNow from a non-test goroutine"
+	}()
+}
+
+func TestOK(t *testing.T) {
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		t.Error("failed") // Error does not stop the goroutine
+	}()
+	<-done
+	t.Fatal("failed")
+}
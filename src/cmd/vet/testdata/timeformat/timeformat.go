@@ -0,0 +1,21 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the timeformat checker.
+
+package timeformat
+
+import "time"
+
+const bad = "2006-02-01 15:04"
+
+func TimeFormatTests(t time.Time) {
+	_ = t.Format("2006-01-02")
+	_ = t.Format("2006-02-01")                    // ERROR "2006-02-01 should be 2006-01-02"
+	_ = t.Format(bad)                             // ERROR "2006-02-01 should be 2006-01-02"
+	_ = t.AppendFormat(nil, "%Y-%m-%d")           // ERROR "time layout contains strftime directive %Y"
+	_, _ = time.Parse("2006-02-01", "2019-01-02") // ERROR "2006-02-01 should be 2006-01-02"
+	_, _ = time.Parse("100%", "100%")
+	_ = t.Format("Jan 2, 2006 at 3:04pm (MST)")
+}
@@ -82,12 +82,15 @@ func TestVet(t *testing.T) {
 		"asm",
 		"assign",
 		"atomic",
+		"atomicalign",
 		"bool",
 		"buildtag",
 		"cgo",
 		"composite",
 		"copylock",
 		"deadcode",
+		"errderef",
+		"errorsas",
 		"httpresponse",
 		"lostcancel",
 		"method",
@@ -95,9 +98,13 @@ func TestVet(t *testing.T) {
 		"print",
 		"rangeloop",
 		"shift",
+		"sortslice",
+		"stringintconv",
 		"structtag",
+		"testinggoroutine",
 		"testingpkg",
 		// "testtag" has its own test
+		"timeformat",
 		"unmarshal",
 		"unsafeptr",
 		"unused",
@@ -0,0 +1,56 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+// bitWriter accumulates values, most-significant bit first, into a byte
+// slice. It is the counterpart of bitReader.
+type bitWriter struct {
+	out  []byte
+	n    uint64 // pending bits, in the least-significant part
+	bits uint   // number of pending bits
+}
+
+// WriteBits writes the low bits bits of v, which must be at most 32.
+func (bw *bitWriter) WriteBits(bits uint, v uint32) {
+	bw.n = bw.n<<bits | uint64(v)&(1<<bits-1)
+	bw.bits += bits
+	for bw.bits >= 8 {
+		bw.bits -= 8
+		bw.out = append(bw.out, byte(bw.n>>bw.bits))
+	}
+}
+
+// WriteBits64 writes the low bits bits of v, which must be at most 64.
+func (bw *bitWriter) WriteBits64(bits uint, v uint64) {
+	if bits > 32 {
+		bw.WriteBits(bits-32, uint32(v>>32))
+		bits = 32
+	}
+	bw.WriteBits(bits, uint32(v))
+}
+
+// WriteBit writes a single bit.
+func (bw *bitWriter) WriteBit(b bool) {
+	v := uint32(0)
+	if b {
+		v = 1
+	}
+	bw.WriteBits(1, v)
+}
+
+// Bytes returns the complete bytes written so far, keeping any
+// pending bits, and empties the buffer.
+func (bw *bitWriter) Bytes() []byte {
+	b := bw.out
+	bw.out = bw.out[:0]
+	return b
+}
+
+// Pad writes zero bits up to the next byte boundary.
+func (bw *bitWriter) Pad() {
+	if bw.bits > 0 {
+		bw.WriteBits(8-bw.bits, 0)
+	}
+}
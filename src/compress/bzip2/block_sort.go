@@ -0,0 +1,139 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+// blockSorter computes the Burrows-Wheeler transform of a block. Its
+// buffers are kept between blocks.
+type blockSorter struct {
+	sa, tmp, rank, count []int32
+}
+
+// transform returns the Burrows-Wheeler transform of block, which is the
+// last column of the sorted matrix of its rotations, and the row of that
+// matrix holding the block itself. The transform is appended to out[:0].
+func (bs *blockSorter) transform(block []byte, out []byte) ([]byte, int) {
+	n := len(block)
+	sa := bs.sortRotations(block)
+	out = out[:0]
+	origPtr := 0
+	for j, i := range sa {
+		if i == 0 {
+			origPtr = j
+			i = int32(n)
+		}
+		out = append(out, block[i-1])
+	}
+	return out, origPtr
+}
+
+// sortRotations returns the start indexes of the rotations of block in
+// sorted order.
+//
+// It uses prefix doubling: after the round for k, the rotations are
+// sorted by their first 2k bytes and rank holds the rank of each among
+// the distinct prefixes. Each round is a stable counting sort by the
+// rank of the first half, of rotations already sorted by the second.
+// Rotations that are equal, as happen in periodic blocks, keep some
+// order among themselves, which does not change the transform.
+func (bs *blockSorter) sortRotations(block []byte) []int32 {
+	n := len(block)
+	if cap(bs.sa) < n {
+		bs.sa = make([]int32, n)
+		bs.tmp = make([]int32, n)
+		bs.rank = make([]int32, n)
+	}
+	// The counts are for the pairs of bytes, and then for the ranks.
+	if len(bs.count) < n || len(bs.count) < 1<<16 {
+		size := n
+		if size < 1<<16 {
+			size = 1 << 16
+		}
+		bs.count = make([]int32, size)
+	}
+	sa, tmp, rank, count := bs.sa[:n], bs.tmp[:n], bs.rank[:n], bs.count
+	if n == 0 {
+		return sa
+	}
+
+	// Sort by the first two bytes.
+	c := count[:1<<16]
+	for i := range c {
+		c[i] = 0
+	}
+	key := func(i int) int {
+		j := i + 1
+		if j == n {
+			j = 0
+		}
+		return int(block[i])<<8 | int(block[j])
+	}
+	for i := range block {
+		c[key(i)]++
+	}
+	sum := int32(0)
+	for k := range c {
+		sum, c[k] = sum+c[k], sum
+	}
+	for i := range block {
+		k := key(i)
+		sa[c[k]] = int32(i)
+		c[k]++
+	}
+	classes := int32(0)
+	for j, i := range sa {
+		if j > 0 && key(int(i)) != key(int(sa[j-1])) {
+			classes++
+		}
+		rank[i] = classes
+	}
+	classes++
+
+	for k := 2; k < n && int(classes) < n; k <<= 1 {
+		// The rotations starting k bytes before those in sa are
+		// sorted by their second half.
+		for j, i := range sa {
+			i -= int32(k)
+			if i < 0 {
+				i += int32(n)
+			}
+			tmp[j] = i
+		}
+		count := count[:classes]
+		for i := range count {
+			count[i] = 0
+		}
+		for _, r := range rank {
+			count[r]++
+		}
+		sum := int32(0)
+		for r := range count {
+			sum, count[r] = sum+count[r], sum
+		}
+		for _, i := range tmp {
+			r := rank[i]
+			sa[count[r]] = i
+			count[r]++
+		}
+
+		// Rank the rotations by their first 2k bytes, in tmp.
+		newRank := tmp
+		classes = 0
+		prev, prev2 := int32(-1), int32(-1)
+		for _, i := range sa {
+			i2 := i + int32(k)
+			if i2 >= int32(n) {
+				i2 -= int32(n)
+			}
+			r, r2 := rank[i], rank[i2]
+			if r != prev || r2 != prev2 {
+				classes++
+				prev, prev2 = r, r2
+			}
+			newRank[i] = classes - 1
+		}
+		rank, tmp = newRank, rank
+	}
+	return sa
+}
@@ -235,3 +235,85 @@ func buildHuffmanNode(t *huffmanTree, codes []huffmanCode, level uint32) (nodeIn
 
 	return
 }
+
+// huffmanCodeLengths sets lengths to the code lengths of a Huffman code
+// for symbols with the given frequencies, none of them longer than
+// maxLen. Every symbol gets a code, as if it occurred at least once.
+func huffmanCodeLengths(lengths []uint8, freqs []int32, maxLen uint8) {
+	n := len(freqs)
+	weights := make([]int32, 2*n-1)
+	parents := make([]int32, 2*n-1)
+	for i, f := range freqs {
+		if f == 0 {
+			f = 1
+		}
+		weights[i] = f
+	}
+	leaves := make([]int32, n)
+	for {
+		for i := range leaves {
+			leaves[i] = int32(i)
+		}
+		sort.SliceStable(leaves, func(i, j int) bool {
+			return weights[leaves[i]] < weights[leaves[j]]
+		})
+
+		// Merge the two lightest nodes until one is left. Internal
+		// nodes are made in order of weight, so the lightest node is
+		// at the front of leaves or of the internal nodes.
+		nextLeaf, nextNode := 0, n
+		lightest := func(last int) int32 {
+			if nextLeaf < n && (nextNode >= last || weights[leaves[nextLeaf]] <= weights[nextNode]) {
+				nextLeaf++
+				return leaves[nextLeaf-1]
+			}
+			nextNode++
+			return int32(nextNode - 1)
+		}
+		for node := n; node < 2*n-1; node++ {
+			a, b := lightest(node), lightest(node)
+			weights[node] = weights[a] + weights[b]
+			parents[a], parents[b] = int32(node), int32(node)
+		}
+
+		// The depth of a node is one more than that of its parent,
+		// which was made after it.
+		tooLong := false
+		depths := parents // reused, from the root down
+		depths[2*n-2] = 0
+		for node := 2*n - 3; node >= 0; node-- {
+			depths[node] = depths[parents[node]] + 1
+			if node < n {
+				lengths[node] = uint8(depths[node])
+				if depths[node] > int32(maxLen) {
+					tooLong = true
+				}
+			}
+		}
+		if !tooLong {
+			return
+		}
+
+		// Flatten the frequencies and try again, as the reference
+		// implementation does.
+		for i := range freqs {
+			weights[i] = 1 + weights[i]/2
+		}
+	}
+}
+
+// huffmanCodes sets codes to the canonical codes for the code lengths
+// lengths: codes of the same length are consecutive, in symbol order,
+// and shorter codes come first. newHuffmanTree decodes the same codes.
+func huffmanCodes(codes []uint32, lengths []uint8) {
+	code := uint32(0)
+	for length := uint8(1); length <= 32; length++ {
+		for i, l := range lengths {
+			if l == length {
+				codes[i] = code
+				code++
+			}
+		}
+		code <<= 1
+	}
+}
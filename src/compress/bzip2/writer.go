@@ -0,0 +1,418 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The compression level is the block size in units of 100 kB. Larger
+// blocks compress better, but use more memory.
+const (
+	BestSpeed          = 1
+	BestCompression    = 9
+	DefaultCompression = -1
+)
+
+const (
+	// maxCodeLen is the longest Huffman code the Writer makes. The
+	// format allows 20 bits, but the reference implementation limits
+	// its codes to 17.
+	maxCodeLen = 17
+
+	// groupSize is the number of symbols coded with one of the Huffman
+	// tables before the next one is selected.
+	groupSize = 50
+)
+
+var errWriterClosed = errors.New("bzip2: write to closed Writer")
+
+// A Writer is an io.WriteCloser.
+// Writes to a Writer are compressed and written to w.
+type Writer struct {
+	w           io.Writer
+	level       int
+	maxBlock    int // largest block, after the initial run-length encoding
+	wroteHeader bool
+	closed      bool
+	err         error
+
+	// The initial run-length encoding replaces runs of 4 to 255 equal
+	// bytes with 4 of them and a count of the rest. The current run,
+	// runLen copies of runByte, is still pending.
+	runByte  byte
+	runLen   int
+	block    []byte
+	blockCRC uint32
+	fileCRC  uint32
+
+	bw      bitWriter
+	sorter  blockSorter
+	bwt     []byte
+	symbols []uint16
+}
+
+// NewWriter returns a new Writer.
+// Writes to the returned writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the Writer when done.
+// Writes may be buffered and not flushed until Close.
+func NewWriter(w io.Writer) *Writer {
+	z, _ := NewWriterLevel(w, DefaultCompression)
+	return z
+}
+
+// NewWriterLevel is like NewWriter but specifies the compression level instead
+// of assuming DefaultCompression.
+//
+// The compression level can be DefaultCompression, which is the same as
+// BestCompression, or any integer value between BestSpeed and
+// BestCompression inclusive. The error returned will be nil if the level
+// is valid.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	if level == DefaultCompression {
+		level = BestCompression
+	}
+	if level < BestSpeed || level > BestCompression {
+		return nil, fmt.Errorf("bzip2: invalid compression level: %d", level)
+	}
+	z := &Writer{
+		level: level,
+		// Like the reference implementation, keep some room for
+		// the run being ended.
+		maxBlock: level*100*1000 - 19,
+	}
+	z.Reset(w)
+	return z, nil
+}
+
+// Reset discards the Writer z's state and makes it equivalent to the
+// result of its original state from NewWriter or NewWriterLevel, but
+// writing to w instead. This permits reusing a Writer rather than
+// allocating a new one.
+func (z *Writer) Reset(w io.Writer) {
+	z.w = w
+	z.wroteHeader = false
+	z.closed = false
+	z.err = nil
+	z.runLen = 0
+	z.block = z.block[:0]
+	z.blockCRC = 0
+	z.fileCRC = 0
+	z.bw.out = z.bw.out[:0]
+	z.bw.n, z.bw.bits = 0, 0
+}
+
+// Write writes a compressed form of p to the underlying io.Writer. The
+// compressed bytes are not necessarily flushed until the Writer is
+// closed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	if z.closed {
+		return 0, errWriterClosed
+	}
+	for _, b := range p {
+		if z.runLen > 0 && b == z.runByte && z.runLen < 255 {
+			z.runLen++
+			continue
+		}
+		z.endRun()
+		if len(z.block)+5 > z.maxBlock {
+			z.writeBlock()
+			if z.err != nil {
+				return 0, z.err
+			}
+		}
+		z.runByte, z.runLen = b, 1
+	}
+	return len(p), nil
+}
+
+// endRun adds the pending run to the block.
+func (z *Writer) endRun() {
+	if z.runLen == 0 {
+		return
+	}
+	b := z.runByte
+	crc := ^z.blockCRC
+	for i := 0; i < z.runLen; i++ {
+		crc = crctab[byte(crc>>24)^b] ^ (crc << 8)
+	}
+	z.blockCRC = ^crc
+	if z.runLen < 4 {
+		for i := 0; i < z.runLen; i++ {
+			z.block = append(z.block, b)
+		}
+	} else {
+		z.block = append(z.block, b, b, b, b, byte(z.runLen-4))
+	}
+	z.runLen = 0
+}
+
+// writeHeader writes the stream header if it has not been written yet.
+func (z *Writer) writeHeader() {
+	if z.wroteHeader {
+		return
+	}
+	z.wroteHeader = true
+	z.bw.WriteBits(16, bzip2FileMagic)
+	z.bw.WriteBits(8, 'h')
+	z.bw.WriteBits(8, uint32('0'+z.level))
+}
+
+// writeBlock compresses and writes the block.
+func (z *Writer) writeBlock() {
+	if len(z.block) == 0 {
+		return
+	}
+	z.writeHeader()
+	bw := &z.bw
+
+	var origPtr int
+	z.bwt, origPtr = z.sorter.transform(z.block, z.bwt)
+
+	var inUse [256]bool
+	for _, b := range z.block {
+		inUse[b] = true
+	}
+	var ranges uint32
+	for i := 0; i < 16; i++ {
+		for j := 0; j < 16; j++ {
+			if inUse[i*16+j] {
+				ranges |= 1 << uint(15-i)
+				break
+			}
+		}
+	}
+
+	bw.WriteBits64(48, bzip2BlockMagic)
+	bw.WriteBits(32, z.blockCRC)
+	bw.WriteBits(1, 0) // not randomized
+	bw.WriteBits(24, uint32(origPtr))
+	bw.WriteBits(16, ranges)
+	for i := 0; i < 16; i++ {
+		if ranges&(1<<uint(15-i)) == 0 {
+			continue
+		}
+		var bits uint32
+		for j := 0; j < 16; j++ {
+			if inUse[i*16+j] {
+				bits |= 1 << uint(15-j)
+			}
+		}
+		bw.WriteBits(16, bits)
+	}
+
+	var freqs [258]int32
+	alphaSize := z.moveToFront(&inUse, &freqs)
+	z.writeSymbols(freqs[:alphaSize])
+
+	z.fileCRC = (z.fileCRC<<1 | z.fileCRC>>31) ^ z.blockCRC
+	z.block = z.block[:0]
+	z.blockCRC = 0
+	_, z.err = z.w.Write(bw.Bytes())
+}
+
+// moveToFront computes the symbols to code for the transformed block in
+// z.bwt: its move-to-front transform, with runs of zeros coded as
+// RUNA and RUNB digits, and ending with an EOB symbol. It counts the
+// symbols in freqs and returns the size of the alphabet.
+func (z *Writer) moveToFront(inUse *[256]bool, freqs *[258]int32) int {
+	var mtf [256]byte
+	numInUse := 0
+	for i, used := range inUse {
+		if used {
+			mtf[numInUse] = byte(i)
+			numInUse++
+		}
+	}
+	eob := uint16(numInUse + 1)
+
+	symbols := z.symbols[:0]
+	zeros := 0
+	endZeros := func() {
+		// The run length is written in bijective base 2, least
+		// significant digit first, with digits RUNA (1) and RUNB (2).
+		for zeros > 0 {
+			zeros--
+			s := uint16(zeros & 1)
+			symbols = append(symbols, s)
+			freqs[s]++
+			zeros >>= 1
+		}
+	}
+	for _, b := range z.bwt {
+		if mtf[0] == b {
+			zeros++
+			continue
+		}
+		endZeros()
+		j := 1
+		for mtf[j] != b {
+			j++
+		}
+		copy(mtf[1:j+1], mtf[:j])
+		mtf[0] = b
+		// The front of the list is only ever coded as a run, so an
+		// index of j is coded as j+1.
+		symbols = append(symbols, uint16(j+1))
+		freqs[j+1]++
+	}
+	endZeros()
+	symbols = append(symbols, eob)
+	freqs[eob]++
+	z.symbols = symbols
+	return numInUse + 2
+}
+
+// writeSymbols chooses the Huffman tables for the symbols in z.symbols,
+// whose frequencies are freqs, and writes the tables and the symbols.
+func (z *Writer) writeSymbols(freqs []int32) {
+	bw := &z.bw
+	symbols := z.symbols
+	alphaSize := len(freqs)
+
+	numTables := 6
+	switch n := len(symbols); {
+	case n < 200:
+		numTables = 2
+	case n < 600:
+		numTables = 3
+	case n < 1200:
+		numTables = 4
+	case n < 2400:
+		numTables = 5
+	}
+
+	// Start with tables that favor ranges of symbols of about equal
+	// total frequency, as the reference implementation does.
+	var lengths [6][258]uint8
+	remaining := int32(len(symbols))
+	start := 0
+	for part := numTables; part > 0; part-- {
+		target := remaining / int32(part)
+		end := start - 1
+		sum := int32(0)
+		for sum < target && end < alphaSize-1 {
+			end++
+			sum += freqs[end]
+		}
+		if end > start && part != numTables && part != 1 && (numTables-part)%2 == 1 {
+			sum -= freqs[end]
+			end--
+		}
+		for s := 0; s < alphaSize; s++ {
+			if s < start || s > end {
+				lengths[part-1][s] = 15
+			}
+		}
+		start = end + 1
+		remaining -= sum
+	}
+
+	// Refine the tables: code each group of symbols with the table
+	// that codes it best, and rebuild each table from the symbols it
+	// was chosen for.
+	numSelectors := (len(symbols) + groupSize - 1) / groupSize
+	selectors := make([]uint8, numSelectors)
+	tableFreqs := make([][]int32, numTables)
+	for t := range tableFreqs {
+		tableFreqs[t] = make([]int32, alphaSize)
+	}
+	for iter := 0; iter < 4; iter++ {
+		for t := range tableFreqs {
+			for s := range tableFreqs[t] {
+				tableFreqs[t][s] = 0
+			}
+		}
+		for i := range selectors {
+			group := symbols[i*groupSize:]
+			if len(group) > groupSize {
+				group = group[:groupSize]
+			}
+			best, bestCost := 0, -1
+			for t := 0; t < numTables; t++ {
+				cost := 0
+				for _, s := range group {
+					cost += int(lengths[t][s])
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = t, cost
+				}
+			}
+			selectors[i] = uint8(best)
+			for _, s := range group {
+				tableFreqs[best][s]++
+			}
+		}
+		for t := 0; t < numTables; t++ {
+			huffmanCodeLengths(lengths[t][:alphaSize], tableFreqs[t], maxCodeLen)
+		}
+	}
+
+	// The selectors are move-to-front transformed and written in unary.
+	bw.WriteBits(3, uint32(numTables))
+	bw.WriteBits(15, uint32(numSelectors))
+	mtf := [6]uint8{0, 1, 2, 3, 4, 5}
+	for _, sel := range selectors {
+		j := 0
+		for mtf[j] != sel {
+			j++
+		}
+		copy(mtf[1:j+1], mtf[:j])
+		mtf[0] = sel
+		bw.WriteBits(uint(j+1), 1<<uint(j+1)-2)
+	}
+
+	// The code lengths are delta coded.
+	var codes [6][258]uint32
+	for t := 0; t < numTables; t++ {
+		cur := lengths[t][0]
+		bw.WriteBits(5, uint32(cur))
+		for _, l := range lengths[t][:alphaSize] {
+			for ; cur < l; cur++ {
+				bw.WriteBits(2, 2)
+			}
+			for ; cur > l; cur-- {
+				bw.WriteBits(2, 3)
+			}
+			bw.WriteBit(false)
+		}
+		huffmanCodes(codes[t][:alphaSize], lengths[t][:alphaSize])
+	}
+
+	for i, s := range symbols {
+		t := selectors[i/groupSize]
+		bw.WriteBits(uint(lengths[t][s]), codes[t][s])
+	}
+}
+
+// Close closes the Writer by flushing any unwritten data to the
+// underlying io.Writer and writing the end of the stream. It does not
+// close the underlying io.Writer.
+func (z *Writer) Close() error {
+	if z.err != nil {
+		return z.err
+	}
+	if z.closed {
+		return nil
+	}
+	z.closed = true
+	z.endRun()
+	z.writeBlock()
+	if z.err != nil {
+		return z.err
+	}
+	z.writeHeader()
+	z.bw.WriteBits64(48, bzip2FinalMagic)
+	z.bw.WriteBits(32, z.fileCRC)
+	z.bw.Pad()
+	_, z.err = z.w.Write(z.bw.Bytes())
+	return z.err
+}
@@ -0,0 +1,191 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bzip2
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+)
+
+func compressLevel(t testing.TB, data []byte, level int) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriterLevel(&buf, level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Write in pieces, to cross block boundaries within a Write.
+	for p := data; len(p) > 0; {
+		n := 70000
+		if n > len(p) {
+			n = len(p)
+		}
+		if _, err := w.Write(p[:n]); err != nil {
+			t.Fatal(err)
+		}
+		p = p[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decompress(t testing.TB, compressed []byte) []byte {
+	b, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestWriter(t *testing.T) {
+	sawtooth := make([]byte, 1<<20)
+	for i := range sawtooth {
+		sawtooth[i] = byte(i)
+	}
+	random := make([]byte, 300<<10)
+	rand.New(rand.NewSource(1)).Read(random)
+	runs := make([]byte, 0, 300<<10)
+	for i := 0; len(runs) < cap(runs)-1000; i++ {
+		runs = append(runs, bytes.Repeat([]byte{byte(i % 3)}, i%600)...)
+	}
+	inputs := []struct {
+		desc string
+		data []byte
+	}{
+		{"empty", nil},
+		{"hello world", []byte("hello world\n")},
+		{"one byte", []byte("x")},
+		{"1MiB zeros", make([]byte, 1<<20)},
+		{"1MiB sawtooth", sawtooth},
+		{"random", random},
+		{"runs", runs},
+		{"pass-random1", mustLoadFile("testdata/pass-random1.bin")},
+		{"pass-random2", mustLoadFile("testdata/pass-random2.bin")},
+		{"digits", decompress(t, digits)},
+		{"newton", decompress(t, newton)},
+	}
+	for _, in := range inputs {
+		for _, level := range []int{BestSpeed, 4, DefaultCompression} {
+			compressed := compressLevel(t, in.data, level)
+			got, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+			if err != nil {
+				t.Errorf("%s, level %d: %v", in.desc, level, err)
+				continue
+			}
+			if !bytes.Equal(got, in.data) {
+				t.Errorf("%s, level %d: got %s, want %s", in.desc, level, trim(got), trim(in.data))
+			}
+		}
+	}
+}
+
+func TestWriterEmpty(t *testing.T) {
+	// As written by the reference implementation.
+	want := mustDecodeHex("425a683917724538509000000000")
+	if got := compressLevel(t, nil, DefaultCompression); !bytes.Equal(got, want) {
+		t.Errorf("compressing nothing gave %x; want %x", got, want)
+	}
+}
+
+func TestWriterRatio(t *testing.T) {
+	// The output should be about as small as the reference
+	// implementation's, which compressed the files in testdata.
+	for _, compressed := range [][]byte{digits, newton} {
+		data := decompress(t, compressed)
+		got := compressLevel(t, data, BestCompression)
+		if len(got) > len(compressed)*21/20 {
+			t.Errorf("compressed %d bytes to %d; the reference implementation gives %d", len(data), len(got), len(compressed))
+		}
+	}
+}
+
+func TestWriterReset(t *testing.T) {
+	data := decompress(t, digits)[:10000]
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.Write(data)
+	w.Close()
+	w.Reset(&buf2)
+	w.Write(data)
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Error("output after Reset differs")
+	}
+}
+
+func TestWriterClosed(t *testing.T) {
+	w := NewWriter(ioutil.Discard)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+	if _, err := w.Write([]byte("x")); err == nil {
+		t.Error("Write after Close succeeded")
+	}
+}
+
+func TestNewWriterLevel(t *testing.T) {
+	for _, level := range []int{-2, 0, 10} {
+		if _, err := NewWriterLevel(ioutil.Discard, level); err == nil {
+			t.Errorf("NewWriterLevel accepted level %d", level)
+		}
+	}
+}
+
+func TestBlockSort(t *testing.T) {
+	var bs blockSorter
+	out, origPtr := bs.transform([]byte("banana"), nil)
+	if string(out) != "nnbaaa" || origPtr != 3 {
+		t.Errorf("transform(%q) = %q, %d; want %q, %d", "banana", out, origPtr, "nnbaaa", 3)
+	}
+
+	// Periodic blocks have equal rotations.
+	for _, in := range []string{"x", "abab", "aaaaaaaa", "abcabcabcab", "mississippi"} {
+		out, origPtr := bs.transform([]byte(in), nil)
+		tt := make([]uint32, len(out))
+		var c [256]uint
+		for i, b := range out {
+			tt[i] = uint32(b)
+			c[b]++
+		}
+		tPos := inverseBWT(tt, uint(origPtr), c[:])
+		got := make([]byte, len(in))
+		for i := range got {
+			tPos = tt[tPos]
+			got[i] = byte(tPos)
+			tPos >>= 8
+		}
+		if string(got) != in {
+			t.Errorf("transform(%q) = %q, %d, which inverts to %q", in, out, origPtr, got)
+		}
+	}
+}
+
+func benchmarkEncode(b *testing.B, compressed []byte) {
+	data, err := ioutil.ReadAll(NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	w := NewWriter(ioutil.Discard)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		w.Reset(ioutil.Discard)
+		io.Copy(w, bytes.NewReader(data))
+		w.Close()
+	}
+}
+
+func BenchmarkEncodeDigits(b *testing.B) { benchmarkEncode(b, digits) }
+func BenchmarkEncodeNewton(b *testing.B) { benchmarkEncode(b, newton) }
+func BenchmarkEncodeRand(b *testing.B)   { benchmarkEncode(b, random) }
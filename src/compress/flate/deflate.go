@@ -743,3 +743,19 @@ func (w *Writer) Reset(dst io.Writer) {
 		w.d.reset(dst)
 	}
 }
+
+// ResetDict discards the writer's state and makes it equivalent to
+// the result of NewWriterDict called with dst, w's level and dict.
+// It lets a Writer be reused with a different dictionary, as when
+// compressing consecutive blocks of a stream independently, each
+// primed with the data before it.
+func (w *Writer) ResetDict(dst io.Writer, dict []byte) {
+	dw, ok := w.d.w.writer.(*dictWriter)
+	if !ok {
+		dw = new(dictWriter)
+	}
+	dw.w = dst
+	w.d.reset(dw)
+	w.d.fillWindow(dict)
+	w.dict = append(w.dict[:0], dict...)
+}
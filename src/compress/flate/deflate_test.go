@@ -446,6 +446,49 @@ func TestWriterDict(t *testing.T) {
 	}
 }
 
+func TestWriterResetDict(t *testing.T) {
+	const (
+		dict = "hello world"
+		text = "hello again world"
+	)
+	for _, level := range []int{HuffmanOnly, NoCompression, BestSpeed, 5, BestCompression} {
+		var want bytes.Buffer
+		w, _ := NewWriterDict(&want, level, []byte(dict))
+		w.Write([]byte(text))
+		w.Close()
+
+		// A Writer made without a dictionary, and one made with
+		// another, produce the same output after ResetDict.
+		for _, newWriter := range []func(io.Writer) (*Writer, error){
+			func(w io.Writer) (*Writer, error) { return NewWriter(w, level) },
+			func(w io.Writer) (*Writer, error) { return NewWriterDict(w, level, []byte("other")) },
+		} {
+			w, err := newWriter(ioutil.Discard)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w.Write([]byte("some text to forget"))
+			w.Close()
+			var got bytes.Buffer
+			w.ResetDict(&got, []byte(dict))
+			w.Write([]byte(text))
+			w.Close()
+			if !bytes.Equal(got.Bytes(), want.Bytes()) {
+				t.Errorf("level %d: writer wrote %q want %q", level, got.Bytes(), want.Bytes())
+			}
+
+			// Reset keeps the new dictionary.
+			got.Reset()
+			w.Reset(&got)
+			w.Write([]byte(text))
+			w.Close()
+			if !bytes.Equal(got.Bytes(), want.Bytes()) {
+				t.Errorf("level %d: after Reset, writer wrote %q want %q", level, got.Bytes(), want.Bytes())
+			}
+		}
+	}
+}
+
 // See https://golang.org/issue/2508
 func TestRegression2508(t *testing.T) {
 	if testing.Short() {
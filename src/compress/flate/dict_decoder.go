@@ -63,6 +63,19 @@ func (dd *dictDecoder) histSize() int {
 	return dd.wrPos
 }
 
+// history returns a copy of the historical data in the dictionary,
+// oldest first, or nil if there is none.
+func (dd *dictDecoder) history() []byte {
+	if dd.histSize() == 0 {
+		return nil
+	}
+	h := make([]byte, 0, dd.histSize())
+	if dd.full {
+		h = append(h, dd.hist[dd.wrPos:]...)
+	}
+	return append(h, dd.hist[:dd.wrPos]...)
+}
+
 // availRead reports the number of bytes that can be flushed by readFlush.
 func (dd *dictDecoder) availRead() int {
 	return dd.wrPos - dd.rdPos
@@ -0,0 +1,363 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flate
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+)
+
+// defaultSpan is the distance between access points used for a span of
+// zero or less.
+const defaultSpan = 1 << 20
+
+const indexMagic = "flateix\x01"
+
+var (
+	errIndexFormat   = errors.New("flate: invalid index")
+	errIndexMismatch = errors.New("flate: index does not match compressed data")
+)
+
+// An Index records access points in compressed data: the starts of
+// DEFLATE blocks, with the decompressed data before them that the blocks
+// may refer to. Decompression can begin at any access point, so with an
+// Index the data can be read at any offset without decompressing it from
+// the start. Building an Index takes one pass over the data; the Index
+// can then be saved with MarshalBinary.
+//
+// An Index may cover several DEFLATE streams, one after the other, such
+// as the members of a gzip file. Each stream has an access point at its
+// start, and when a stream ends, decompression continues at the next
+// stream's first access point.
+type Index struct {
+	// Size is the size of the decompressed data.
+	Size int64
+
+	// Points holds the access points in increasing order of Out.
+	Points []IndexPoint
+}
+
+// An IndexPoint is an access point in an Index.
+type IndexPoint struct {
+	Out int64 // offset in the decompressed data
+	In  int64 // offset in the compressed data of the byte with the block's first bit
+	Bit uint8 // position of that bit in the byte, from the least significant bit
+
+	// Window holds up to 32 KB of the decompressed data before Out,
+	// which the block may refer to. It is empty at the start of a stream.
+	Window []byte
+}
+
+// NewIndex reads the DEFLATE stream from r and returns an Index of it,
+// with access points about span bytes apart in the decompressed data.
+// A span of zero or less selects 1 MB. Larger spans make a smaller Index,
+// but reads at an offset may have to decompress up to span bytes first.
+// If r does not also implement io.ByteReader, NewIndex may read more data
+// than necessary from r.
+func NewIndex(r io.Reader, span int64) (*Index, error) {
+	x := new(Index)
+	if _, err := x.AddStream(makeReader(r), 0, span, nil); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// AddStream reads a DEFLATE stream from r and adds access points for it
+// to x, placing the first at the start of the stream and the others at
+// the first block boundaries at least span bytes apart. A span of zero or
+// less selects 1 MB. The stream starts at offset in of the compressed
+// data, and its decompressed data follows the x.Size bytes x already
+// covers. If w is not nil, the decompressed data is written to it.
+//
+// AddStream reads no more from r than the stream, and returns its length.
+// On success, x.Size is increased by the size of the decompressed data;
+// on error, x is left unchanged.
+func (x *Index) AddStream(r Reader, in, span int64, w io.Writer) (int64, error) {
+	if span <= 0 {
+		span = defaultSpan
+	}
+	fixedHuffmanDecoderInit()
+
+	b := &indexBuilder{x: x, in: in, span: span}
+	f := &decompressor{
+		r:        r,
+		bits:     new([maxNumLit + maxNumDist]int),
+		codebits: new([numCodes]int),
+		step:     (*decompressor).nextBlock,
+		index:    b,
+	}
+	f.dict.init(maxMatchOffset, nil)
+
+	npoints := len(x.Points)
+	buf := make([]byte, 32<<10)
+	for {
+		n, err := f.Read(buf)
+		b.out += int64(n)
+		if w != nil && n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				err = werr
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			x.Points = x.Points[:npoints]
+			return f.roffset, err
+		}
+	}
+	x.Size += b.out
+	return f.roffset, nil
+}
+
+// indexBuilder records access points while a decompressor reads a stream.
+type indexBuilder struct {
+	x    *Index
+	in   int64 // offset of the stream in the compressed data
+	span int64
+	out  int64 // amount of data the decompressor has returned
+	last int64 // offset in the stream of the last point, if any
+	any  bool  // whether a point has been recorded
+}
+
+// blockStart is called by f at the start of each block.
+func (b *indexBuilder) blockStart(f *decompressor) {
+	out := b.out + int64(f.dict.availRead())
+	if b.any && out-b.last < b.span {
+		return
+	}
+	bit := (b.in+f.roffset)*8 - int64(f.nb)
+	b.x.Points = append(b.x.Points, IndexPoint{
+		Out:    b.x.Size + out,
+		In:     bit / 8,
+		Bit:    uint8(bit % 8),
+		Window: f.dict.history(),
+	})
+	b.last, b.any = out, true
+}
+
+// MarshalBinary encodes x: a magic string, then as uvarints the size and
+// number of points, then for each point the increases of Out and In from
+// the previous point as uvarints, Bit as a byte, and the length of Window
+// as a uvarint followed by Window itself.
+func (x *Index) MarshalBinary() ([]byte, error) {
+	n := len(indexMagic) + 2*binary.MaxVarintLen64
+	for _, p := range x.Points {
+		n += 3*binary.MaxVarintLen64 + 1 + len(p.Window)
+	}
+	b := make([]byte, n)
+	i := copy(b, indexMagic)
+	i += binary.PutUvarint(b[i:], uint64(x.Size))
+	i += binary.PutUvarint(b[i:], uint64(len(x.Points)))
+	var prev IndexPoint
+	for _, p := range x.Points {
+		if p.Out < prev.Out || p.In < prev.In || p.Bit > 7 || len(p.Window) > maxMatchOffset {
+			return nil, errIndexFormat
+		}
+		i += binary.PutUvarint(b[i:], uint64(p.Out-prev.Out))
+		i += binary.PutUvarint(b[i:], uint64(p.In-prev.In))
+		b[i] = p.Bit
+		i++
+		i += binary.PutUvarint(b[i:], uint64(len(p.Window)))
+		i += copy(b[i:], p.Window)
+		prev = p
+	}
+	return b[:i], nil
+}
+
+// UnmarshalBinary decodes an Index encoded by MarshalBinary into x.
+func (x *Index) UnmarshalBinary(data []byte) error {
+	if len(data) < len(indexMagic) || string(data[:len(indexMagic)]) != indexMagic {
+		return errIndexFormat
+	}
+	data = data[len(indexMagic):]
+	uvarint := func() int64 {
+		v, n := binary.Uvarint(data)
+		if n <= 0 || v > 1<<62 {
+			data = nil
+			return -1
+		}
+		data = data[n:]
+		return int64(v)
+	}
+	size := uvarint()
+	count := uvarint()
+	// Each point takes at least 4 bytes.
+	if size < 0 || count < 0 || count > int64(len(data))/4 {
+		return errIndexFormat
+	}
+	points := make([]IndexPoint, count)
+	var prev IndexPoint
+	for i := range points {
+		p := &points[i]
+		dOut, dIn := uvarint(), uvarint()
+		if dOut < 0 || dIn < 0 || len(data) == 0 {
+			return errIndexFormat
+		}
+		p.Out, p.In, p.Bit = prev.Out+dOut, prev.In+dIn, data[0]
+		data = data[1:]
+		n := uvarint()
+		if p.Out > size || p.In < prev.In || p.Bit > 7 || n < 0 || n > maxMatchOffset || n > int64(len(data)) {
+			return errIndexFormat
+		}
+		p.Window = append([]byte(nil), data[:n]...)
+		data = data[n:]
+		prev = *p
+	}
+	if len(data) != 0 || (count > 0 && points[0].Out != 0) || (count == 0 && size > 0) {
+		return errIndexFormat
+	}
+	x.Size, x.Points = size, points
+	return nil
+}
+
+// NewReaderAt returns a SectionReader of the data decompressed from r,
+// which holds the compressed data indexed by x. A read at an offset
+// starts decompressing at the last access point before it, unless it
+// continues where the previous read stopped. The SectionReader is safe
+// for concurrent use, but concurrent reads are serialized.
+//
+// The data read is not verified against any checksum in the compressed
+// data.
+func NewReaderAt(r io.ReaderAt, x *Index) *io.SectionReader {
+	fixedHuffmanDecoderInit()
+	return io.NewSectionReader(&indexReader{r: r, x: x}, 0, x.Size)
+}
+
+// indexReader is an io.ReaderAt of the data indexed by x.
+type indexReader struct {
+	r io.ReaderAt
+	x *Index
+
+	mu   sync.Mutex
+	f    *decompressor
+	ok   bool // whether f can go on reading
+	br   *bufio.Reader
+	pos  int64 // offset in the decompressed data of f's next byte
+	skip []byte
+}
+
+func (ir *indexReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("flate: negative offset")
+	}
+	if off >= ir.x.Size {
+		return 0, io.EOF
+	}
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	points := ir.x.Points
+	i := sort.Search(len(points), func(i int) bool { return points[i].Out > off }) - 1
+	if i < 0 {
+		return 0, errIndexMismatch
+	}
+	if !ir.ok || off < ir.pos || points[i].Out > ir.pos {
+		if err := ir.start(&points[i]); err != nil {
+			return 0, err
+		}
+	}
+	for ir.pos < off {
+		if ir.skip == nil {
+			ir.skip = make([]byte, 32<<10)
+		}
+		b := ir.skip
+		if int64(len(b)) > off-ir.pos {
+			b = b[:off-ir.pos]
+		}
+		if _, err := ir.read(b); err != nil {
+			return 0, err
+		}
+	}
+
+	n := 0
+	for n < len(p) && ir.pos < ir.x.Size {
+		m, err := ir.read(p[n:])
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// start sets up ir.f to decompress from the access point p.
+func (ir *indexReader) start(p *IndexPoint) error {
+	if ir.f == nil {
+		ir.f = &decompressor{
+			bits:     new([maxNumLit + maxNumDist]int),
+			codebits: new([numCodes]int),
+		}
+	}
+	f := ir.f
+	ir.ok = false
+	const maxInt64 = 1<<63 - 1
+	sr := io.NewSectionReader(ir.r, p.In, maxInt64-p.In)
+	if ir.br == nil {
+		ir.br = bufio.NewReader(sr)
+	} else {
+		ir.br.Reset(sr)
+	}
+	*f = decompressor{
+		r:        ir.br,
+		roffset:  p.In,
+		bits:     f.bits,
+		codebits: f.codebits,
+		dict:     f.dict,
+		step:     (*decompressor).nextBlock,
+	}
+	f.dict.init(maxMatchOffset, p.Window)
+	if p.Bit > 0 {
+		if err := f.moreBits(); err != nil {
+			return err
+		}
+		f.b >>= p.Bit
+		f.nb -= uint(p.Bit)
+	}
+	ir.ok, ir.pos = true, p.Out
+	return nil
+}
+
+// read reads from ir.f, going on to the next stream when one ends.
+func (ir *indexReader) read(p []byte) (int, error) {
+	for {
+		n, err := ir.f.Read(p)
+		ir.pos += int64(n)
+		if err == io.EOF {
+			if n > 0 || ir.pos >= ir.x.Size {
+				return n, nil
+			}
+			err = ir.nextStream()
+		}
+		if err != nil {
+			ir.ok = false
+			return n, err
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// nextStream sets up ir.f to decompress the stream after the one it
+// has finished, which starts at the first access point at the same
+// offset in the decompressed data and later in the compressed data.
+func (ir *indexReader) nextStream() error {
+	points := ir.x.Points
+	i := sort.Search(len(points), func(i int) bool { return points[i].Out >= ir.pos })
+	for ; i < len(points) && points[i].Out == ir.pos; i++ {
+		if points[i].In >= ir.f.roffset {
+			return ir.start(&points[i])
+		}
+	}
+	return errIndexMismatch
+}
@@ -0,0 +1,215 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flate
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// indexTestData returns text followed by random data, so that the
+// compressed data has both Huffman coded and stored blocks.
+func indexTestData(t *testing.T) []byte {
+	data, err := ioutil.ReadFile("../../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	random := make([]byte, 100<<10)
+	rand.New(rand.NewSource(1)).Read(random)
+	return append(data, random...)
+}
+
+func compressForIndex(t *testing.T, data []byte, level int) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(data)
+	w.Close()
+	return buf.Bytes()
+}
+
+// checkReaderAt checks reads from r at various offsets against data.
+func checkReaderAt(t *testing.T, r *io.SectionReader, data []byte) {
+	t.Helper()
+	if r.Size() != int64(len(data)) {
+		t.Fatalf("Size() = %d, want %d", r.Size(), len(data))
+	}
+	got, err := ioutil.ReadAll(r)
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("reading everything: got %d bytes, %v; want %d bytes", len(got), err, len(data))
+	}
+
+	rnd := rand.New(rand.NewSource(2))
+	buf := make([]byte, 100<<10)
+	for i := 0; i < 50; i++ {
+		off := rnd.Int63n(int64(len(data)))
+		n := rnd.Intn(len(buf))
+		m, err := r.ReadAt(buf[:n], off)
+		want := data[off:]
+		if len(want) > n {
+			want = want[:n]
+		}
+		if !bytes.Equal(buf[:m], want) {
+			t.Fatalf("ReadAt(%d bytes, %d) = %d, %v: data differs", n, off, m, err)
+		}
+		if m < n && err != io.EOF || m == n && err != nil {
+			t.Fatalf("ReadAt(%d bytes, %d) = %d, %v", n, off, m, err)
+		}
+	}
+
+	// Seek back and forth, reading a little each time.
+	for _, off := range []int64{int64(len(data)) - 10, 0, 100000, 100010, 50000, int64(len(data)) / 2} {
+		if _, err := r.Seek(off, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		m, err := io.ReadFull(r, buf[:10])
+		if err != nil || !bytes.Equal(buf[:m], data[off:off+10]) {
+			t.Fatalf("read at %d after Seek: got %q, %v; want %q", off, buf[:m], err, data[off:off+10])
+		}
+	}
+}
+
+func TestIndex(t *testing.T) {
+	data := indexTestData(t)
+	for _, level := range []int{NoCompression, BestSpeed, DefaultCompression, HuffmanOnly} {
+		compressed := compressForIndex(t, data, level)
+		x, err := NewIndex(bytes.NewReader(compressed), 64<<10)
+		if err != nil {
+			t.Fatalf("level %d: %v", level, err)
+		}
+		if x.Size != int64(len(data)) {
+			t.Errorf("level %d: Size = %d, want %d", level, x.Size, len(data))
+		}
+		if len(x.Points) < 2 {
+			t.Errorf("level %d: only %d access points", level, len(x.Points))
+		}
+		for i, p := range x.Points[1:] {
+			if p.Out-x.Points[i].Out < 64<<10 {
+				t.Errorf("level %d: access points %d and %d are too close", level, i, i+1)
+			}
+		}
+		checkReaderAt(t, NewReaderAt(bytes.NewReader(compressed), x), data)
+	}
+}
+
+func TestIndexBitOffsets(t *testing.T) {
+	data := indexTestData(t)
+	compressed := compressForIndex(t, data, DefaultCompression)
+	x, err := NewIndex(bytes.NewReader(compressed), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Huffman coded blocks need not start at a byte boundary.
+	unaligned := 0
+	for _, p := range x.Points {
+		if p.Bit != 0 {
+			unaligned++
+		}
+	}
+	if unaligned == 0 {
+		t.Errorf("all %d access points are at bit 0", len(x.Points))
+	}
+	checkReaderAt(t, NewReaderAt(bytes.NewReader(compressed), x), data)
+}
+
+func TestIndexStreams(t *testing.T) {
+	data := indexTestData(t)
+	parts := [][]byte{data[:100000], nil, data[100000:300000], nil, data[300000:]}
+
+	// Separate the streams by some junk, as with gzip headers and
+	// trailers, and end some with an empty final block.
+	var compressed bytes.Buffer
+	compressed.WriteString("junk")
+	x := new(Index)
+	for i, part := range parts {
+		start := int64(compressed.Len())
+		w, _ := NewWriter(&compressed, DefaultCompression)
+		w.Write(part)
+		if i%2 == 0 {
+			w.Flush()
+		}
+		w.Close()
+		stream := compressed.Bytes()[start:]
+		var out bytes.Buffer
+		n, err := x.AddStream(bytes.NewReader(stream), start, 32<<10, &out)
+		if err != nil {
+			t.Fatalf("stream %d: %v", i, err)
+		}
+		if n != int64(len(stream)) {
+			t.Errorf("stream %d: AddStream returned %d, want %d", i, n, len(stream))
+		}
+		if !bytes.Equal(out.Bytes(), part) {
+			t.Errorf("stream %d: AddStream wrote different data", i)
+		}
+		compressed.WriteString("junk")
+	}
+	checkReaderAt(t, NewReaderAt(bytes.NewReader(compressed.Bytes()), x), data)
+}
+
+func TestIndexCorrupt(t *testing.T) {
+	data := indexTestData(t)
+	compressed := compressForIndex(t, data, DefaultCompression)
+	x, err := NewIndex(bytes.NewReader(compressed), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := *x
+	if _, err := x.AddStream(bytes.NewReader(compressed[:len(compressed)/2]), 0, 0, nil); err != io.ErrUnexpectedEOF {
+		t.Errorf("AddStream of truncated stream: got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if !reflect.DeepEqual(*x, saved) {
+		t.Error("failed AddStream changed the Index")
+	}
+
+	// Reading data that does not match the index fails.
+	r := NewReaderAt(bytes.NewReader(compressed[:len(compressed)/2]), x)
+	if _, err := io.Copy(ioutil.Discard, r); err == nil {
+		t.Error("reading truncated data succeeded")
+	}
+}
+
+func TestIndexMarshal(t *testing.T) {
+	data := indexTestData(t)
+	compressed := compressForIndex(t, data, DefaultCompression)
+	x, err := NewIndex(bytes.NewReader(compressed), 100<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var y Index
+	if err := y.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(x, &y) {
+		t.Error("Index differs after MarshalBinary and UnmarshalBinary")
+	}
+
+	for _, n := range []int{0, 5, len(b) / 2, len(b) - 1} {
+		if err := y.UnmarshalBinary(b[:n]); err == nil {
+			t.Errorf("UnmarshalBinary of %d of %d bytes succeeded", n, len(b))
+		}
+	}
+	if err := y.UnmarshalBinary(append(b, 0)); err == nil {
+		t.Error("UnmarshalBinary with trailing data succeeded")
+	}
+
+	empty, err := NewIndex(bytes.NewReader(compressForIndex(t, nil, DefaultCompression)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ = empty.MarshalBinary()
+	if err := y.UnmarshalBinary(b); err != nil || !reflect.DeepEqual(empty, &y) {
+		t.Errorf("round trip of empty Index: got %+v, %v; want %+v", y, err, empty)
+	}
+}
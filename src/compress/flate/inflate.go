@@ -296,9 +296,15 @@ type decompressor struct {
 	hl, hd    *huffmanDecoder
 	copyLen   int
 	copyDist  int
+
+	// If not nil, index records access points at block starts.
+	index *indexBuilder
 }
 
 func (f *decompressor) nextBlock() {
+	if f.index != nil {
+		f.index.blockStart(f)
+	}
 	for f.nb < 1+2 {
 		if f.err = f.moreBits(); f.err != nil {
 			return
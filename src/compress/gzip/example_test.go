@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"time"
 )
@@ -126,3 +128,35 @@ func ExampleReader_Multistream() {
 	//
 	// Hello Gophers - 2
 }
+
+func ExampleNewReaderAt() {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	for i := 0; i < 10000; i++ {
+		fmt.Fprintf(zw, "line %d\n", i)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+	gz := bytes.NewReader(buf.Bytes())
+
+	// Index the file once; the index can be saved with its
+	// MarshalBinary method.
+	x, err := gzip.NewIndex(gz, 16<<10)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Serve a range of the decompressed data.
+	req := httptest.NewRequest("GET", "/lines.txt", nil)
+	req.Header.Set("Range", "bytes=60000-60019")
+	w := httptest.NewRecorder()
+	http.ServeContent(w, req, "lines.txt", time.Time{}, gzip.NewReaderAt(gz, x))
+
+	fmt.Println(w.Code, w.Header().Get("Content-Range"))
+	fmt.Printf("%q\n", w.Body.String())
+
+	// Output:
+	// 206 bytes 60000-60019/98890
+	// "line 6111\nline 6112\n"
+}
@@ -63,7 +63,8 @@ type Header struct {
 // In general, a gzip file can be a concatenation of gzip files,
 // each with its own header. Reads from the Reader
 // return the concatenation of the uncompressed data of each.
-// Only the first header is recorded in the Reader fields.
+// Only the first header is recorded in the Reader fields; the header of
+// the member being read is available from MemberHeader.
 //
 // Gzip files store a length and checksum of the uncompressed data.
 // The Reader will return an ErrChecksum when Read
@@ -74,6 +75,8 @@ type Header struct {
 type Reader struct {
 	Header       // valid after NewReader or Reader.Reset
 	r            flate.Reader
+	dict         []byte
+	member       Header
 	decompressor io.ReadCloser
 	digest       uint32 // CRC-32, IEEE polynomial (section 8)
 	size         uint32 // Uncompressed size (section 2.3.1)
@@ -97,11 +100,23 @@ func NewReader(r io.Reader) (*Reader, error) {
 	return z, nil
 }
 
+// NewReaderDict is like NewReader but decompresses data written by a
+// Writer made by NewWriterDict with the same dictionary.
+func NewReaderDict(r io.Reader, dict []byte) (*Reader, error) {
+	z := &Reader{dict: dict}
+	if err := z.Reset(r); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
 // Reset discards the Reader z's state and makes it equivalent to the
-// result of its original state from NewReader, but reading from r instead.
-// This permits reusing a Reader rather than allocating a new one.
+// result of its original state from NewReader or NewReaderDict, but
+// reading from r instead. This permits reusing a Reader rather than
+// allocating a new one.
 func (z *Reader) Reset(r io.Reader) error {
 	*z = Reader{
+		dict:         z.dict,
 		decompressor: z.decompressor,
 		multistream:  true,
 	}
@@ -111,9 +126,18 @@ func (z *Reader) Reset(r io.Reader) error {
 		z.r = bufio.NewReader(r)
 	}
 	z.Header, z.err = z.readHeader()
+	z.member = z.Header
 	return z.err
 }
 
+// MemberHeader returns the header of the member being read. It differs
+// from z.Header once a multistream Reader has moved past the first
+// member. The Read that returns the last data of a member moves on to
+// the header of the next.
+func (z *Reader) MemberHeader() Header {
+	return z.member
+}
+
 // Multistream controls whether the reader supports multistream files.
 //
 // If enabled (the default), the Reader expects the input to be a sequence
@@ -235,9 +259,9 @@ func (z *Reader) readHeader() (hdr Header, err error) {
 
 	z.digest = 0
 	if z.decompressor == nil {
-		z.decompressor = flate.NewReader(z.r)
+		z.decompressor = flate.NewReaderDict(z.r, z.dict)
 	} else {
-		z.decompressor.(flate.Resetter).Reset(z.r, nil)
+		z.decompressor.(flate.Resetter).Reset(z.r, z.dict)
 	}
 	return hdr, nil
 }
@@ -275,9 +299,11 @@ func (z *Reader) Read(p []byte) (n int, err error) {
 	}
 	z.err = nil // Remove io.EOF
 
-	if _, z.err = z.readHeader(); z.err != nil {
+	var hdr Header
+	if hdr, z.err = z.readHeader(); z.err != nil {
 		return n, z.err
 	}
+	z.member = hdr
 
 	// Read from next file, if necessary.
 	if n > 0 {
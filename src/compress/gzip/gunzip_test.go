@@ -510,3 +510,59 @@ func TestTruncatedStreams(t *testing.T) {
 		}
 	}
 }
+
+func TestIndex(t *testing.T) {
+	data, err := ioutil.ReadFile("../../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Write several members, with header fields, one of them empty.
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Name = "first"
+	w.Comment = "several members"
+	w.Extra = []byte("extra")
+	w.Write(data[:200000])
+	w.NextMember()
+	w.NextMember()
+	w.Write(data[200000:])
+	w.Close()
+	gz := buf.Bytes()
+
+	x, err := NewIndex(bytes.NewReader(gz), 64<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.Size != int64(len(data)) {
+		t.Fatalf("Size = %d, want %d", x.Size, len(data))
+	}
+	r := NewReaderAt(bytes.NewReader(gz), x)
+	got, err := ioutil.ReadAll(r)
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("ReadAll = %d bytes, %v; want %d bytes", len(got), err, len(data))
+	}
+	for _, off := range []int{0, 150000, 199990, 200000, 500000, len(data) - 10} {
+		p := make([]byte, 30000)
+		n, err := r.ReadAt(p, int64(off))
+		want := data[off:]
+		if len(want) > len(p) {
+			want = want[:len(p)]
+		}
+		if !bytes.Equal(p[:n], want) || n < len(p) && err != io.EOF {
+			t.Errorf("ReadAt at %d = %d, %v; data differs", off, n, err)
+		}
+	}
+
+	if _, err := NewIndex(bytes.NewReader(nil), 0); err != io.EOF {
+		t.Errorf("NewIndex of empty file: got %v, want io.EOF", err)
+	}
+	corrupt := append([]byte(nil), gz...)
+	corrupt[len(corrupt)-5] ^= 1
+	if _, err := NewIndex(bytes.NewReader(corrupt), 0); err != ErrChecksum {
+		t.Errorf("NewIndex with bad size: got %v, want %v", err, ErrChecksum)
+	}
+	if _, err := NewIndex(bytes.NewReader(gz[:len(gz)-3]), 0); err != io.ErrUnexpectedEOF {
+		t.Errorf("NewIndex of truncated file: got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
@@ -5,11 +5,13 @@
 package gzip
 
 import (
+	"bytes"
 	"compress/flate"
 	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
+	"sync"
 	"time"
 )
 
@@ -23,12 +25,38 @@ const (
 	HuffmanOnly        = flate.HuffmanOnly
 )
 
+const (
+	// defaultBlockSize is the block size used for parallel compression
+	// when Writer.BlockSize is not set.
+	defaultBlockSize = 1 << 20
+
+	// maxDictSize is the size of the DEFLATE window, the most of a
+	// dictionary that can be referred to.
+	maxDictSize = 32 << 10
+)
+
 // A Writer is an io.WriteCloser.
 // Writes to a Writer are compressed and written to w.
+//
+// A Writer normally compresses on the calling goroutine. Setting
+// Concurrency makes it split the data into blocks that are compressed
+// in parallel instead. Each block is primed with the end of the block
+// before it and, but for the last, ends with a sync flush, so that the
+// blocks still form a single DEFLATE stream in a single gzip member.
 type Writer struct {
-	Header      // written at first call to Write, Flush, or Close
+	Header // written at first call to Write, Flush, or Close
+
+	// Concurrency, if greater than one, is the number of blocks the
+	// Writer compresses in parallel.
+	Concurrency int
+
+	// BlockSize is the size of the blocks compressed in parallel.
+	// If zero, blocks are 1 MB.
+	BlockSize int
+
 	w           io.Writer
 	level       int
+	dict        []byte
 	wroteHeader bool
 	compressor  *flate.Writer
 	digest      uint32 // CRC-32, IEEE polynomial (section 8)
@@ -36,6 +64,11 @@ type Writer struct {
 	closed      bool
 	buf         [10]byte
 	err         error
+
+	// Parallel compression state.
+	block []byte        // data not yet compressed
+	prev  []byte        // the end of the data before the block
+	queue []chan []byte // blocks being compressed, in order
 }
 
 // NewWriter returns a new Writer.
@@ -44,8 +77,8 @@ type Writer struct {
 // It is the caller's responsibility to call Close on the Writer when done.
 // Writes may be buffered and not flushed until Close.
 //
-// Callers that wish to set the fields in Writer.Header must do so before
-// the first call to Write, Flush, or Close.
+// Callers that wish to set the fields in Writer.Header, Concurrency or
+// BlockSize must do so before the first call to Write, Flush, or Close.
 func NewWriter(w io.Writer) *Writer {
 	z, _ := NewWriterLevel(w, DefaultCompression)
 	return z
@@ -66,6 +99,23 @@ func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
 	return z, nil
 }
 
+// NewWriterDict is like NewWriterLevel but initializes the compressor
+// with a preset dictionary, data that the compressed data is expected
+// to share. The gzip format does not record the use of a dictionary,
+// so the output can only be read by a Reader made by NewReaderDict
+// with the same dictionary.
+func NewWriterDict(w io.Writer, level int, dict []byte) (*Writer, error) {
+	z, err := NewWriterLevel(w, level)
+	if err != nil {
+		return nil, err
+	}
+	if len(dict) > maxDictSize {
+		dict = dict[len(dict)-maxDictSize:]
+	}
+	z.dict = append([]byte(nil), dict...)
+	return z, nil
+}
+
 func (z *Writer) init(w io.Writer, level int) {
 	compressor := z.compressor
 	if compressor != nil {
@@ -75,16 +125,19 @@ func (z *Writer) init(w io.Writer, level int) {
 		Header: Header{
 			OS: 255, // unknown
 		},
-		w:          w,
-		level:      level,
-		compressor: compressor,
+		Concurrency: z.Concurrency,
+		BlockSize:   z.BlockSize,
+		w:           w,
+		level:       level,
+		dict:        z.dict,
+		compressor:  compressor,
 	}
 }
 
 // Reset discards the Writer z's state and makes it equivalent to the
-// result of its original state from NewWriter or NewWriterLevel, but
-// writing to w instead. This permits reusing a Writer rather than
-// allocating a new one.
+// result of its original state from NewWriter, NewWriterLevel or
+// NewWriterDict, but writing to w instead. This permits reusing a Writer
+// rather than allocating a new one. Concurrency and BlockSize are kept.
 func (z *Writer) Reset(w io.Writer) {
 	z.init(w, z.level)
 }
@@ -134,6 +187,61 @@ func (z *Writer) writeString(s string) (err error) {
 	return err
 }
 
+// writeHeader writes the GZIP header.
+func (z *Writer) writeHeader() error {
+	z.wroteHeader = true
+	z.buf = [10]byte{0: gzipID1, 1: gzipID2, 2: gzipDeflate}
+	if z.Extra != nil {
+		z.buf[3] |= 0x04
+	}
+	if z.Name != "" {
+		z.buf[3] |= 0x08
+	}
+	if z.Comment != "" {
+		z.buf[3] |= 0x10
+	}
+	if z.ModTime.After(time.Unix(0, 0)) {
+		// Section 2.3.1, the zero value for MTIME means that the
+		// modified time is not set.
+		le.PutUint32(z.buf[4:8], uint32(z.ModTime.Unix()))
+	}
+	if z.level == BestCompression {
+		z.buf[8] = 2
+	} else if z.level == BestSpeed {
+		z.buf[8] = 4
+	}
+	z.buf[9] = z.OS
+	if _, err := z.w.Write(z.buf[:10]); err != nil {
+		return err
+	}
+	if z.Extra != nil {
+		if err := z.writeBytes(z.Extra); err != nil {
+			return err
+		}
+	}
+	if z.Name != "" {
+		if err := z.writeString(z.Name); err != nil {
+			return err
+		}
+	}
+	if z.Comment != "" {
+		if err := z.writeString(z.Comment); err != nil {
+			return err
+		}
+	}
+	if z.Concurrency > 1 {
+		// The first block is primed with the dictionary.
+		z.prev = z.dict
+	} else if z.compressor == nil {
+		if z.dict != nil {
+			z.compressor, _ = flate.NewWriterDict(z.w, z.level, z.dict)
+		} else {
+			z.compressor, _ = flate.NewWriter(z.w, z.level)
+		}
+	}
+	return nil
+}
+
 // Write writes a compressed form of p to the underlying io.Writer. The
 // compressed bytes are not necessarily flushed until the Writer is closed.
 func (z *Writer) Write(p []byte) (int, error) {
@@ -143,58 +251,99 @@ func (z *Writer) Write(p []byte) (int, error) {
 	var n int
 	// Write the GZIP header lazily.
 	if !z.wroteHeader {
-		z.wroteHeader = true
-		z.buf = [10]byte{0: gzipID1, 1: gzipID2, 2: gzipDeflate}
-		if z.Extra != nil {
-			z.buf[3] |= 0x04
-		}
-		if z.Name != "" {
-			z.buf[3] |= 0x08
-		}
-		if z.Comment != "" {
-			z.buf[3] |= 0x10
-		}
-		if z.ModTime.After(time.Unix(0, 0)) {
-			// Section 2.3.1, the zero value for MTIME means that the
-			// modified time is not set.
-			le.PutUint32(z.buf[4:8], uint32(z.ModTime.Unix()))
-		}
-		if z.level == BestCompression {
-			z.buf[8] = 2
-		} else if z.level == BestSpeed {
-			z.buf[8] = 4
-		}
-		z.buf[9] = z.OS
-		_, z.err = z.w.Write(z.buf[:10])
-		if z.err != nil {
+		if z.err = z.writeHeader(); z.err != nil {
 			return 0, z.err
 		}
-		if z.Extra != nil {
-			z.err = z.writeBytes(z.Extra)
-			if z.err != nil {
-				return 0, z.err
-			}
+	}
+	z.size += uint32(len(p))
+	z.digest = crc32.Update(z.digest, crc32.IEEETable, p)
+	if z.Concurrency > 1 {
+		return z.writeParallel(p)
+	}
+	n, z.err = z.compressor.Write(p)
+	return n, z.err
+}
+
+// flateWriters holds flate.Writers for parallel compression, indexed by
+// level+2.
+var flateWriters [BestCompression + 3]sync.Pool
+
+// writeParallel adds p to the blocks to compress in parallel.
+func (z *Writer) writeParallel(p []byte) (int, error) {
+	size := z.BlockSize
+	if size <= 0 {
+		size = defaultBlockSize
+	}
+	n := len(p)
+	for len(p) > 0 {
+		if z.block == nil {
+			z.block = make([]byte, 0, size)
 		}
-		if z.Name != "" {
-			z.err = z.writeString(z.Name)
-			if z.err != nil {
-				return 0, z.err
-			}
+		c := size - len(z.block)
+		if c > len(p) {
+			c = len(p)
 		}
-		if z.Comment != "" {
-			z.err = z.writeString(z.Comment)
+		z.block = append(z.block, p[:c]...)
+		p = p[c:]
+		if len(z.block) == size {
+			z.compressBlock(false)
 			if z.err != nil {
 				return 0, z.err
 			}
 		}
-		if z.compressor == nil {
-			z.compressor, _ = flate.NewWriter(z.w, z.level)
+	}
+	return n, nil
+}
+
+// compressBlock starts compressing the pending block in parallel. All
+// blocks but the last end with a sync flush.
+func (z *Writer) compressBlock(last bool) {
+	if len(z.queue) >= z.Concurrency {
+		z.writeQueued()
+		if z.err != nil {
+			return
 		}
 	}
-	z.size += uint32(len(p))
-	z.digest = crc32.Update(z.digest, crc32.IEEETable, p)
-	n, z.err = z.compressor.Write(p)
-	return n, z.err
+	data, dict := z.block, z.prev
+	z.block = nil
+	if len(data) >= maxDictSize {
+		z.prev = data[len(data)-maxDictSize:]
+	} else {
+		// A short block leaves room for the end of the data before it.
+		prev := append(append([]byte(nil), dict...), data...)
+		if len(prev) > maxDictSize {
+			prev = prev[len(prev)-maxDictSize:]
+		}
+		z.prev = prev
+	}
+
+	c := make(chan []byte, 1)
+	z.queue = append(z.queue, c)
+	level := z.level
+	go func() {
+		var buf bytes.Buffer
+		pool := &flateWriters[level+2]
+		fw, ok := pool.Get().(*flate.Writer)
+		if !ok {
+			fw, _ = flate.NewWriter(nil, level)
+		}
+		fw.ResetDict(&buf, dict)
+		fw.Write(data)
+		if last {
+			fw.Close()
+		} else {
+			fw.Flush()
+		}
+		pool.Put(fw)
+		c <- buf.Bytes()
+	}()
+}
+
+// writeQueued writes the oldest block being compressed in parallel.
+func (z *Writer) writeQueued() {
+	out := <-z.queue[0]
+	z.queue = z.queue[1:]
+	_, z.err = z.w.Write(out)
 }
 
 // Flush flushes any pending compressed data to the underlying writer.
@@ -218,6 +367,15 @@ func (z *Writer) Flush() error {
 			return z.err
 		}
 	}
+	if z.Concurrency > 1 {
+		if len(z.block) > 0 {
+			z.compressBlock(false)
+		}
+		for len(z.queue) > 0 && z.err == nil {
+			z.writeQueued()
+		}
+		return z.err
+	}
 	z.err = z.compressor.Flush()
 	return z.err
 }
@@ -239,7 +397,14 @@ func (z *Writer) Close() error {
 			return z.err
 		}
 	}
-	z.err = z.compressor.Close()
+	if z.Concurrency > 1 {
+		z.compressBlock(true)
+		for len(z.queue) > 0 && z.err == nil {
+			z.writeQueued()
+		}
+	} else {
+		z.err = z.compressor.Close()
+	}
 	if z.err != nil {
 		return z.err
 	}
@@ -248,3 +413,23 @@ func (z *Writer) Close() error {
 	_, z.err = z.w.Write(z.buf[:8])
 	return z.err
 }
+
+// NextMember ends the current gzip member, as Close does, and starts a
+// new one in the same stream, so that the output holds a sequence of
+// members. The Header is kept; callers that wish to change its fields
+// for the new member must do so before the next call to Write, Flush,
+// or Close. Readers return the concatenated data of all members unless
+// multistream mode is disabled; see Reader.Multistream and
+// Reader.MemberHeader.
+func (z *Writer) NextMember() error {
+	if err := z.Close(); err != nil {
+		return err
+	}
+	z.wroteHeader = false
+	z.closed = false
+	z.digest, z.size = 0, 0
+	if z.compressor != nil {
+		z.compressor.Reset(z.w)
+	}
+	return nil
+}
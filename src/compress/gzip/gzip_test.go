@@ -271,3 +271,169 @@ func TestLimitedWrite(t *testing.T) {
 		}
 	}
 }
+
+func TestWriterParallel(t *testing.T) {
+	newton, err := ioutil.ReadFile("../../testdata/Isaac.Newton-Opticks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	compress := func(level, concurrency, blockSize int) []byte {
+		var buf bytes.Buffer
+		z, err := NewWriterLevel(&buf, level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		z.Concurrency = concurrency
+		z.BlockSize = blockSize
+		for p := newton; len(p) > 0; {
+			n := 30000
+			if n > len(p) {
+				n = len(p)
+			}
+			if _, err := z.Write(p[:n]); err != nil {
+				t.Fatal(err)
+			}
+			p = p[n:]
+		}
+		if err := z.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	for _, level := range []int{HuffmanOnly, NoCompression, BestSpeed, DefaultCompression, BestCompression} {
+		serial := compress(level, 1, 0)
+		for _, blockSize := range []int{0, 1000, 100000} {
+			got := compress(level, 4, blockSize)
+			// The output is a single gzip member.
+			r, err := NewReader(bytes.NewReader(got))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.Multistream(false)
+			b, err := ioutil.ReadAll(r)
+			if err != nil || !bytes.Equal(b, newton) {
+				t.Errorf("level %d, block size %d: read %d bytes, %v; want %d bytes", level, blockSize, len(b), err, len(newton))
+			}
+			// Each block refers back to the one before, so the
+			// output is about as small as the serial output.
+			if level >= DefaultCompression && blockSize != 1000 && len(got) > len(serial)*102/100 {
+				t.Errorf("level %d, block size %d: compressed to %d bytes; %d serially", level, blockSize, len(got), len(serial))
+			}
+		}
+	}
+}
+
+func TestWriterParallelFlush(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Concurrency = 2
+	w.BlockSize = 4
+	r := bufio.NewReader(&buf)
+	var zr *Reader
+	for _, s := range []string{"hello, ", "parallel ", "world"} {
+		w.Write([]byte(s))
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if zr == nil {
+			var err error
+			if zr, err = NewReader(r); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// The data written so far must be readable.
+		b := make([]byte, len(s))
+		if _, err := io.ReadFull(zr, b); err != nil || string(b) != s {
+			t.Fatalf("read %q, %v after Flush; want %q", b, err, s)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := ioutil.ReadAll(zr); len(b) != 0 || err != nil {
+		t.Errorf("ReadAll after Close = %q, %v; want nothing", b, err)
+	}
+}
+
+func TestWriterDict(t *testing.T) {
+	dict := []byte("the quick brown fox jumps over the lazy dog")
+	msg := []byte("the lazy dog jumps over the quick brown fox")
+	for _, concurrency := range []int{1, 2} {
+		var buf bytes.Buffer
+		w, err := NewWriterDict(&buf, BestCompression, dict)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Concurrency = concurrency
+		w.Write(msg)
+		w.Close()
+		compressed := buf.Bytes()
+
+		r, err := NewReaderDict(bytes.NewReader(compressed), dict)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b, err := ioutil.ReadAll(r); err != nil || !bytes.Equal(b, msg) {
+			t.Errorf("concurrency %d: ReadAll = %q, %v; want %q, nil", concurrency, b, err, msg)
+		}
+		// Reset keeps the dictionary.
+		if err := r.Reset(bytes.NewReader(compressed)); err != nil {
+			t.Fatal(err)
+		}
+		if b, err := ioutil.ReadAll(r); err != nil || !bytes.Equal(b, msg) {
+			t.Errorf("concurrency %d: after Reset, ReadAll = %q, %v; want %q, nil", concurrency, b, err, msg)
+		}
+
+		// Without the dictionary, the data cannot be read.
+		if r, err := NewReader(bytes.NewReader(compressed)); err == nil {
+			if b, err := ioutil.ReadAll(r); err == nil && bytes.Equal(b, msg) {
+				t.Errorf("concurrency %d: read the data without the dictionary", concurrency)
+			}
+		}
+	}
+}
+
+func TestWriterNextMember(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Name = "first"
+	w.Write([]byte("hello, "))
+	if err := w.NextMember(); err != nil {
+		t.Fatal(err)
+	}
+	w.Name = "second"
+	w.Write([]byte("world"))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.MemberHeader().Name; got != "first" {
+		t.Errorf("first MemberHeader().Name = %q; want %q", got, "first")
+	}
+	b, err := ioutil.ReadAll(r)
+	if string(b) != "hello, world" || err != nil {
+		t.Fatalf("ReadAll = %q, %v; want %q, nil", b, err, "hello, world")
+	}
+	if r.Name != "first" || r.MemberHeader().Name != "second" {
+		t.Errorf("Name, MemberHeader().Name = %q, %q; want %q, %q", r.Name, r.MemberHeader().Name, "first", "second")
+	}
+
+	// The members can be read one at a time.
+	br := bytes.NewReader(buf.Bytes())
+	var names []string
+	for r.Reset(br) == nil {
+		r.Multistream(false)
+		if _, err := ioutil.ReadAll(r); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, r.Name)
+	}
+	if len(names) != 2 || names[0] != "first" || names[1] != "second" {
+		t.Errorf("member names = %q; want [first second]", names)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gzip
+
+import (
+	"bufio"
+	"compress/flate"
+	"hash/crc32"
+	"io"
+)
+
+// NewIndex reads the gzip file from r, which may have several members,
+// and returns an index of its DEFLATE streams for NewReaderAt. The
+// access points are about span bytes apart in the decompressed data; a
+// span of zero or less selects 1 MB. The offsets in the index are
+// offsets in r, whose checksums and sizes are verified as it is read.
+func NewIndex(r io.Reader, span int64) (*flate.Index, error) {
+	cr := &countReader{r: bufio.NewReader(r)}
+	z := &Reader{r: cr}
+	x := new(flate.Index)
+	for {
+		_, err := z.readHeader()
+		if err == io.EOF && len(x.Points) > 0 {
+			return x, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		size := x.Size
+		digest := crc32.NewIEEE()
+		if _, err := x.AddStream(cr, cr.n, span, digest); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(cr, z.buf[:8]); err != nil {
+			return nil, noEOF(err)
+		}
+		if le.Uint32(z.buf[:4]) != digest.Sum32() || le.Uint32(z.buf[4:8]) != uint32(x.Size-size) {
+			return nil, ErrChecksum
+		}
+	}
+}
+
+// NewReaderAt returns a SectionReader of the data decompressed from the
+// gzip file r, using the index x made by NewIndex. Unlike a Reader, it
+// does not verify the checksums. As it implements io.ReadSeeker, it may
+// be passed to net/http.ServeContent to serve ranges of the data.
+func NewReaderAt(r io.ReaderAt, x *flate.Index) *io.SectionReader {
+	return flate.NewReaderAt(r, x)
+}
+
+// countReader counts the bytes read through it.
+type countReader struct {
+	r flate.Reader
+	n int64
+}
+
+func (cr *countReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
+
+func (cr *countReader) ReadByte() (byte, error) {
+	c, err := cr.r.ReadByte()
+	if err == nil {
+		cr.n++
+	}
+	return c, err
+}
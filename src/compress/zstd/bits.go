@@ -0,0 +1,154 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// highBit returns the index of the highest set bit of v, which must be
+// non-zero.
+func highBit(v uint32) uint32 {
+	return uint32(bits.Len32(v)) - 1
+}
+
+// A reverseBitReader reads a bitstream backward, from the end of data
+// toward its start, as used by the FSE and Huffman coded streams.
+// The last byte of the stream holds a marker bit above the final bits.
+type reverseBitReader struct {
+	data  []byte
+	off   int    // index of the next byte to load; bytes [0, off) remain
+	bits  uint64 // loaded bits; the valid ones are the low cnt bits
+	cnt   uint32
+	extra uint32 // bits read past the start of the stream
+}
+
+// init prepares br to read data, which must end in a byte holding
+// the marker bit.
+func (br *reverseBitReader) init(data []byte) bool {
+	if len(data) == 0 || data[len(data)-1] == 0 {
+		return false
+	}
+	last := data[len(data)-1]
+	*br = reverseBitReader{
+		data: data,
+		off:  len(data) - 1,
+		bits: uint64(last),
+		cnt:  highBit(uint32(last)),
+	}
+	return true
+}
+
+// fill loads as many bytes as fit in the container, stopping at the
+// start of the stream.
+func (br *reverseBitReader) fill() {
+	if br.off >= 8 {
+		n := (64 - br.cnt) >> 3
+		v := binary.LittleEndian.Uint64(br.data[br.off-8:])
+		br.bits = br.bits<<(8*n) | v>>(64-8*n)
+		br.off -= int(n)
+		br.cnt += 8 * n
+		return
+	}
+	for br.cnt <= 56 && br.off > 0 {
+		br.off--
+		br.bits = br.bits<<8 | uint64(br.data[br.off])
+		br.cnt += 8
+	}
+}
+
+// read returns the next n bits of the stream, n <= 32. Bits past the
+// start of the stream read as zero and are recorded as an overflow.
+func (br *reverseBitReader) read(n uint32) uint32 {
+	if n == 0 {
+		return 0
+	}
+	if br.cnt < n {
+		br.fill()
+		if br.cnt < n {
+			// Pad with zeros past the start of the stream.
+			missing := n - br.cnt
+			br.bits <<= missing
+			br.cnt += missing
+			br.extra += missing
+		}
+	}
+	br.cnt -= n
+	return uint32(br.bits>>br.cnt) & (1<<n - 1)
+}
+
+// peek returns the next n bits of the stream, n <= 32, without
+// consuming them, padding with zeros past its start.
+func (br *reverseBitReader) peek(n uint32) uint32 {
+	if br.cnt < n {
+		br.fill()
+		if br.cnt < n {
+			return uint32(br.bits<<(n-br.cnt)) & (1<<n - 1)
+		}
+	}
+	return uint32(br.bits>>(br.cnt-n)) & (1<<n - 1)
+}
+
+// skip consumes n bits previously returned by peek.
+func (br *reverseBitReader) skip(n uint32) {
+	if br.cnt < n {
+		br.extra += n - br.cnt
+		br.cnt = 0
+		return
+	}
+	br.cnt -= n
+}
+
+// overflow reports whether more bits were read than the stream holds.
+func (br *reverseBitReader) overflow() bool {
+	return br.extra > 0
+}
+
+// finished reports whether the stream was consumed exactly.
+func (br *reverseBitReader) finished() bool {
+	return br.extra == 0 && br.cnt == 0 && br.off == 0
+}
+
+// A bitWriter writes a bitstream to be read backward by a
+// reverseBitReader.
+type bitWriter struct {
+	out  []byte
+	bits uint64
+	cnt  uint32
+}
+
+// write appends the low n bits of v, n <= 32.
+func (bw *bitWriter) write(v uint32, n uint32) {
+	bw.bits |= uint64(v&(1<<n-1)) << bw.cnt
+	bw.cnt += n
+	if bw.cnt >= 32 {
+		bw.out = append(bw.out, byte(bw.bits), byte(bw.bits>>8), byte(bw.bits>>16), byte(bw.bits>>24))
+		bw.bits >>= 32
+		bw.cnt -= 32
+	}
+}
+
+// close writes the marker bit and the remaining bits and returns the
+// stream.
+func (bw *bitWriter) close() []byte {
+	bw.write(1, 1)
+	return bw.flush()
+}
+
+// flush writes the remaining bits, padding the last byte with zeros,
+// and returns the stream.
+func (bw *bitWriter) flush() []byte {
+	for bw.cnt > 0 {
+		bw.out = append(bw.out, byte(bw.bits))
+		bw.bits >>= 8
+		if bw.cnt < 8 {
+			bw.cnt = 0
+		} else {
+			bw.cnt -= 8
+		}
+	}
+	return bw.out
+}
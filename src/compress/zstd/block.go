@@ -0,0 +1,308 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+// decodeBlock decodes the compressed block data (RFC 8878, section
+// 3.1.1.3), appending its content to z.hist, which has room for it.
+func (z *Reader) decodeBlock(data []byte) error {
+	lits, n, err := z.readLiterals(data)
+	if err != nil {
+		return err
+	}
+	return z.decodeSequences(data[n:], lits)
+}
+
+// readLiterals reads the literals section at the start of data,
+// returning the literals and the size of the section.
+func (z *Reader) readLiterals(data []byte) ([]byte, int, error) {
+	if len(data) == 0 {
+		return nil, 0, errCorrupt("missing literals section")
+	}
+	typ := data[0] & 3
+	sizeFormat := data[0] >> 2 & 3
+
+	if typ == literalsRaw || typ == literalsRLE {
+		var size, hdr int
+		switch sizeFormat {
+		case 0, 2:
+			size, hdr = int(data[0]>>3), 1
+		case 1:
+			if len(data) < 2 {
+				return nil, 0, errCorrupt("truncated literals header")
+			}
+			size, hdr = int(data[0]>>4)+int(data[1])<<4, 2
+		case 3:
+			if len(data) < 3 {
+				return nil, 0, errCorrupt("truncated literals header")
+			}
+			size, hdr = int(data[0]>>4)+int(data[1])<<4+int(data[2])<<12, 3
+		}
+		if size > z.blockMax {
+			return nil, 0, errCorrupt("too many literals")
+		}
+		if typ == literalsRaw {
+			if hdr+size > len(data) {
+				return nil, 0, errCorrupt("truncated literals")
+			}
+			return data[hdr : hdr+size], hdr + size, nil
+		}
+		if hdr >= len(data) {
+			return nil, 0, errCorrupt("truncated literals")
+		}
+		lits := z.literalsBuffer(size)
+		for i := range lits {
+			lits[i] = data[hdr]
+		}
+		return lits, hdr + 1, nil
+	}
+
+	// Huffman coded literals.
+	var regen, comp, hdr int
+	streams := 4
+	switch sizeFormat {
+	case 0, 1:
+		if len(data) < 3 {
+			return nil, 0, errCorrupt("truncated literals header")
+		}
+		h := int(data[0]) | int(data[1])<<8 | int(data[2])<<16
+		regen, comp, hdr = h>>4&0x3ff, h>>14, 3
+		if sizeFormat == 0 {
+			streams = 1
+		}
+	case 2:
+		if len(data) < 4 {
+			return nil, 0, errCorrupt("truncated literals header")
+		}
+		h := int(data[0]) | int(data[1])<<8 | int(data[2])<<16 | int(data[3])<<24
+		regen, comp, hdr = h>>4&0x3fff, h>>18, 4
+	case 3:
+		if len(data) < 5 {
+			return nil, 0, errCorrupt("truncated literals header")
+		}
+		h := int64(data[0]) | int64(data[1])<<8 | int64(data[2])<<16 | int64(data[3])<<24 | int64(data[4])<<32
+		regen, comp, hdr = int(h>>4&0x3ffff), int(h>>22), 5
+	}
+	if regen > z.blockMax {
+		return nil, 0, errCorrupt("too many literals")
+	}
+	if hdr+comp > len(data) {
+		return nil, 0, errCorrupt("truncated literals")
+	}
+	in := data[hdr : hdr+comp]
+	if typ == literalsCompressed {
+		n, err := z.huff.read(in)
+		if err != nil {
+			z.haveHuff = false
+			return nil, 0, err
+		}
+		z.haveHuff = true
+		in = in[n:]
+	} else if !z.haveHuff {
+		return nil, 0, errCorrupt("missing Huffman table for treeless literals")
+	}
+	lits := z.literalsBuffer(regen)
+	var err error
+	if streams == 1 {
+		err = z.huff.decode(in, lits)
+	} else {
+		err = z.huff.decode4(in, lits)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return lits, hdr + comp, nil
+}
+
+// literalsBuffer returns a buffer for n literals.
+func (z *Reader) literalsBuffer(n int) []byte {
+	if cap(z.literals) < n {
+		z.literals = make([]byte, n, z.blockMax)
+	}
+	return z.literals[:n]
+}
+
+// decodeSequences decodes the sequences section data and executes the
+// sequences, appending the result to z.hist.
+func (z *Reader) decodeSequences(data []byte, lits []byte) error {
+	if len(data) == 0 {
+		return errCorrupt("missing sequences section")
+	}
+	var nseq, n int
+	switch b := int(data[0]); {
+	case b < 128:
+		nseq, n = b, 1
+	case b < 255:
+		if len(data) < 2 {
+			return errCorrupt("truncated sequences header")
+		}
+		nseq, n = (b-128)<<8+int(data[1]), 2
+	default:
+		if len(data) < 3 {
+			return errCorrupt("truncated sequences header")
+		}
+		nseq, n = int(data[1])+int(data[2])<<8+0x7f00, 3
+	}
+	data = data[n:]
+	if nseq == 0 {
+		if len(data) != 0 {
+			return errCorrupt("extra data after sequences header")
+		}
+		z.hist = append(z.hist, lits...)
+		return nil
+	}
+
+	if len(data) == 0 {
+		return errCorrupt("missing sequence table modes")
+	}
+	modes := data[0]
+	if modes&3 != 0 {
+		return errCorrupt("reserved bits set in sequence table modes")
+	}
+	data = data[1:]
+	var err error
+	if n, err = z.readTable(&z.literalsLength, modes>>6, data, maxLiteralsLengthSymbol, maxLiteralsLengthLog, &predefLiteralsLength, z.llStorage[:]); err != nil {
+		return err
+	}
+	data = data[n:]
+	if n, err = z.readTable(&z.offset, modes>>4&3, data, maxOffsetSymbol, maxOffsetLog, &predefOffset, z.ofStorage[:]); err != nil {
+		return err
+	}
+	data = data[n:]
+	if n, err = z.readTable(&z.matchLength, modes>>2&3, data, maxMatchLengthSymbol, maxMatchLengthLog, &predefMatchLength, z.mlStorage[:]); err != nil {
+		return err
+	}
+	data = data[n:]
+
+	var br reverseBitReader
+	if !br.init(data) {
+		return errCorrupt("invalid sequences bitstream")
+	}
+	llTable, ofTable, mlTable := z.literalsLength.table, z.offset.table, z.matchLength.table
+	llState := br.read(z.literalsLength.log)
+	ofState := br.read(z.offset.log)
+	mlState := br.read(z.matchLength.log)
+
+	// The largest offset a sequence may use grows with the data of the
+	// frame until it reaches the window size. Dictionary content can be
+	// referred to until then.
+	blockStart := len(z.hist)
+	frameStart := int64(blockStart) - int64(z.frameOut)
+	window := int64(z.frame.windowSize)
+	reps := z.reps
+	for i := 0; i < nseq; i++ {
+		ll, of, ml := llTable[llState], ofTable[ofState], mlTable[mlState]
+
+		offset := uint32(1)<<of.sym + br.read(uint32(of.sym))
+		matchLen := matchLengthBase[ml.sym] + br.read(uint32(matchLengthBits[ml.sym]))
+		litLen := literalsLengthBase[ll.sym] + br.read(uint32(literalsLengthBits[ll.sym]))
+
+		if offset > 3 {
+			offset -= 3
+			reps[2], reps[1], reps[0] = reps[1], reps[0], offset
+		} else {
+			idx := offset - 1
+			if litLen == 0 {
+				idx++
+			}
+			switch idx {
+			case 0:
+				offset = reps[0]
+			case 1:
+				offset = reps[1]
+				reps[1], reps[0] = reps[0], offset
+			case 2:
+				offset = reps[2]
+				reps[2], reps[1], reps[0] = reps[1], reps[0], offset
+			case 3:
+				offset = reps[0] - 1
+				reps[2], reps[1], reps[0] = reps[1], reps[0], offset
+			}
+		}
+
+		if i < nseq-1 {
+			llState = uint32(ll.base) + br.read(uint32(ll.bits))
+			mlState = uint32(ml.base) + br.read(uint32(ml.bits))
+			ofState = uint32(of.base) + br.read(uint32(of.bits))
+		}
+		if br.overflow() {
+			return errCorrupt("sequences bitstream overrun")
+		}
+
+		// Execute the sequence.
+		if int(litLen) > len(lits) {
+			return errCorrupt("sequence uses too many literals")
+		}
+		if len(z.hist)-blockStart+int(litLen)+int(matchLen) > z.blockMax {
+			return errCorrupt("block content too large")
+		}
+		n := len(z.hist)
+		z.hist = z.hist[:n+int(litLen)+int(matchLen)]
+		copy(z.hist[n:], lits[:litLen])
+		lits = lits[litLen:]
+		n += int(litLen)
+
+		pos := int64(n) - frameStart
+		limit := pos + int64(z.dictLen)
+		if pos > window {
+			limit = window
+		}
+		if offset == 0 || int64(offset) > limit || int(offset) > n {
+			return errCorrupt("match offset out of range")
+		}
+		src := z.hist[n-int(offset) : n+int(matchLen)-int(offset)]
+		dst := z.hist[n : n+int(matchLen)]
+		if int(offset) >= int(matchLen) {
+			copy(dst, src)
+		} else {
+			// The match overlaps the data it produces.
+			for j := range dst {
+				dst[j] = src[j]
+			}
+		}
+	}
+	if !br.finished() {
+		return errCorrupt("sequences bitstream size mismatch")
+	}
+	if len(z.hist)-blockStart+len(lits) > z.blockMax {
+		return errCorrupt("block content too large")
+	}
+	z.hist = append(z.hist, lits...)
+	z.reps = reps
+	return nil
+}
+
+// readTable sets up t, the decoding table for a sequence field, as
+// described by mode and data, returning the number of bytes read.
+func (z *Reader) readTable(t *fseTable, mode byte, data []byte, maxSym int, maxLog uint32, predef *fseTable, storage []fseEntry) (int, error) {
+	switch mode {
+	case modePredefined:
+		*t = *predef
+		return 0, nil
+	case modeRLE:
+		if len(data) == 0 {
+			return 0, errCorrupt("truncated sequence table")
+		}
+		if int(data[0]) > maxSym {
+			return 0, errCorrupt("invalid RLE sequence symbol")
+		}
+		t.setRLE(data[0], storage)
+		return 1, nil
+	case modeCompressed:
+		var norm [maxMatchLengthSymbol + 1]int16
+		log, n, err := readNCount(data, norm[:maxSym+1], maxLog)
+		if err != nil {
+			return 0, err
+		}
+		if err := t.build(norm[:maxSym+1], log, storage); err != nil {
+			return 0, err
+		}
+		return n, nil
+	default:
+		if t.table == nil {
+			return 0, errCorrupt("missing sequence table for repeat mode")
+		}
+		return 0, nil
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const dictMagic = 0xEC30A437
+
+// A dictionary is a parsed zstd dictionary (RFC 8878, section 5).
+type dictionary struct {
+	id      uint32
+	content []byte
+	reps    [3]uint32
+
+	// The entropy tables, which are nil for a raw content dictionary.
+	huff                 *huffTable
+	literalsLength       *fseTable
+	offset               *fseTable
+	matchLength          *fseTable
+	llStorage, mlStorage [1 << maxMatchLengthLog]fseEntry
+	ofStorage            [1 << maxOffsetLog]fseEntry
+}
+
+var errDictionary = errors.New("zstd: invalid dictionary")
+
+// parseDictionary parses b, which is either a dictionary in the zstd
+// format or, lacking its magic number, raw content. The dictionary
+// refers to b, which must not be modified while it is in use.
+func parseDictionary(b []byte) (*dictionary, error) {
+	d := &dictionary{reps: [3]uint32{1, 4, 8}}
+	if len(b) < 8 || binary.LittleEndian.Uint32(b) != dictMagic {
+		d.content = b
+		return d, nil
+	}
+	d.id = binary.LittleEndian.Uint32(b[4:])
+	b = b[8:]
+
+	d.huff = new(huffTable)
+	n, err := d.huff.read(b)
+	if err != nil {
+		return nil, errDictionary
+	}
+	b = b[n:]
+
+	var norm [maxMatchLengthSymbol + 1]int16
+	var log uint32
+	d.offset = new(fseTable)
+	if log, n, err = readNCount(b, norm[:maxOffsetSymbol+1], maxOffsetLog); err == nil {
+		err = d.offset.build(norm[:maxOffsetSymbol+1], log, d.ofStorage[:])
+	}
+	if err != nil {
+		return nil, errDictionary
+	}
+	b = b[n:]
+	d.matchLength = new(fseTable)
+	if log, n, err = readNCount(b, norm[:maxMatchLengthSymbol+1], maxMatchLengthLog); err == nil {
+		err = d.matchLength.build(norm[:maxMatchLengthSymbol+1], log, d.mlStorage[:])
+	}
+	if err != nil {
+		return nil, errDictionary
+	}
+	b = b[n:]
+	d.literalsLength = new(fseTable)
+	if log, n, err = readNCount(b, norm[:maxLiteralsLengthSymbol+1], maxLiteralsLengthLog); err == nil {
+		err = d.literalsLength.build(norm[:maxLiteralsLengthSymbol+1], log, d.llStorage[:])
+	}
+	if err != nil {
+		return nil, errDictionary
+	}
+	b = b[n:]
+
+	if len(b) < 12 {
+		return nil, errDictionary
+	}
+	for i := range d.reps {
+		d.reps[i] = binary.LittleEndian.Uint32(b[4*i:])
+	}
+	d.content = b[12:]
+	for _, r := range d.reps {
+		if r == 0 || int64(r) > int64(len(d.content)) {
+			return nil, errDictionary
+		}
+	}
+	return d, nil
+}
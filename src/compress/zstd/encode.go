@@ -0,0 +1,661 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// levelParams are the match finder parameters for a compression level.
+type levelParams struct {
+	windowLog uint32
+	hashLog   uint32
+	chainLog  uint32 // 0 for no hash chains
+	depth     int    // chain candidates to check
+	lazy      int    // positions to look ahead for a better match
+	target    int    // match length that ends the search
+	skipShift uint32 // speeds up the skipping of incompressible data; 0 to disable
+}
+
+var levels = [...]levelParams{
+	1: {windowLog: 20, hashLog: 16, depth: 1, target: 16, skipShift: 5},
+	2: {windowLog: 20, hashLog: 17, depth: 1, target: 24, skipShift: 6},
+	3: {windowLog: 21, hashLog: 17, chainLog: 16, depth: 4, target: 32, skipShift: 7},
+	4: {windowLog: 21, hashLog: 17, chainLog: 16, depth: 8, lazy: 1, target: 32, skipShift: 8},
+	5: {windowLog: 21, hashLog: 17, chainLog: 17, depth: 16, lazy: 1, target: 48, skipShift: 8},
+	6: {windowLog: 22, hashLog: 18, chainLog: 17, depth: 32, lazy: 1, target: 64, skipShift: 8},
+	7: {windowLog: 22, hashLog: 18, chainLog: 18, depth: 64, lazy: 2, target: 96, skipShift: 8},
+	8: {windowLog: 22, hashLog: 18, chainLog: 18, depth: 128, lazy: 2, target: 128, skipShift: 8},
+	9: {windowLog: 22, hashLog: 18, chainLog: 18, depth: 256, lazy: 2, target: 256, skipShift: 8},
+}
+
+const (
+	minMatch = 4
+
+	// Below these sizes, coding literals with Huffman codes and
+	// sequences with compressed tables is not worth it.
+	minHuffmanLiterals = 32
+	minCompressedSeqs  = 16
+)
+
+// A seq is a sequence: litLen literals followed by a match of matchLen
+// bytes, whose offset is coded as offsetValue (RFC 8878, section
+// 3.1.1.5).
+type seq struct {
+	litLen, matchLen, offsetValue uint32
+}
+
+// An encoder compresses the blocks of a frame. Its history holds the
+// data of the frame, preceded by the dictionary content, as far back
+// as the window reaches.
+type encoder struct {
+	level   int
+	p       levelParams
+	dict    *dictionary
+	window  int
+	hist    []byte
+	base    int // position in the frame of hist[0], negative for dictionary content
+	dictLen int // bytes of dictionary content the frame can refer to
+	reps    [3]uint32
+	digest  xxhash64
+
+	table []int32 // hash of 4 bytes to position in hist plus one
+	chain []int32 // position to previous position with the same hash, plus one
+
+	seqs []seq
+	lits []byte
+
+	// Entropy coding state.
+	huff                         huffEncoder
+	llCodes, mlCodes, ofCodes    []uint8
+	llTable, mlTable, ofTable    fseEncTable
+	scratch                      []byte
+	litCounts                    [256]uint32
+	llCounts, mlCounts, ofCounts [maxMatchLengthSymbol + 1]uint32
+	llNorm, mlNorm, ofNorm       [maxMatchLengthSymbol + 1]int16
+}
+
+func newEncoder(level int, dict *dictionary) *encoder {
+	e := &encoder{level: level, dict: dict}
+	if level > 0 {
+		e.p = levels[level]
+		e.table = make([]int32, 1<<e.p.hashLog)
+		if e.p.chainLog > 0 {
+			e.chain = make([]int32, 1<<e.p.chainLog)
+		}
+	} else {
+		e.p = levels[1]
+	}
+	e.window = 1 << e.p.windowLog
+	return e
+}
+
+// reset prepares e to compress a new frame.
+func (e *encoder) reset() {
+	e.hist = e.hist[:0]
+	e.base = 0
+	e.dictLen = 0
+	e.reps = [3]uint32{1, 4, 8}
+	e.digest.reset()
+	for i := range e.table {
+		e.table[i] = 0
+	}
+	for i := range e.chain {
+		e.chain[i] = 0
+	}
+	if d := e.dict; d != nil {
+		e.reps = d.reps
+		content := d.content
+		if len(content) > e.window {
+			content = content[len(content)-e.window:]
+		}
+		e.hist = append(e.hist, content...)
+		e.base = -len(content)
+		e.dictLen = len(content)
+		if e.table != nil {
+			for i := 0; i+minMatch <= len(content); i++ {
+				e.insert(i)
+			}
+		}
+	}
+}
+
+// frameHeader appends a frame header for e's configuration to dst.
+// If size is not negative, it is the content size of the frame.
+func (e *encoder) frameHeader(dst []byte, size int64) []byte {
+	var desc byte = 0x04 // checksum
+	var fcs []byte
+	single := size >= 0 && size <= int64(e.window)
+	switch {
+	case size < 0:
+	case single && size < 256:
+		fcs = []byte{byte(size)}
+	case size >= 256 && size < 256+1<<16:
+		desc |= 1 << 6
+		fcs = binary.LittleEndian.AppendUint16(nil, uint16(size-256))
+	case size <= math.MaxUint32:
+		desc |= 2 << 6
+		fcs = binary.LittleEndian.AppendUint32(nil, uint32(size))
+	default:
+		desc |= 3 << 6
+		fcs = binary.LittleEndian.AppendUint64(nil, uint64(size))
+	}
+	if single {
+		desc |= 0x20
+	}
+	var id []byte
+	if e.dict != nil && e.dict.id != 0 {
+		switch v := e.dict.id; {
+		case v < 1<<8:
+			desc |= 1
+		case v < 1<<16:
+			desc |= 2
+		default:
+			desc |= 3
+		}
+		id = binary.LittleEndian.AppendUint32(nil, e.dict.id)[:[4]int{0, 1, 2, 4}[desc&3]]
+	}
+	dst = binary.LittleEndian.AppendUint32(dst, frameMagic)
+	dst = append(dst, desc)
+	if !single {
+		dst = append(dst, byte(e.p.windowLog-minWindowLog)<<3)
+	}
+	dst = append(dst, id...)
+	return append(dst, fcs...)
+}
+
+// encodeBlock adds src to the frame, appending it as a block to dst.
+func (e *encoder) encodeBlock(dst []byte, src []byte, last bool) []byte {
+	e.digest.update(src)
+	e.makeRoom(len(src))
+	start := len(e.hist)
+	e.hist = append(e.hist, src...)
+
+	var lastBit uint32
+	if last {
+		lastBit = 1
+	}
+	if len(src) == 0 {
+		return appendBlockHeader(dst, lastBit|blockRaw<<1)
+	}
+	if e.level > 0 && len(src) > 1 {
+		reps := e.reps
+		e.findSequences(start)
+		hdr := len(dst)
+		dst = append(dst, 0, 0, 0)
+		var ok bool
+		if dst, ok = e.encodeSequences(dst, len(src)); ok {
+			h := lastBit | blockCompressed<<1 | uint32(len(dst)-hdr-3)<<3
+			dst[hdr], dst[hdr+1], dst[hdr+2] = byte(h), byte(h>>8), byte(h>>16)
+			return dst
+		}
+		dst = dst[:hdr]
+		e.reps = reps // the decoder will not see the sequences
+	}
+	if isRLE(src) {
+		dst = appendBlockHeader(dst, lastBit|blockRLE<<1|uint32(len(src))<<3)
+		return append(dst, src[0])
+	}
+	dst = appendBlockHeader(dst, lastBit|blockRaw<<1|uint32(len(src))<<3)
+	return append(dst, src...)
+}
+
+func appendBlockHeader(dst []byte, h uint32) []byte {
+	return append(dst, byte(h), byte(h>>8), byte(h>>16))
+}
+
+// isRLE reports whether b, which is not empty, repeats a single byte.
+func isRLE(b []byte) bool {
+	for _, c := range b[1:] {
+		if c != b[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// checksum appends the content checksum of the frame to dst.
+func (e *encoder) checksum(dst []byte) []byte {
+	return binary.LittleEndian.AppendUint32(dst, uint32(e.digest.sum64()))
+}
+
+// makeRoom makes room for n more bytes in e.hist, dropping data the
+// window no longer reaches.
+func (e *encoder) makeRoom(n int) {
+	if len(e.hist)+n <= cap(e.hist) {
+		return
+	}
+	drop := len(e.hist) - e.window
+	if e.chain != nil {
+		// Keep the chain slots of the remaining positions.
+		drop &^= len(e.chain) - 1
+	}
+	if drop > 0 && len(e.hist)+n-drop <= cap(e.hist) {
+		copy(e.hist, e.hist[drop:])
+		e.hist = e.hist[:len(e.hist)-drop]
+		e.base += drop
+		e.dictLen = 0
+		slide(e.table, int32(drop))
+		slide(e.chain, int32(drop))
+		return
+	}
+	c := 2*cap(e.hist) + n
+	if max := 2*e.window + maxBlockSize; c > max && len(e.hist)+n <= max {
+		c = max
+	}
+	h := make([]byte, len(e.hist), c)
+	copy(h, e.hist)
+	e.hist = h
+}
+
+// slide moves the positions in t back by d, dropping those before
+// the start of the history.
+func slide(t []int32, d int32) {
+	for i, v := range t {
+		if v -= d; v < 0 {
+			v = 0
+		}
+		t[i] = v
+	}
+}
+
+func hash4(u uint32, log uint32) uint32 {
+	return (u * 2654435761) >> (32 - log)
+}
+
+// insert adds position i of the history to the hash tables.
+func (e *encoder) insert(i int) {
+	h := hash4(binary.LittleEndian.Uint32(e.hist[i:]), e.p.hashLog)
+	if e.chain != nil {
+		e.chain[i&(len(e.chain)-1)] = e.table[h]
+	}
+	e.table[h] = int32(i + 1)
+}
+
+// maxOffset returns the largest offset a match at history position i
+// may use.
+func (e *encoder) maxOffset(i int) int {
+	pos := e.base + i
+	if pos <= e.window {
+		return pos + e.dictLen
+	}
+	return e.window
+}
+
+// matchLen returns the length of the common prefix of a and b.
+func matchLen(a, b []byte) int {
+	n := 0
+	for len(a) >= 8 && len(b) >= 8 {
+		if x := binary.LittleEndian.Uint64(a) ^ binary.LittleEndian.Uint64(b); x != 0 {
+			return n + bits.TrailingZeros64(x)>>3
+		}
+		a, b, n = a[8:], b[8:], n+8
+	}
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// bestMatch returns the longest match for history position i, whose
+// data ends at end, among the repeat offsets and the hash candidates.
+func (e *encoder) bestMatch(i, end int) (length, offset int) {
+	hist := e.hist[:end]
+	maxOff := e.maxOffset(i)
+	for _, r := range e.reps {
+		if int(r) <= maxOff {
+			if n := matchLen(hist[i:], hist[i-int(r):]); n > length {
+				length, offset = n, int(r)
+			}
+		}
+	}
+	if length >= e.p.target {
+		return length, offset
+	}
+	cur := binary.LittleEndian.Uint32(hist[i:])
+	cand := int(e.table[hash4(cur, e.p.hashLog)]) - 1
+	for depth := e.p.depth; depth > 0 && cand >= 0 && cand < i && i-cand <= maxOff; depth-- {
+		if binary.LittleEndian.Uint32(hist[cand:]) == cur {
+			if n := matchLen(hist[i:], hist[cand:]); n > length {
+				length, offset = n, i-cand
+				if n >= e.p.target {
+					break
+				}
+			}
+		}
+		if e.chain == nil || i-cand >= len(e.chain) {
+			break
+		}
+		next := int(e.chain[cand&(len(e.chain)-1)]) - 1
+		if next >= cand {
+			break
+		}
+		cand = next
+	}
+	if length < minMatch {
+		return 0, 0
+	}
+	return length, offset
+}
+
+// findSequences parses the block at e.hist[start:] into sequences and
+// literals.
+func (e *encoder) findSequences(start int) {
+	e.seqs = e.seqs[:0]
+	e.lits = e.lits[:0]
+	end := len(e.hist)
+	limit := end - 8 // leave room for loading 8 bytes
+	litStart := start
+	i := start
+	for i < limit {
+		length, offset := e.bestMatch(i, end)
+		if length == 0 {
+			e.insert(i)
+			step := 1
+			if e.p.skipShift > 0 {
+				step += (i - litStart) >> e.p.skipShift
+			}
+			i += step
+			continue
+		}
+		for k := 0; k < e.p.lazy && i+1 < limit; k++ {
+			// Look for a better match at the next position.
+			e.insert(i)
+			l, o := e.bestMatch(i+1, end)
+			if l <= length {
+				break
+			}
+			i, length, offset = i+1, l, o
+		}
+		// Extend the match backward over the pending literals.
+		for i > litStart && i-offset > 0 && offset <= e.maxOffset(i-1) && e.hist[i-1] == e.hist[i-1-offset] {
+			i--
+			length++
+		}
+
+		e.lits = append(e.lits, e.hist[litStart:i]...)
+		e.addSeq(uint32(i-litStart), uint32(length), uint32(offset))
+
+		// Index the positions within the match.
+		matchEnd := i + length
+		if e.chain != nil {
+			for ; i < matchEnd && i < limit; i++ {
+				e.insert(i)
+			}
+		} else {
+			e.insert(i)
+			if matchEnd-2 < limit {
+				e.insert(matchEnd - 2)
+			}
+		}
+		i = matchEnd
+		litStart = i
+	}
+	e.lits = append(e.lits, e.hist[litStart:]...)
+}
+
+// addSeq adds a sequence, coding its offset with the repeat offsets
+// like the decoder will.
+func (e *encoder) addSeq(litLen, matchLen, offset uint32) {
+	r := &e.reps
+	var v uint32
+	switch {
+	case litLen > 0 && offset == r[0]:
+		v = 1
+	case litLen > 0 && offset == r[1]:
+		v = 2
+		r[0], r[1] = r[1], r[0]
+	case litLen > 0 && offset == r[2]:
+		v = 3
+		r[0], r[1], r[2] = r[2], r[0], r[1]
+	case litLen == 0 && offset == r[1]:
+		v = 1
+		r[0], r[1] = r[1], r[0]
+	case litLen == 0 && offset == r[2]:
+		v = 2
+		r[0], r[1], r[2] = r[2], r[0], r[1]
+	case litLen == 0 && offset == r[0]-1:
+		v = 3
+		r[0], r[1], r[2] = offset, r[0], r[1]
+	default:
+		v = offset + 3
+		r[0], r[1], r[2] = offset, r[0], r[1]
+	}
+	e.seqs = append(e.seqs, seq{litLen: litLen, matchLen: matchLen, offsetValue: v})
+}
+
+// literalsLengthCode returns the code for literals length n.
+func literalsLengthCode(n uint32) uint8 {
+	if n >= 64 {
+		return uint8(highBit(n) + 19)
+	}
+	return llCodeTable[n]
+}
+
+// matchLengthCode returns the code for match length n.
+func matchLengthCode(n uint32) uint8 {
+	n -= 3
+	if n >= 128 {
+		return uint8(highBit(n) + 36)
+	}
+	return mlCodeTable[n]
+}
+
+var llCodeTable [64]uint8
+var mlCodeTable [128]uint8
+
+func init() {
+	for c, base := range literalsLengthBase {
+		for n := base; n < base+1<<literalsLengthBits[c] && n < 64; n++ {
+			llCodeTable[n] = uint8(c)
+		}
+	}
+	for c, base := range matchLengthBase {
+		for n := base - 3; n < base-3+1<<matchLengthBits[c] && n < 128; n++ {
+			mlCodeTable[n] = uint8(c)
+		}
+	}
+}
+
+// encodeSequences appends the literals and sequences sections for the
+// block of n bytes last parsed to dst, returning false if they are no
+// smaller than the block.
+func (e *encoder) encodeSequences(dst []byte, n int) ([]byte, bool) {
+	start := len(dst)
+	dst = e.encodeLiterals(dst)
+
+	nseq := len(e.seqs)
+	switch {
+	case nseq < 128:
+		dst = append(dst, byte(nseq))
+	case nseq < 0x7f00:
+		dst = append(dst, byte(nseq>>8+128), byte(nseq))
+	default:
+		dst = append(dst, 255, byte(nseq-0x7f00), byte((nseq-0x7f00)>>8))
+	}
+	if nseq == 0 {
+		return dst, len(dst)-start < n
+	}
+
+	e.llCodes, e.mlCodes, e.ofCodes = e.llCodes[:0], e.mlCodes[:0], e.ofCodes[:0]
+	e.llCounts, e.mlCounts, e.ofCounts = [maxMatchLengthSymbol + 1]uint32{}, [maxMatchLengthSymbol + 1]uint32{}, [maxMatchLengthSymbol + 1]uint32{}
+	for _, s := range e.seqs {
+		ll, ml, of := literalsLengthCode(s.litLen), matchLengthCode(s.matchLen), uint8(highBit(s.offsetValue))
+		e.llCodes = append(e.llCodes, ll)
+		e.mlCodes = append(e.mlCodes, ml)
+		e.ofCodes = append(e.ofCodes, of)
+		e.llCounts[ll]++
+		e.mlCounts[ml]++
+		e.ofCounts[of]++
+	}
+
+	modes := len(dst)
+	dst = append(dst, 0)
+	var llMode, ofMode, mlMode byte
+	var ll, of, ml *fseEncTable
+	dst, llMode, ll = e.chooseTable(dst, e.llCounts[:maxLiteralsLengthSymbol+1], maxLiteralsLengthLog, &e.llTable, &e.llNorm, &predefLiteralsLengthEnc, predefLiteralsLengthNorm[:])
+	dst, ofMode, of = e.chooseTable(dst, e.ofCounts[:maxOffsetSymbol+1], maxOffsetLog, &e.ofTable, &e.ofNorm, &predefOffsetEnc, predefOffsetNorm[:])
+	dst, mlMode, ml = e.chooseTable(dst, e.mlCounts[:maxMatchLengthSymbol+1], maxMatchLengthLog, &e.mlTable, &e.mlNorm, &predefMatchLengthEnc, predefMatchLengthNorm[:])
+	dst[modes] = llMode<<6 | ofMode<<4 | mlMode<<2
+
+	// The sequences are coded backward, so that the decoder reads
+	// them forward.
+	bw := bitWriter{out: dst}
+	var llState, ofState, mlState fseState
+	last := nseq - 1
+	mlState.init(ml, e.mlCodes[last])
+	ofState.init(of, e.ofCodes[last])
+	llState.init(ll, e.llCodes[last])
+	e.writeExtraBits(&bw, last)
+	for i := last - 1; i >= 0; i-- {
+		ofState.encode(&bw, e.ofCodes[i])
+		mlState.encode(&bw, e.mlCodes[i])
+		llState.encode(&bw, e.llCodes[i])
+		e.writeExtraBits(&bw, i)
+	}
+	mlState.flush(&bw)
+	ofState.flush(&bw)
+	llState.flush(&bw)
+	dst = bw.close()
+	return dst, len(dst)-start < n
+}
+
+// writeExtraBits writes the extra bits of sequence i.
+func (e *encoder) writeExtraBits(bw *bitWriter, i int) {
+	s := e.seqs[i]
+	ll, ml, of := e.llCodes[i], e.mlCodes[i], e.ofCodes[i]
+	bw.write(s.litLen-literalsLengthBase[ll], uint32(literalsLengthBits[ll]))
+	bw.write(s.matchLen-matchLengthBase[ml], uint32(matchLengthBits[ml]))
+	bw.write(s.offsetValue-1<<of, uint32(of))
+}
+
+// chooseTable picks the cheapest way to code symbols with the given
+// counts: with the predefined table, as a single repeated symbol, or
+// with a table built for them, whose description it appends to dst.
+// It returns the mode and the table to code with. An RLE table
+// codes with no bits at all.
+func (e *encoder) chooseTable(dst []byte, counts []uint32, maxLog uint32, t *fseEncTable, norm *[maxMatchLengthSymbol + 1]int16, predef *fseEncTable, predefNorm []int16) ([]byte, byte, *fseEncTable) {
+	total, maxSym, distinct := 0, 0, 0
+	for s, c := range counts {
+		if c > 0 {
+			total += int(c)
+			maxSym = s
+			distinct++
+		}
+	}
+	if distinct == 1 {
+		t.log = 0
+		t.stateTable[0] = 0
+		t.symTT[maxSym] = fseTransform{}
+		return append(dst, byte(maxSym)), modeRLE, t
+	}
+
+	predefCost := tableCost(counts, predefNorm, predef.log)
+	if total < minCompressedSeqs && predefCost != math.MaxInt32 {
+		return dst, modePredefined, predef
+	}
+	log := optimalTableLog(maxLog, total, maxSym)
+	normalizeCounts(norm[:maxSym+1], counts[:maxSym+1], total, log)
+	e.scratch = writeNCount(e.scratch[:0], norm[:maxSym+1], log)
+	cost := tableCost(counts, norm[:maxSym+1], log) + 8*len(e.scratch)
+	if predefCost <= cost {
+		return dst, modePredefined, predef
+	}
+	if t.build(norm[:maxSym+1], log) != nil {
+		panic("zstd: invalid normalized distribution")
+	}
+	return append(dst, e.scratch...), modeCompressed, t
+}
+
+// encodeLiterals appends the literals section for e.lits to dst.
+func (e *encoder) encodeLiterals(dst []byte) []byte {
+	lits := e.lits
+	n := len(lits)
+	if n == 0 {
+		return append(dst, literalsRaw)
+	}
+	if n >= minHuffmanLiterals {
+		if isRLE(lits) {
+			return append(appendLiteralsHeader(dst, literalsRLE, n), lits[0])
+		}
+		if b, ok := e.huffmanLiterals(dst); ok {
+			return b
+		}
+	}
+	return append(appendLiteralsHeader(dst, literalsRaw, n), lits...)
+}
+
+// appendLiteralsHeader appends the header of a raw or RLE literals
+// section of n bytes to dst.
+func appendLiteralsHeader(dst []byte, typ byte, n int) []byte {
+	switch {
+	case n < 1<<5:
+		return append(dst, typ|byte(n)<<3)
+	case n < 1<<12:
+		return append(dst, typ|1<<2|byte(n)<<4, byte(n>>4))
+	default:
+		return append(dst, typ|3<<2|byte(n)<<4, byte(n>>4), byte(n>>12))
+	}
+}
+
+// huffmanLiterals appends the literals section for e.lits coded with a
+// Huffman code to dst, returning false if that does not pay off.
+func (e *encoder) huffmanLiterals(dst []byte) ([]byte, bool) {
+	lits := e.lits
+	n := len(lits)
+	e.litCounts = [256]uint32{}
+	for _, c := range lits {
+		e.litCounts[c]++
+	}
+	e.huff.build(&e.litCounts)
+
+	// Leave room for the largest header.
+	start := len(dst)
+	dst = append(dst, 0, 0, 0, 0, 0)
+	body := len(dst)
+	dst, ok := e.huff.writeTree(dst)
+	if !ok {
+		return dst[:start], false
+	}
+	streams := 4
+	if n < 256 {
+		streams = 1
+		dst = e.huff.encode(dst, lits)
+	} else if dst, ok = e.huff.encode4(dst, lits); !ok {
+		return dst[:start], false
+	}
+	size := len(dst) - body
+
+	var hdr [5]byte
+	var hlen int
+	switch {
+	case streams == 1 && size < 1<<10:
+		h := literalsCompressed | uint32(n)<<4 | uint32(size)<<14
+		hdr, hlen = [5]byte{byte(h), byte(h >> 8), byte(h >> 16)}, 3
+	case n < 1<<10 && size < 1<<10:
+		h := literalsCompressed | 1<<2 | uint32(n)<<4 | uint32(size)<<14
+		hdr, hlen = [5]byte{byte(h), byte(h >> 8), byte(h >> 16)}, 3
+	case streams == 4 && n < 1<<14 && size < 1<<14:
+		h := literalsCompressed | 2<<2 | uint32(n)<<4 | uint32(size)<<18
+		hdr, hlen = [5]byte{byte(h), byte(h >> 8), byte(h >> 16), byte(h >> 24)}, 4
+	case streams == 4 && size < 1<<18:
+		h := literalsCompressed | 3<<2 | uint64(n)<<4 | uint64(size)<<22
+		hdr, hlen = [5]byte{byte(h), byte(h >> 8), byte(h >> 16), byte(h >> 24), byte(h >> 32)}, 5
+	default:
+		return dst[:start], false
+	}
+	if hlen+size >= n+3 {
+		// No better than raw literals.
+		return dst[:start], false
+	}
+	copy(dst[start:], hdr[:hlen])
+	copy(dst[start+hlen:], dst[body:])
+	return dst[:start+hlen+size], true
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd_test
+
+import (
+	"bytes"
+	"compress/zstd"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+func Example_writerReader() {
+	var buf bytes.Buffer
+	zw := zstd.NewWriter(&buf)
+	if _, err := zw.Write([]byte("A long time ago in a galaxy far, far away...")); err != nil {
+		log.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	zr := zstd.NewReader(&buf)
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+	if err := zr.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// A long time ago in a galaxy far, far away...
+}
+
+func ExampleNewWriterDict() {
+	// The dictionary holds content that the data is expected to share.
+	dict := []byte("<order><item>widget</item><quantity></quantity></order>")
+	data := []byte("<order><item>widget</item><quantity>3</quantity></order>")
+
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriterDict(&buf, zstd.BestCompression, dict)
+	if err != nil {
+		log.Fatal(err)
+	}
+	zw.Write(data)
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(buf.Len() < len(data))
+
+	// Decompression requires the same dictionary.
+	zr, err := zstd.NewReaderDict(&buf, dict)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := io.Copy(os.Stdout, zr); err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// true
+	// <order><item>widget</item><quantity>3</quantity></order>
+}
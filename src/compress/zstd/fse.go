@@ -0,0 +1,259 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+// Limits on the FSE tables used to code sequences (RFC 8878, section 3.1.1.3.2).
+const (
+	maxLiteralsLengthSymbol = 35
+	maxMatchLengthSymbol    = 52
+	maxOffsetSymbol         = 31
+
+	maxLiteralsLengthLog = 9
+	maxMatchLengthLog    = 9
+	maxOffsetLog         = 8
+
+	minFSELog = 5
+)
+
+// An fseEntry is an entry in an FSE decoding table. Decoding from the
+// state indexing the entry yields sym; the next state is base plus the
+// next bits bits of the stream.
+type fseEntry struct {
+	sym  uint8
+	bits uint8
+	base uint16
+}
+
+// An fseTable is an FSE decoding table.
+type fseTable struct {
+	log   uint32
+	table []fseEntry
+}
+
+// readNCount reads an FSE table description from the start of data
+// (RFC 8878, section 4.1.1) into norm, whose length is the size of the
+// alphabet. It returns the accuracy log, which must not exceed maxLog,
+// and the number of bytes read. Symbols beyond the described ones have
+// a zero count; a count of -1 denotes a "less than 1" probability.
+func readNCount(data []byte, norm []int16, maxLog uint32) (accLog uint32, n int, err error) {
+	if len(data) == 0 {
+		return 0, 0, errCorrupt("missing FSE table description")
+	}
+	pos := uint32(0) // bit position in data
+	peek := func(n uint32) uint32 {
+		var v uint32
+		for i := uint32(0); i < 4; i++ {
+			if j := int(pos/8 + i); j < len(data) {
+				v |= uint32(data[j]) << (8 * i)
+			}
+		}
+		return v >> (pos % 8) & (1<<n - 1)
+	}
+
+	accLog = peek(4) + minFSELog
+	if accLog > maxLog {
+		return 0, 0, errCorrupt("FSE accuracy log too large")
+	}
+	pos = 4
+
+	remaining := int32(1)<<accLog + 1
+	threshold := int32(1) << accLog
+	nbBits := accLog + 1
+	sym := 0
+	prev0 := false
+	for remaining > 1 {
+		if prev0 {
+			// Runs of zero counts are coded as 2-bit repeat flags.
+			n0 := sym
+			for {
+				r := peek(2)
+				pos += 2
+				n0 += int(r)
+				if r != 3 {
+					break
+				}
+			}
+			if n0 >= len(norm) {
+				return 0, 0, errCorrupt("too many FSE symbols")
+			}
+			for ; sym < n0; sym++ {
+				norm[sym] = 0
+			}
+		}
+		if sym >= len(norm) {
+			return 0, 0, errCorrupt("too many FSE symbols")
+		}
+
+		max := 2*threshold - 1 - remaining
+		v := int32(peek(nbBits))
+		var count int32
+		if v&(threshold-1) < max {
+			count = v & (threshold - 1)
+			pos += nbBits - 1
+		} else {
+			count = v & (2*threshold - 1)
+			if count >= threshold {
+				count -= max
+			}
+			pos += nbBits
+		}
+		count-- // count is stored plus one
+		if count < 0 {
+			remaining += count
+		} else {
+			remaining -= count
+		}
+		if remaining < 1 {
+			return 0, 0, errCorrupt("invalid FSE table description")
+		}
+		norm[sym] = int16(count)
+		sym++
+		prev0 = count == 0
+		for remaining < threshold {
+			nbBits--
+			threshold >>= 1
+		}
+	}
+	for ; sym < len(norm); sym++ {
+		norm[sym] = 0
+	}
+	n = int(pos+7) / 8
+	if n > len(data) {
+		return 0, 0, errCorrupt("truncated FSE table description")
+	}
+	return accLog, n, nil
+}
+
+// spreadSymbols assigns the symbols of the normalized distribution norm
+// to the states of a table of size 1<<accLog, storing them in syms.
+func spreadSymbols(norm []int16, accLog uint32, syms []uint8) error {
+	size := 1 << accLog
+	high := size - 1
+	for s, c := range norm {
+		if c == -1 {
+			syms[high] = uint8(s)
+			high--
+		}
+	}
+	step := size>>1 + size>>3 + 3
+	mask := size - 1
+	pos := 0
+	for s, c := range norm {
+		for i := 0; i < int(c); i++ {
+			syms[pos] = uint8(s)
+			for {
+				pos = (pos + step) & mask
+				if pos <= high {
+					break
+				}
+			}
+		}
+	}
+	if pos != 0 {
+		return errCorrupt("invalid FSE distribution")
+	}
+	return nil
+}
+
+// build builds the decoding table for the normalized distribution norm
+// with the given accuracy log in storage, and makes t use it.
+func (t *fseTable) build(norm []int16, accLog uint32, storage []fseEntry) error {
+	size := 1 << accLog
+	var syms [1 << maxMatchLengthLog]uint8
+	if err := spreadSymbols(norm, accLog, syms[:size]); err != nil {
+		return err
+	}
+	var next [256]uint16
+	for s, c := range norm {
+		if c == -1 {
+			next[s] = 1
+		} else {
+			next[s] = uint16(c)
+		}
+	}
+	table := storage[:size]
+	for u := range table {
+		s := syms[u]
+		n := next[s]
+		next[s]++
+		nb := accLog - highBit(uint32(n))
+		table[u] = fseEntry{sym: s, bits: uint8(nb), base: uint16(uint32(n)<<nb - uint32(size))}
+	}
+	t.log = accLog
+	t.table = table
+	return nil
+}
+
+// setRLE makes t a table that decodes only sym, reading no bits.
+func (t *fseTable) setRLE(sym uint8, storage []fseEntry) {
+	storage[0] = fseEntry{sym: sym}
+	t.log = 0
+	t.table = storage[:1]
+}
+
+// Predefined distributions (RFC 8878, section 3.1.1.3.2.2).
+var (
+	predefLiteralsLengthNorm = [maxLiteralsLengthSymbol + 1]int16{
+		4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+		-1, -1, -1, -1,
+	}
+	predefMatchLengthNorm = [maxMatchLengthSymbol + 1]int16{
+		1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+		-1, -1, -1, -1, -1,
+	}
+	predefOffsetNorm = [29]int16{
+		1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+	}
+)
+
+const (
+	predefLiteralsLengthLog = 6
+	predefMatchLengthLog    = 6
+	predefOffsetLog         = 5
+)
+
+var predefLiteralsLength, predefMatchLength, predefOffset fseTable
+
+func init() {
+	var ll [1 << predefLiteralsLengthLog]fseEntry
+	var ml [1 << predefMatchLengthLog]fseEntry
+	var of [1 << predefOffsetLog]fseEntry
+	if predefLiteralsLength.build(predefLiteralsLengthNorm[:], predefLiteralsLengthLog, ll[:]) != nil ||
+		predefMatchLength.build(predefMatchLengthNorm[:], predefMatchLengthLog, ml[:]) != nil ||
+		predefOffset.build(predefOffsetNorm[:], predefOffsetLog, of[:]) != nil {
+		panic("zstd: invalid predefined distribution")
+	}
+}
+
+// Baselines and extra bits of the literals length and match length
+// codes (RFC 8878, section 3.1.1.3.2.1).
+var (
+	literalsLengthBase = [maxLiteralsLengthSymbol + 1]uint32{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 128, 256, 512, 1024, 2048, 4096,
+		8192, 16384, 32768, 65536,
+	}
+	literalsLengthBits = [maxLiteralsLengthSymbol + 1]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12,
+		13, 14, 15, 16,
+	}
+	matchLengthBase = [maxMatchLengthSymbol + 1]uint32{
+		3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+		19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+		35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 131, 259, 515, 1027, 2051,
+		4099, 8195, 16387, 32771, 65539,
+	}
+	matchLengthBits = [maxMatchLengthSymbol + 1]uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11,
+		12, 13, 14, 15, 16,
+	}
+)
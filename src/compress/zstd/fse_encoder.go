@@ -0,0 +1,238 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import "math"
+
+// An fseTransform tells how to encode a symbol from any state.
+type fseTransform struct {
+	deltaNbBits    uint32
+	deltaFindState int32
+}
+
+// An fseEncTable is an FSE encoding table.
+type fseEncTable struct {
+	log        uint32
+	stateTable [1 << maxMatchLengthLog]uint16
+	symTT      [256]fseTransform
+}
+
+// build builds the encoding table for the normalized distribution norm
+// with the given accuracy log. It mirrors fseTable.build, so that the
+// decoder retraces the states the encoder goes through.
+func (t *fseEncTable) build(norm []int16, accLog uint32) error {
+	size := uint32(1) << accLog
+	var syms [1 << maxMatchLengthLog]uint8
+	if err := spreadSymbols(norm, accLog, syms[:size]); err != nil {
+		return err
+	}
+	var cumul [257]uint32
+	for s, c := range norm {
+		if c == -1 {
+			c = 1
+		}
+		cumul[s+1] = cumul[s] + uint32(c)
+	}
+	for u := uint32(0); u < size; u++ {
+		s := syms[u]
+		t.stateTable[cumul[s]] = uint16(size + u)
+		cumul[s]++
+	}
+	total := int32(0)
+	for s, c := range norm {
+		switch c {
+		case 0:
+			t.symTT[s] = fseTransform{deltaNbBits: (accLog+1)<<16 - size}
+		case -1, 1:
+			t.symTT[s] = fseTransform{deltaNbBits: accLog<<16 - size, deltaFindState: total - 1}
+			total++
+		default:
+			maxBitsOut := accLog - highBit(uint32(c-1))
+			minStatePlus := uint32(c) << maxBitsOut
+			t.symTT[s] = fseTransform{deltaNbBits: maxBitsOut<<16 - minStatePlus, deltaFindState: total - int32(c)}
+			total += int32(c)
+		}
+	}
+	t.log = accLog
+	return nil
+}
+
+// An fseState is the state of an FSE encoder.
+type fseState struct {
+	t     *fseEncTable
+	state uint32
+}
+
+// init sets the initial state of s for encoding sym, which will be
+// the last symbol decoded.
+func (s *fseState) init(t *fseEncTable, sym uint8) {
+	s.t = t
+	tt := t.symTT[sym]
+	nbBitsOut := (tt.deltaNbBits + 1<<15) >> 16
+	value := nbBitsOut<<16 - tt.deltaNbBits
+	s.state = uint32(t.stateTable[int32(value>>nbBitsOut)+tt.deltaFindState])
+}
+
+// encode writes the bits for moving to the state encoding sym.
+func (s *fseState) encode(bw *bitWriter, sym uint8) {
+	tt := s.t.symTT[sym]
+	nbBitsOut := (s.state + tt.deltaNbBits) >> 16
+	bw.write(s.state, nbBitsOut)
+	s.state = uint32(s.t.stateTable[int32(s.state>>nbBitsOut)+tt.deltaFindState])
+}
+
+// flush writes the final state, which the decoder reads first.
+func (s *fseState) flush(bw *bitWriter) {
+	bw.write(s.state, s.t.log)
+}
+
+// optimalTableLog returns the accuracy log to use for coding total
+// symbols whose values do not exceed maxSym.
+func optimalTableLog(maxLog uint32, total int, maxSym int) uint32 {
+	log := int(maxLog)
+	if total > 1 {
+		if b := int(highBit(uint32(total-1))) - 2; b < log {
+			log = b
+		}
+	}
+	minBits := int(highBit(uint32(total))) + 1
+	if b := int(highBit(uint32(maxSym))) + 2; b < minBits {
+		minBits = b
+	}
+	if minBits > log {
+		log = minBits
+	}
+	if log < minFSELog {
+		log = minFSELog
+	}
+	if log > int(maxLog) {
+		log = int(maxLog)
+	}
+	return uint32(log)
+}
+
+// normalizeCounts scales counts, whose sum is total, to a distribution
+// over 1<<log states in norm, giving each symbol that occurs at least
+// one state.
+func normalizeCounts(norm []int16, counts []uint32, total int, log uint32) {
+	size := 1 << log
+	sum := 0
+	largest := 0
+	for s, c := range counts {
+		if c == 0 {
+			norm[s] = 0
+			continue
+		}
+		n := int(uint64(c) * uint64(size) / uint64(total))
+		if n == 0 {
+			n = 1
+		}
+		norm[s] = int16(n)
+		sum += n
+		if c > counts[largest] {
+			largest = s
+		}
+	}
+	if sum <= size {
+		norm[largest] += int16(size - sum)
+		return
+	}
+	// Rounding up the rare symbols overshot the table size;
+	// take the excess from the most probable symbols.
+	for ; sum > size; sum-- {
+		max := 0
+		for s := range counts {
+			if norm[s] > norm[max] {
+				max = s
+			}
+		}
+		norm[max]--
+	}
+}
+
+// tableCost estimates the number of bits needed to code symbols with
+// the given counts using the normalized distribution norm. It returns
+// math.MaxInt32 if norm does not cover some symbol.
+func tableCost(counts []uint32, norm []int16, log uint32) int {
+	var bits float64
+	for s, c := range counts {
+		if c == 0 {
+			continue
+		}
+		if s >= len(norm) || norm[s] == 0 {
+			return math.MaxInt32
+		}
+		n := norm[s]
+		if n < 0 {
+			n = 1
+		}
+		bits += float64(c) * (float64(log) - math.Log2(float64(n)))
+	}
+	return int(bits)
+}
+
+// writeNCount appends the description of the normalized distribution
+// norm (RFC 8878, section 4.1.1) to dst.
+func writeNCount(dst []byte, norm []int16, accLog uint32) []byte {
+	bw := bitWriter{out: dst}
+	bw.write(accLog-minFSELog, 4)
+
+	remaining := int32(1)<<accLog + 1
+	threshold := int32(1) << accLog
+	nbBits := accLog + 1
+	sym := 0
+	prev0 := false
+	for remaining > 1 {
+		if prev0 {
+			start := sym
+			for norm[sym] == 0 {
+				sym++
+			}
+			for sym >= start+24 {
+				start += 24
+				bw.write(0xffff, 16)
+			}
+			for sym >= start+3 {
+				start += 3
+				bw.write(3, 2)
+			}
+			bw.write(uint32(sym-start), 2)
+		}
+		count := int32(norm[sym])
+		sym++
+		max := 2*threshold - 1 - remaining
+		if count < 0 {
+			remaining += count
+		} else {
+			remaining -= count
+		}
+		count++ // count is stored plus one
+		if count >= threshold {
+			count += max
+		}
+		if count < max {
+			bw.write(uint32(count), nbBits-1)
+		} else {
+			bw.write(uint32(count), nbBits)
+		}
+		prev0 = count == 1
+		for remaining < threshold {
+			nbBits--
+			threshold >>= 1
+		}
+	}
+	return bw.flush()
+}
+
+// Encoding tables for the predefined distributions.
+var predefLiteralsLengthEnc, predefMatchLengthEnc, predefOffsetEnc fseEncTable
+
+func init() {
+	if predefLiteralsLengthEnc.build(predefLiteralsLengthNorm[:], predefLiteralsLengthLog) != nil ||
+		predefMatchLengthEnc.build(predefMatchLengthNorm[:], predefMatchLengthLog) != nil ||
+		predefOffsetEnc.build(predefOffsetNorm[:], predefOffsetLog) != nil {
+		panic("zstd: invalid predefined distribution")
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+// maxHuffmanBits is the maximum length of a Huffman code.
+const maxHuffmanBits = 11
+
+// A huffTable is a Huffman decoding table for literals. Each entry,
+// indexed by the next maxBits bits of the stream, holds the decoded
+// symbol in its high byte and the length of its code in its low byte.
+type huffTable struct {
+	maxBits uint32
+	table   [1 << maxHuffmanBits]uint16
+}
+
+// readHuffmanWeights reads a Huffman tree description (RFC 8878,
+// section 4.2.1) from the start of data into weights, returning the
+// number of symbols and the number of bytes read. The weight of the
+// last symbol, which is implied, is included.
+func readHuffmanWeights(data []byte, weights *[256]uint8) (nsym int, n int, err error) {
+	if len(data) == 0 {
+		return 0, 0, errCorrupt("missing Huffman tree description")
+	}
+	hdr := int(data[0])
+	if hdr < 128 {
+		// The weights are FSE compressed.
+		if 1+hdr > len(data) {
+			return 0, 0, errCorrupt("truncated Huffman tree description")
+		}
+		fdata := data[1 : 1+hdr]
+		var norm [maxHuffmanBits + 2]int16
+		accLog, nn, err := readNCount(fdata, norm[:], 6)
+		if err != nil {
+			return 0, 0, err
+		}
+		var storage [1 << 6]fseEntry
+		var t fseTable
+		if err := t.build(norm[:], accLog, storage[:]); err != nil {
+			return 0, 0, err
+		}
+		var br reverseBitReader
+		if !br.init(fdata[nn:]) {
+			return 0, 0, errCorrupt("invalid Huffman weights stream")
+		}
+		// Two states, sharing the table, decode alternate weights
+		// until the stream is exhausted.
+		s1 := br.read(accLog)
+		s2 := br.read(accLog)
+		for {
+			if nsym >= 255 {
+				return 0, 0, errCorrupt("too many Huffman weights")
+			}
+			e := t.table[s1]
+			weights[nsym] = e.sym
+			nsym++
+			s1 = uint32(e.base) + br.read(uint32(e.bits))
+			if br.overflow() {
+				weights[nsym] = t.table[s2].sym
+				nsym++
+				break
+			}
+			if nsym >= 255 {
+				return 0, 0, errCorrupt("too many Huffman weights")
+			}
+			e = t.table[s2]
+			weights[nsym] = e.sym
+			nsym++
+			s2 = uint32(e.base) + br.read(uint32(e.bits))
+			if br.overflow() {
+				weights[nsym] = t.table[s1].sym
+				nsym++
+				break
+			}
+		}
+		n = 1 + hdr
+	} else {
+		// The weights are stored directly, two per byte.
+		nsym = hdr - 127
+		n = 1 + (nsym+1)/2
+		if n > len(data) {
+			return 0, 0, errCorrupt("truncated Huffman tree description")
+		}
+		for i := 0; i < nsym; i++ {
+			b := data[1+i/2]
+			if i%2 == 0 {
+				weights[i] = b >> 4
+			} else {
+				weights[i] = b & 15
+			}
+		}
+	}
+
+	// Derive the weight of the last symbol, which completes the tree.
+	var total uint32
+	for _, w := range weights[:nsym] {
+		if w > maxHuffmanBits {
+			return 0, 0, errCorrupt("invalid Huffman weight")
+		}
+		if w > 0 {
+			total += 1 << (w - 1)
+		}
+	}
+	if total == 0 {
+		return 0, 0, errCorrupt("empty Huffman tree")
+	}
+	maxBits := highBit(total) + 1
+	if maxBits > maxHuffmanBits {
+		return 0, 0, errCorrupt("Huffman code too long")
+	}
+	rest := uint32(1)<<maxBits - total
+	if rest&(rest-1) != 0 {
+		return 0, 0, errCorrupt("incomplete Huffman tree")
+	}
+	weights[nsym] = uint8(highBit(rest) + 1)
+	nsym++
+	return nsym, n, nil
+}
+
+// read reads a Huffman tree description from the start of data
+// and builds the table for it, returning the number of bytes read.
+func (t *huffTable) read(data []byte) (int, error) {
+	var weights [256]uint8
+	nsym, n, err := readHuffmanWeights(data, &weights)
+	if err != nil {
+		return 0, err
+	}
+	var count [maxHuffmanBits + 1]uint32
+	for _, w := range weights[:nsym] {
+		count[w]++
+	}
+	var total uint32
+	for w := 1; w <= maxHuffmanBits; w++ {
+		total += count[w] << (w - 1)
+	}
+	maxBits := highBit(total)
+
+	// Codes are assigned in order of increasing weight,
+	// then of increasing symbol value.
+	var start [maxHuffmanBits + 1]uint32
+	next := uint32(0)
+	for w := uint32(1); w <= maxBits; w++ {
+		start[w] = next
+		next += count[w] << (w - 1)
+	}
+	for s, w := range weights[:nsym] {
+		if w == 0 {
+			continue
+		}
+		length := uint32(1) << (w - 1)
+		e := uint16(s)<<8 | uint16(maxBits+1-uint32(w))
+		p := start[w]
+		for i := p; i < p+length; i++ {
+			t.table[i] = e
+		}
+		start[w] = p + length
+	}
+	t.maxBits = maxBits
+	return n, nil
+}
+
+// decode decodes len(out) symbols from the single Huffman coded
+// stream data into out.
+func (t *huffTable) decode(data []byte, out []byte) error {
+	var br reverseBitReader
+	if !br.init(data) {
+		return errCorrupt("invalid Huffman stream")
+	}
+	maxBits := t.maxBits
+	for i := range out {
+		e := t.table[br.peek(maxBits)]
+		out[i] = byte(e >> 8)
+		br.skip(uint32(e & 0xff))
+	}
+	if !br.finished() {
+		return errCorrupt("Huffman stream size mismatch")
+	}
+	return nil
+}
+
+// decode4 decodes len(out) symbols from the four Huffman coded streams
+// in data, which starts with their jump table.
+func (t *huffTable) decode4(data []byte, out []byte) error {
+	if len(data) < 6 {
+		return errCorrupt("truncated Huffman jump table")
+	}
+	s1 := int(data[0]) | int(data[1])<<8
+	s2 := int(data[2]) | int(data[3])<<8
+	s3 := int(data[4]) | int(data[5])<<8
+	data = data[6:]
+	if s1+s2+s3 > len(data) {
+		return errCorrupt("invalid Huffman jump table")
+	}
+	seg := (len(out) + 3) / 4
+	if 3*seg > len(out) {
+		return errCorrupt("too few literals for four streams")
+	}
+	streams := [4][]byte{
+		data[:s1],
+		data[s1 : s1+s2],
+		data[s1+s2 : s1+s2+s3],
+		data[s1+s2+s3:],
+	}
+	for i, st := range streams {
+		o := out[i*seg:]
+		if i < 3 {
+			o = o[:seg]
+		}
+		if err := t.decode(st, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
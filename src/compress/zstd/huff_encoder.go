@@ -0,0 +1,246 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import "sort"
+
+// A huffEncoder holds a Huffman code for literals.
+type huffEncoder struct {
+	codes   [256]uint16
+	lens    [256]uint8
+	lastSym int // largest symbol with a code
+	maxBits uint32
+
+	nodes []pmNode
+	list  []int32
+	next  []int32
+}
+
+// A pmNode is an item in the package-merge algorithm: a leaf holding
+// a symbol, or a package of two items.
+type pmNode struct {
+	weight      uint64
+	left, right int32 // left is -1 for a leaf, whose symbol is right
+}
+
+// build builds a code for literals with the given counts, of which
+// at least two must be non-zero, limiting code lengths to
+// maxHuffmanBits with the package-merge algorithm.
+func (h *huffEncoder) build(counts *[256]uint32) {
+	h.nodes = h.nodes[:0]
+	for s, c := range counts {
+		h.lens[s] = 0
+		if c != 0 {
+			h.nodes = append(h.nodes, pmNode{weight: uint64(c), left: -1, right: int32(s)})
+			h.lastSym = s
+		}
+	}
+	n := len(h.nodes)
+	sort.Slice(h.nodes, func(i, j int) bool {
+		a, b := h.nodes[i], h.nodes[j]
+		return a.weight < b.weight || a.weight == b.weight && a.right < b.right
+	})
+
+	// Each round pairs up the items of the list into packages and
+	// merges them with the leaves. The 2n-2 lightest items of the
+	// final list hold each symbol as many times as its code length.
+	list := h.list[:0]
+	for i := 0; i < n; i++ {
+		list = append(list, int32(i))
+	}
+	for round := 1; round < maxHuffmanBits; round++ {
+		next := h.next[:0]
+		leaf := 0
+		for i := 0; i+1 < len(list); i += 2 {
+			p := pmNode{weight: h.nodes[list[i]].weight + h.nodes[list[i+1]].weight, left: list[i], right: list[i+1]}
+			for leaf < n && h.nodes[leaf].weight <= p.weight {
+				next = append(next, int32(leaf))
+				leaf++
+			}
+			h.nodes = append(h.nodes, p)
+			next = append(next, int32(len(h.nodes)-1))
+		}
+		for ; leaf < n; leaf++ {
+			next = append(next, int32(leaf))
+		}
+		list, h.next = next, list
+	}
+	h.list = list
+
+	stack := h.next[:0]
+	stack = append(stack, list[:2*n-2]...)
+	for len(stack) > 0 {
+		nd := h.nodes[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		if nd.left < 0 {
+			h.lens[nd.right]++
+		} else {
+			stack = append(stack, nd.left, nd.right)
+		}
+	}
+	h.next = stack
+
+	h.maxBits = 0
+	for _, l := range h.lens {
+		if uint32(l) > h.maxBits {
+			h.maxBits = uint32(l)
+		}
+	}
+
+	// Assign codes the way huffTable.read expects them: in order of
+	// increasing weight, that is decreasing length, then of symbol.
+	var count [maxHuffmanBits + 2]uint32
+	for _, l := range h.lens {
+		if l != 0 {
+			count[h.maxBits+1-uint32(l)]++
+		}
+	}
+	var start [maxHuffmanBits + 2]uint32
+	next := uint32(0)
+	for w := uint32(1); w <= h.maxBits; w++ {
+		start[w] = next
+		next += count[w] << (w - 1)
+	}
+	for s, l := range h.lens {
+		if l == 0 {
+			continue
+		}
+		w := h.maxBits + 1 - uint32(l)
+		h.codes[s] = uint16(start[w] >> (w - 1))
+		start[w] += 1 << (w - 1)
+	}
+}
+
+// weight returns the weight of symbol s in the tree description.
+func (h *huffEncoder) weight(s int) uint8 {
+	if h.lens[s] == 0 {
+		return 0
+	}
+	return uint8(h.maxBits + 1 - uint32(h.lens[s]))
+}
+
+// writeTree appends the tree description of the code to dst, returning
+// false if it cannot be described.
+func (h *huffEncoder) writeTree(dst []byte) ([]byte, bool) {
+	// The weight of the last symbol is implied.
+	var weights [256]uint8
+	nw := h.lastSym
+	for s := 0; s < nw; s++ {
+		weights[s] = h.weight(s)
+	}
+	if b, ok := writeWeightsFSE(dst, weights[:nw]); ok && (nw > 128 || len(b)-len(dst) < 1+(nw+1)/2) {
+		return b, true
+	}
+	if nw > 128 {
+		return dst, false
+	}
+	dst = append(dst, byte(127+nw))
+	for s := 0; s < nw; s += 2 {
+		dst = append(dst, weights[s]<<4|weights[s+1])
+	}
+	return dst, true
+}
+
+// writeWeightsFSE appends weights compressed with FSE to dst, returning
+// false if they cannot be or the result is too large.
+func writeWeightsFSE(dst []byte, weights []uint8) ([]byte, bool) {
+	if len(weights) < 2 {
+		return dst, false
+	}
+	var counts [maxHuffmanBits + 1]uint32
+	maxSym := 0
+	for _, w := range weights {
+		counts[w]++
+		if int(w) > maxSym {
+			maxSym = int(w)
+		}
+	}
+	for _, c := range counts {
+		if int(c) == len(weights) {
+			// A single weight, which FSE cannot code
+			// with two interleaved states.
+			return dst, false
+		}
+	}
+	log := optimalTableLog(6, len(weights), maxSym)
+	var norm [maxHuffmanBits + 1]int16
+	normalizeCounts(norm[:maxSym+1], counts[:maxSym+1], len(weights), log)
+	var t fseEncTable
+	if t.build(norm[:maxSym+1], log) != nil {
+		return dst, false
+	}
+
+	start := len(dst)
+	dst = append(dst, 0)
+	dst = writeNCount(dst, norm[:maxSym+1], log)
+
+	// Two states, sharing the table, code alternate weights.
+	// They are coded backward, so that the first state codes the
+	// first weight.
+	bw := bitWriter{out: dst}
+	var s1, s2 fseState
+	i := len(weights)
+	if i%2 == 1 {
+		s1.init(&t, weights[i-1])
+		s2.init(&t, weights[i-2])
+		s1.encode(&bw, weights[i-3])
+		i -= 3
+	} else {
+		s2.init(&t, weights[i-1])
+		s1.init(&t, weights[i-2])
+		i -= 2
+	}
+	for i > 0 {
+		s2.encode(&bw, weights[i-1])
+		s1.encode(&bw, weights[i-2])
+		i -= 2
+	}
+	s2.flush(&bw)
+	s1.flush(&bw)
+	dst = bw.close()
+
+	size := len(dst) - start - 1
+	if size >= 128 {
+		return dst[:start], false
+	}
+	dst[start] = byte(size)
+	return dst, true
+}
+
+// encode appends the literals lits coded as a single stream to dst.
+func (h *huffEncoder) encode(dst []byte, lits []byte) []byte {
+	bw := bitWriter{out: dst}
+	for i := len(lits) - 1; i >= 0; i-- {
+		c := lits[i]
+		bw.write(uint32(h.codes[c]), uint32(h.lens[c]))
+	}
+	return bw.close()
+}
+
+// encode4 appends the literals lits coded as four streams, preceded
+// by their jump table, to dst, returning false if a stream is too
+// large for the jump table.
+func (h *huffEncoder) encode4(dst []byte, lits []byte) ([]byte, bool) {
+	start := len(dst)
+	dst = append(dst, 0, 0, 0, 0, 0, 0)
+	seg := (len(lits) + 3) / 4
+	for i := 0; i < 4; i++ {
+		s := lits[i*seg:]
+		if i < 3 {
+			s = s[:seg]
+		}
+		n := len(dst)
+		dst = h.encode(dst, s)
+		if i < 3 {
+			size := len(dst) - n
+			if size > 0xffff {
+				return dst[:start], false
+			}
+			dst[start+2*i] = byte(size)
+			dst[start+2*i+1] = byte(size >> 8)
+		}
+	}
+	return dst, true
+}
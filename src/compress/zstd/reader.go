@@ -0,0 +1,437 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var (
+	errWindowTooLarge = errors.New("zstd: window size exceeds limit")
+	errClosed         = errors.New("zstd: reader is closed")
+)
+
+// noEOF converts io.EOF to io.ErrUnexpectedEOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// A frameHeader is a decoded frame header (RFC 8878, section 3.1.1.1).
+type frameHeader struct {
+	windowSize     uint64
+	contentSize    uint64
+	hasContentSize bool
+	singleSegment  bool
+	hasChecksum    bool
+	dictID         uint32
+}
+
+// readFrameHeader reads the remainder of a frame header, after the
+// magic number, from r. It returns the header and its size in bytes.
+func readFrameHeader(r io.Reader, buf []byte) (h frameHeader, n int, err error) {
+	if _, err = io.ReadFull(r, buf[:1]); err != nil {
+		return h, 0, noEOF(err)
+	}
+	desc := buf[0]
+	if desc&0x08 != 0 {
+		return h, 0, ErrHeader
+	}
+	fcsFlag := desc >> 6
+	h.singleSegment = desc&0x20 != 0
+	h.hasChecksum = desc&0x04 != 0
+	dictIDSize := [4]int{0, 1, 2, 4}[desc&3]
+	fcsSize := [4]int{0, 2, 4, 8}[fcsFlag]
+	if fcsFlag == 0 && h.singleSegment {
+		fcsSize = 1
+	}
+	size := dictIDSize + fcsSize
+	if !h.singleSegment {
+		size++
+	}
+	if _, err = io.ReadFull(r, buf[:size]); err != nil {
+		return h, 0, noEOF(err)
+	}
+	b := buf[:size]
+	if !h.singleSegment {
+		exp := uint(b[0] >> 3)
+		base := uint64(1) << (minWindowLog + exp)
+		h.windowSize = base + base/8*uint64(b[0]&7)
+		b = b[1:]
+	}
+	switch dictIDSize {
+	case 1:
+		h.dictID = uint32(b[0])
+	case 2:
+		h.dictID = uint32(binary.LittleEndian.Uint16(b))
+	case 4:
+		h.dictID = binary.LittleEndian.Uint32(b)
+	}
+	b = b[dictIDSize:]
+	switch fcsSize {
+	case 1:
+		h.contentSize = uint64(b[0])
+	case 2:
+		h.contentSize = uint64(binary.LittleEndian.Uint16(b)) + 256
+	case 4:
+		h.contentSize = uint64(binary.LittleEndian.Uint32(b))
+	case 8:
+		h.contentSize = binary.LittleEndian.Uint64(b)
+	}
+	h.hasContentSize = fcsSize > 0
+	if h.singleSegment {
+		h.windowSize = h.contentSize
+	}
+	return h, 1 + size, nil
+}
+
+// A Reader is an io.Reader that can be read to retrieve uncompressed
+// data from a Zstandard stream.
+//
+// A Zstandard stream can be a concatenation of frames. Reads from the
+// Reader return the concatenation of the uncompressed data of each,
+// skipping any skippable frames.
+//
+// Frames may carry a checksum of their uncompressed data. The Reader
+// returns ErrChecksum when Read reaches the end of a frame whose data
+// does not match its checksum. Clients should treat data returned by
+// Read as tentative until they receive the io.EOF marking the end of
+// the data.
+//
+// The Reader reads no more input than the frames it decodes hold, so
+// the underlying reader is left positioned just after the last frame.
+type Reader struct {
+	r           io.Reader
+	roffset     int64 // bytes read from r
+	err         error
+	multistream bool
+	dict        *dictionary
+	buf         [16]byte
+
+	// Frame state.
+	inFrame     bool
+	frame       frameHeader
+	blockMax    int
+	lastBlock   bool
+	frameOut    uint64 // bytes of the frame decoded before the current block
+	digest      xxhash64
+	dictLen     int // bytes of hist holding dictionary content
+	hist        []byte
+	pending     int // start of the decoded bytes in hist not yet returned
+	blockOffset int64
+
+	// Block state, some of which is carried from block to block.
+	block    []byte
+	literals []byte
+	reps     [3]uint32
+	huff     huffTable
+	haveHuff bool
+
+	literalsLength, offset, matchLength fseTable
+	llStorage, mlStorage                [1 << maxMatchLengthLog]fseEntry
+	ofStorage                           [1 << maxOffsetLog]fseEntry
+}
+
+// NewReader creates a new Reader reading the given reader.
+// No input is read until the first call to Read.
+//
+// It is the caller's responsibility to call Close on the Reader when done.
+func NewReader(r io.Reader) *Reader {
+	z := new(Reader)
+	z.Reset(r)
+	return z
+}
+
+// NewReaderDict is like NewReader but decompresses using the given
+// dictionary, which is either in the zstd dictionary format or holds
+// raw content. Frames naming a dictionary must name this one; frames
+// naming none are decoded with it.
+//
+// The Reader refers to dict, which must not be modified while the
+// Reader is in use.
+func NewReaderDict(r io.Reader, dict []byte) (*Reader, error) {
+	d, err := parseDictionary(dict)
+	if err != nil {
+		return nil, err
+	}
+	z := NewReader(r)
+	z.dict = d
+	return z, nil
+}
+
+// Reset discards the Reader z's state and makes it equivalent to the
+// result of its original state from NewReader or NewReaderDict, but
+// reading from r instead. This permits reusing a Reader rather than
+// allocating a new one.
+func (z *Reader) Reset(r io.Reader) {
+	z.r = r
+	z.roffset = 0
+	z.err = nil
+	z.multistream = true
+	z.inFrame = false
+	z.hist = z.hist[:0]
+	z.pending = 0
+}
+
+// Multistream controls whether the reader supports multiple frames.
+//
+// If enabled (the default), the Reader expects the input to be a
+// sequence of frames, and Read returns the concatenation of their
+// data.
+//
+// If disabled, Read returns io.EOF at the end of each frame. Since
+// the Reader leaves the underlying reader positioned just after the
+// frame, the caller can call Reset to read the next one, which lets
+// clients separate the data of the individual frames.
+func (z *Reader) Multistream(ok bool) {
+	z.multistream = ok
+}
+
+// Read implements io.Reader, reading uncompressed bytes from its
+// underlying reader.
+func (z *Reader) Read(p []byte) (n int, err error) {
+	for z.err == nil && z.pending == len(z.hist) {
+		if !z.inFrame {
+			z.err = z.readFrameStart()
+		} else if z.lastBlock {
+			z.err = z.finishFrame()
+		} else {
+			z.err = z.readBlock()
+		}
+	}
+	n = copy(p, z.hist[z.pending:])
+	z.pending += n
+	if n > 0 {
+		return n, nil
+	}
+	return 0, z.err
+}
+
+// Close closes the Reader, releasing its buffers. It does not close
+// the underlying io.Reader. In order for the frame checksums to be
+// verified, the reader must be fully consumed until the io.EOF.
+func (z *Reader) Close() error {
+	z.hist = nil
+	z.block = nil
+	z.literals = nil
+	z.inFrame = false
+	if z.err == nil {
+		z.err = errClosed
+	}
+	return nil
+}
+
+// read fills b from the underlying reader.
+func (z *Reader) read(b []byte) error {
+	n, err := io.ReadFull(z.r, b)
+	z.roffset += int64(n)
+	return err
+}
+
+// skip discards n bytes from the underlying reader.
+func (z *Reader) skip(n int64) error {
+	for n > 0 {
+		b := z.buf[:]
+		if n < int64(len(b)) {
+			b = b[:n]
+		}
+		if err := z.read(b); err != nil {
+			return err
+		}
+		n -= int64(len(b))
+	}
+	return nil
+}
+
+// readFrameStart reads frames up to and including the header of the
+// next frame holding data, and prepares to decode it.
+func (z *Reader) readFrameStart() error {
+	for {
+		start := z.roffset
+		if err := z.read(z.buf[:4]); err != nil {
+			if err == io.EOF && (start == 0 || z.multistream) {
+				// End of the stream, or an empty one.
+				return io.EOF
+			}
+			return noEOF(err)
+		}
+		magic := binary.LittleEndian.Uint32(z.buf[:4])
+		if magic&skippableMagicMask == skippableMagic {
+			if err := z.read(z.buf[:4]); err != nil {
+				return noEOF(err)
+			}
+			if err := z.skip(int64(binary.LittleEndian.Uint32(z.buf[:4]))); err != nil {
+				return noEOF(err)
+			}
+			continue
+		}
+		if magic != frameMagic {
+			return ErrHeader
+		}
+		h, n, err := readFrameHeader(z.r, z.buf[:])
+		z.roffset += int64(n)
+		if err != nil {
+			return err
+		}
+		if h.windowSize > maxWindowSize {
+			return errWindowTooLarge
+		}
+		return z.startFrame(h)
+	}
+}
+
+// startFrame prepares to decode the blocks of a frame with header h.
+func (z *Reader) startFrame(h frameHeader) error {
+	d := z.dict
+	if h.dictID != 0 && (d == nil || d.id != h.dictID) {
+		return ErrDictionary
+	}
+	z.inFrame = true
+	z.frame = h
+	z.blockMax = maxBlockSize
+	if h.windowSize < maxBlockSize {
+		z.blockMax = int(h.windowSize)
+	}
+	z.lastBlock = false
+	z.frameOut = 0
+	z.digest.reset()
+	z.hist = z.hist[:0]
+	z.reps = [3]uint32{1, 4, 8}
+	z.haveHuff = false
+	z.literalsLength = fseTable{}
+	z.offset = fseTable{}
+	z.matchLength = fseTable{}
+	if d != nil {
+		z.hist = append(z.hist, d.content...)
+		z.reps = d.reps
+		if d.huff != nil {
+			z.huff = *d.huff
+			z.haveHuff = true
+			z.literalsLength = *d.literalsLength
+			z.offset = *d.offset
+			z.matchLength = *d.matchLength
+		}
+	}
+	z.dictLen = len(z.hist)
+	z.pending = len(z.hist)
+	return nil
+}
+
+// finishFrame verifies the size and checksum of the frame just decoded.
+func (z *Reader) finishFrame() error {
+	z.inFrame = false
+	if z.frame.hasContentSize && z.frameOut != z.frame.contentSize {
+		return &CorruptInputError{z.blockOffset, "frame content size mismatch"}
+	}
+	if z.frame.hasChecksum {
+		if err := z.read(z.buf[:4]); err != nil {
+			return noEOF(err)
+		}
+		if binary.LittleEndian.Uint32(z.buf[:4]) != uint32(z.digest.sum64()) {
+			return ErrChecksum
+		}
+	}
+	if !z.multistream {
+		return io.EOF
+	}
+	return nil
+}
+
+// readBlock reads and decodes the next block of the current frame,
+// appending its data to z.hist.
+func (z *Reader) readBlock() error {
+	z.blockOffset = z.roffset
+	if err := z.read(z.buf[:3]); err != nil {
+		return noEOF(err)
+	}
+	hdr := uint32(z.buf[0]) | uint32(z.buf[1])<<8 | uint32(z.buf[2])<<16
+	z.lastBlock = hdr&1 != 0
+	typ := hdr >> 1 & 3
+	size := int(hdr >> 3)
+	if size > z.blockMax {
+		return &CorruptInputError{z.blockOffset, "block too large"}
+	}
+	z.makeRoom()
+	start := len(z.hist)
+	switch typ {
+	case blockRaw:
+		z.hist = z.hist[:start+size]
+		if err := z.read(z.hist[start:]); err != nil {
+			z.hist = z.hist[:start]
+			return noEOF(err)
+		}
+	case blockRLE:
+		if err := z.read(z.buf[:1]); err != nil {
+			return noEOF(err)
+		}
+		z.hist = z.hist[:start+size]
+		b := z.hist[start:]
+		for i := range b {
+			b[i] = z.buf[0]
+		}
+	case blockCompressed:
+		if cap(z.block) < size {
+			z.block = make([]byte, size, z.blockMax)
+		}
+		z.block = z.block[:size]
+		if err := z.read(z.block); err != nil {
+			return noEOF(err)
+		}
+		if err := z.decodeBlock(z.block); err != nil {
+			z.hist = z.hist[:start]
+			if e, ok := err.(errCorrupt); ok {
+				return &CorruptInputError{z.blockOffset, string(e)}
+			}
+			return err
+		}
+	default:
+		return &CorruptInputError{z.blockOffset, "reserved block type"}
+	}
+
+	out := z.hist[start:]
+	z.frameOut += uint64(len(out))
+	if z.frame.hasContentSize && z.frameOut > z.frame.contentSize {
+		return &CorruptInputError{z.blockOffset, "frame content size exceeded"}
+	}
+	if z.frame.hasChecksum {
+		z.digest.update(out)
+	}
+	return nil
+}
+
+// makeRoom makes room in z.hist for a block of decoded data, dropping
+// history the next block can no longer refer to. All the data in
+// z.hist has been returned to the reader.
+func (z *Reader) makeRoom() {
+	if len(z.hist)+z.blockMax <= cap(z.hist) {
+		return
+	}
+	keep := len(z.hist)
+	if z.frameOut > z.frame.windowSize {
+		// The dictionary is out of reach, as is anything
+		// farther back than the window.
+		keep = int(z.frame.windowSize)
+		z.dictLen = 0
+	}
+	if drop := len(z.hist) - keep; drop >= keep {
+		n := copy(z.hist, z.hist[drop:])
+		z.hist = z.hist[:n]
+		z.pending = n
+		if len(z.hist)+z.blockMax <= cap(z.hist) {
+			return
+		}
+	}
+	c := 2 * cap(z.hist)
+	if c < len(z.hist)+z.blockMax {
+		c = len(z.hist) + z.blockMax
+	}
+	h := make([]byte, len(z.hist), c)
+	copy(h, z.hist)
+	z.hist = h
+}
@@ -0,0 +1,226 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+// The compressed files in testdata were produced by the reference
+// implementation's command line tool:
+//
+//	zstd -19 Isaac.Newton-Opticks.txt
+//	zstd -3 e.txt
+//	zstd --train (1 KB pieces of Isaac.Newton-Opticks.txt) --maxdict=4096 -o opticks.dict
+//	zstd -3 -D opticks.dict gettysburg.txt
+var readerTests = []struct {
+	compressed, raw, dict string
+}{
+	{"testdata/Isaac.Newton-Opticks.txt.zst", "../../testdata/Isaac.Newton-Opticks.txt", ""},
+	{"testdata/e.txt.zst", "../testdata/e.txt", ""},
+	{"testdata/gettysburg.txt.dict.zst", "../testdata/gettysburg.txt", "testdata/opticks.dict"},
+}
+
+func mustReadFile(t testing.TB, name string) []byte {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestReader(t *testing.T) {
+	for _, tt := range readerTests {
+		compressed := mustReadFile(t, tt.compressed)
+		want := mustReadFile(t, tt.raw)
+		r := NewReader(bytes.NewReader(compressed))
+		if tt.dict != "" {
+			var err error
+			r, err = NewReaderDict(bytes.NewReader(compressed), mustReadFile(t, tt.dict))
+			if err != nil {
+				t.Fatalf("%s: NewReaderDict: %v", tt.compressed, err)
+			}
+		}
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("%s: %v", tt.compressed, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: decompressed %d bytes, which differ from the %d of %s", tt.compressed, len(got), len(want), tt.raw)
+		}
+		if err := r.Close(); err != nil {
+			t.Errorf("%s: Close: %v", tt.compressed, err)
+		}
+	}
+}
+
+func TestReaderEmpty(t *testing.T) {
+	n, err := NewReader(bytes.NewReader(nil)).Read(make([]byte, 10))
+	if n != 0 || err != io.EOF {
+		t.Errorf("Read = %d, %v; want 0, EOF", n, err)
+	}
+}
+
+// skippableFrame returns a skippable frame holding data.
+func skippableFrame(data string) []byte {
+	b := binary.LittleEndian.AppendUint32(nil, skippableMagic|7)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(data)))
+	return append(b, data...)
+}
+
+func compress(t testing.TB, data []byte) []byte {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReaderMultistream(t *testing.T) {
+	var in []byte
+	in = append(in, skippableFrame("metadata")...)
+	in = append(in, compress(t, []byte("hello, "))...)
+	in = append(in, skippableFrame("")...)
+	in = append(in, compress(t, []byte("world"))...)
+	in = append(in, compress(t, nil)...)
+
+	got, err := ioutil.ReadAll(NewReader(bytes.NewReader(in)))
+	if string(got) != "hello, world" || err != nil {
+		t.Fatalf("ReadAll = %q, %v; want %q, nil", got, err, "hello, world")
+	}
+
+	br := bytes.NewReader(in)
+	r := NewReader(br)
+	var frames []string
+	for {
+		r.Multistream(false)
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("frame %d: %v", len(frames), err)
+		}
+		frames = append(frames, string(b))
+		if br.Len() == 0 {
+			break
+		}
+		r.Reset(br)
+	}
+	if len(frames) != 3 || frames[0] != "hello, " || frames[1] != "world" || frames[2] != "" {
+		t.Errorf("frames = %q; want [\"hello, \" \"world\" \"\"]", frames)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	good := compress(t, []byte("hello, hello, hello, world"))
+	modify := func(f func(b []byte) []byte) []byte {
+		return f(append([]byte(nil), good...))
+	}
+	dict := mustReadFile(t, "testdata/opticks.dict")
+	dictFrame := mustReadFile(t, "testdata/gettysburg.txt.dict.zst")
+
+	tests := []struct {
+		desc string
+		in   []byte
+		dict []byte
+		want error
+	}{
+		{"bad magic", modify(func(b []byte) []byte { b[0]++; return b }), nil, ErrHeader},
+		{"reserved header bit", modify(func(b []byte) []byte { b[4] |= 0x08; return b }), nil, ErrHeader},
+		{"bad checksum", modify(func(b []byte) []byte { b[len(b)-1]++; return b }), nil, ErrChecksum},
+		{"truncated header", good[:5], nil, io.ErrUnexpectedEOF},
+		{"truncated block", good[:len(good)-6], nil, io.ErrUnexpectedEOF},
+		{"truncated checksum", good[:len(good)-2], nil, io.ErrUnexpectedEOF},
+		{"trailing garbage", append(append([]byte(nil), good...), 1, 2, 3, 4), nil, ErrHeader},
+		{"missing dictionary", dictFrame, nil, ErrDictionary},
+		{"wrong dictionary", dictFrame, append(append([]byte(nil), dict[:4]...), append([]byte{1, 2, 3, 4}, dict[8:]...)...), ErrDictionary},
+	}
+	for _, tt := range tests {
+		r := NewReader(bytes.NewReader(tt.in))
+		if tt.dict != nil {
+			var err error
+			if r, err = NewReaderDict(bytes.NewReader(tt.in), tt.dict); err != nil {
+				t.Fatalf("%s: NewReaderDict: %v", tt.desc, err)
+			}
+		}
+		if _, err := ioutil.ReadAll(r); err != tt.want {
+			t.Errorf("%s: got error %v; want %v", tt.desc, err, tt.want)
+		}
+	}
+}
+
+func TestReaderCorrupt(t *testing.T) {
+	// A frame with a single compressed block, whose literals section
+	// claims more literals than the block holds.
+	in := []byte{
+		0x28, 0xb5, 0x2f, 0xfd, // magic
+		0x20, 0x10, // single segment, content size 16
+		0x15, 0x00, 0x00, // last compressed block of 2 bytes
+		0x80, 0x00, // 16 raw literals, with none present
+	}
+	_, err := ioutil.ReadAll(NewReader(bytes.NewReader(in)))
+	if e, ok := err.(*CorruptInputError); !ok || e.Offset != 6 {
+		t.Errorf("got error %v; want *CorruptInputError at offset 6", err)
+	}
+
+	in = []byte{
+		0x28, 0xb5, 0x2f, 0xfd, // magic
+		0x20, 0x10, // single segment, content size 16
+		0x07, 0x00, 0x00, // last block of reserved type
+	}
+	_, err = ioutil.ReadAll(NewReader(bytes.NewReader(in)))
+	if _, ok := err.(*CorruptInputError); !ok {
+		t.Errorf("got error %v; want *CorruptInputError", err)
+	}
+}
+
+func TestReaderInvalidDictionary(t *testing.T) {
+	dict := mustReadFile(t, "testdata/opticks.dict")
+	if _, err := NewReaderDict(nil, dict[:20]); err == nil {
+		t.Error("NewReaderDict accepted a truncated dictionary")
+	}
+}
+
+func TestXXHash64(t *testing.T) {
+	tests := []struct {
+		in   string
+		want uint64
+	}{
+		{"", 0xef46db3751d8e999},
+		{"abc", 0x44bc2cf5ad770999},
+	}
+	for _, tt := range tests {
+		var h xxhash64
+		h.reset()
+		h.update([]byte(tt.in))
+		if got := h.sum64(); got != tt.want {
+			t.Errorf("xxhash64(%q) = %#x; want %#x", tt.in, got, tt.want)
+		}
+	}
+
+	// Hashing in pieces must not change the result.
+	data := mustReadFile(t, "../testdata/gettysburg.txt")
+	var whole, pieces xxhash64
+	whole.reset()
+	whole.update(data)
+	pieces.reset()
+	for i := 0; i < len(data); i += 7 {
+		end := i + 7
+		if end > len(data) {
+			end = len(data)
+		}
+		pieces.update(data[i:end])
+	}
+	if whole.sum64() != pieces.sum64() {
+		t.Errorf("xxhash64 of pieces = %#x; want %#x", pieces.sum64(), whole.sum64())
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	NoCompression      = 0
+	BestSpeed          = 1
+	BestCompression    = 9
+	DefaultCompression = -1
+
+	defaultLevel = 3
+
+	// defaultConcurrentFrameSize is the frame size used for concurrent
+	// compression when Writer.FrameSize is not set.
+	defaultConcurrentFrameSize = 4 << 20
+)
+
+var errWriterClosed = errors.New("zstd: write to closed Writer")
+
+// A Writer is an io.WriteCloser.
+// Writes to a Writer are compressed and written to w.
+//
+// By default the Writer produces a single frame, whose blocks refer
+// back to the data of the earlier ones. Setting FrameSize or
+// Concurrency makes it produce a sequence of independent frames
+// instead, which the Reader decodes as one stream.
+// All frames carry a content checksum.
+type Writer struct {
+	// FrameSize, if positive, makes the Writer end the current frame
+	// after every FrameSize bytes of input and start a new one.
+	// Frames of known size record it in their header.
+	FrameSize int
+
+	// Concurrency, if greater than one, is the number of frames the
+	// Writer compresses in parallel. Frames are FrameSize bytes, or
+	// 4 MB if FrameSize is not set, and are written in order.
+	Concurrency int
+
+	w      io.Writer
+	level  int
+	dict   *dictionary
+	err    error
+	closed bool
+
+	enc     *encoder
+	buf     []byte // data not yet compressed
+	out     []byte
+	started bool // single frame header written
+
+	// Independent frames state.
+	frames   int           // frames written or queued
+	queue    []chan []byte // frames being compressed in parallel, in order
+	encoders sync.Pool
+}
+
+// NewWriter returns a new Writer.
+// Writes to the returned writer are compressed and written to w.
+//
+// It is the caller's responsibility to call Close on the Writer when done.
+// Writes may be buffered and not flushed until Close.
+//
+// Callers that wish to set FrameSize or Concurrency must do so before
+// the first call to Write, Flush, or Close.
+func NewWriter(w io.Writer) *Writer {
+	z, _ := NewWriterLevel(w, DefaultCompression)
+	return z
+}
+
+// NewWriterLevel is like NewWriter but specifies the compression level
+// instead of assuming DefaultCompression.
+//
+// The compression level can be DefaultCompression, NoCompression, or any
+// integer value between BestSpeed and BestCompression inclusive.
+// The error returned will be nil if the level is valid.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	if level < DefaultCompression || level > BestCompression {
+		return nil, fmt.Errorf("zstd: invalid compression level: %d", level)
+	}
+	if level == DefaultCompression {
+		level = defaultLevel
+	}
+	z := &Writer{w: w, level: level}
+	z.encoders.New = z.newEncoder
+	return z, nil
+}
+
+// NewWriterDict is like NewWriterLevel but compresses using the given
+// dictionary, which is either in the zstd dictionary format or holds
+// raw content. The compressed data can only be decompressed by a
+// Reader using the same dictionary.
+//
+// The Writer refers to dict, which must not be modified while the
+// Writer is in use.
+func NewWriterDict(w io.Writer, level int, dict []byte) (*Writer, error) {
+	z, err := NewWriterLevel(w, level)
+	if err != nil {
+		return nil, err
+	}
+	if z.dict, err = parseDictionary(dict); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+func (z *Writer) newEncoder() interface{} {
+	return newEncoder(z.level, z.dict)
+}
+
+// Reset discards the Writer z's state and makes it equivalent to the
+// result of its original state from NewWriter, NewWriterLevel or
+// NewWriterDict, but writing to w instead. This permits reusing a
+// Writer rather than allocating a new one. FrameSize and Concurrency
+// are kept.
+func (z *Writer) Reset(w io.Writer) {
+	z.w = w
+	z.err = nil
+	z.closed = false
+	z.started = false
+	z.buf = z.buf[:0]
+	z.frames = 0
+	z.queue = nil
+}
+
+// frameSize returns the size of independent frames, or 0 for a single
+// frame.
+func (z *Writer) frameSize() int {
+	if z.FrameSize > 0 {
+		return z.FrameSize
+	}
+	if z.Concurrency > 1 {
+		return defaultConcurrentFrameSize
+	}
+	return 0
+}
+
+// Write writes a compressed form of p to the underlying io.Writer. The
+// compressed bytes are not necessarily flushed until the Writer is
+// closed.
+func (z *Writer) Write(p []byte) (int, error) {
+	if z.err != nil {
+		return 0, z.err
+	}
+	if z.closed {
+		return 0, errWriterClosed
+	}
+	size := z.frameSize()
+	if size == 0 {
+		size = maxBlockSize
+	}
+	n := len(p)
+	for len(p) > 0 {
+		if len(z.buf) == size {
+			// Data is compressed only once more follows, so that
+			// Close can tell the last block or frame apart.
+			if z.frameSize() == 0 {
+				z.writeBlock(false)
+			} else {
+				z.writeFrame()
+			}
+			if z.err != nil {
+				return 0, z.err
+			}
+		}
+		if z.buf == nil {
+			z.buf = make([]byte, 0, size)
+		}
+		c := size - len(z.buf)
+		if c > len(p) {
+			c = len(p)
+		}
+		z.buf = append(z.buf, p[:c]...)
+		p = p[c:]
+	}
+	return n, nil
+}
+
+// writeBlock compresses the buffered data as a block of the single frame.
+func (z *Writer) writeBlock(last bool) {
+	if z.enc == nil {
+		z.enc = z.newEncoder().(*encoder)
+	}
+	e := z.enc
+	z.out = z.out[:0]
+	if !z.started {
+		e.reset()
+		size := int64(-1)
+		if last {
+			size = int64(len(z.buf))
+		}
+		z.out = e.frameHeader(z.out, size)
+		z.started = true
+	}
+	z.out = e.encodeBlock(z.out, z.buf, last)
+	if last {
+		z.out = e.checksum(z.out)
+	}
+	z.buf = z.buf[:0]
+	_, z.err = z.w.Write(z.out)
+}
+
+// encodeFrame appends data compressed by e as a frame to dst.
+func encodeFrame(e *encoder, dst []byte, data []byte) []byte {
+	e.reset()
+	dst = e.frameHeader(dst, int64(len(data)))
+	for {
+		n := len(data)
+		if n > maxBlockSize {
+			n = maxBlockSize
+		}
+		dst = e.encodeBlock(dst, data[:n], n == len(data))
+		data = data[n:]
+		if len(data) == 0 {
+			break
+		}
+	}
+	return e.checksum(dst)
+}
+
+// writeFrame compresses the buffered data as an independent frame,
+// in parallel if Concurrency allows it.
+func (z *Writer) writeFrame() {
+	data := z.buf
+	z.buf = nil
+	z.frames++
+	if z.Concurrency <= 1 {
+		if z.enc == nil {
+			z.enc = z.newEncoder().(*encoder)
+		}
+		z.out = encodeFrame(z.enc, z.out[:0], data)
+		z.buf = data[:0]
+		_, z.err = z.w.Write(z.out)
+		return
+	}
+	if len(z.queue) >= z.Concurrency {
+		z.writeQueued()
+		if z.err != nil {
+			return
+		}
+	}
+	c := make(chan []byte, 1)
+	z.queue = append(z.queue, c)
+	go func() {
+		e := z.encoders.Get().(*encoder)
+		out := encodeFrame(e, nil, data)
+		z.encoders.Put(e)
+		c <- out
+	}()
+}
+
+// writeQueued writes the oldest frame being compressed in parallel.
+func (z *Writer) writeQueued() {
+	out := <-z.queue[0]
+	z.queue = z.queue[1:]
+	_, z.err = z.w.Write(out)
+}
+
+// Flush writes any pending data to the underlying writer. With a
+// single frame, the data is written as a block of the current frame;
+// otherwise, it ends the current frame, and waits for the frames
+// being compressed in parallel to be written.
+//
+// Flush is intended for protocols that need the receiver to see all
+// data written so far. Calling it often hurts compression.
+func (z *Writer) Flush() error {
+	if z.err != nil {
+		return z.err
+	}
+	if z.closed {
+		return nil
+	}
+	if z.frameSize() == 0 {
+		if len(z.buf) > 0 {
+			z.writeBlock(false)
+		}
+		return z.err
+	}
+	if len(z.buf) > 0 {
+		z.writeFrame()
+	}
+	for len(z.queue) > 0 && z.err == nil {
+		z.writeQueued()
+	}
+	return z.err
+}
+
+// Close closes the Writer by flushing any unwritten data to the
+// underlying io.Writer and ending the frame. It does not close the
+// underlying io.Writer.
+func (z *Writer) Close() error {
+	if z.err != nil {
+		return z.err
+	}
+	if z.closed {
+		return nil
+	}
+	z.closed = true
+	if z.frameSize() == 0 {
+		z.writeBlock(true)
+	} else {
+		if len(z.buf) > 0 || z.frames == 0 {
+			// An empty stream still gets a frame.
+			z.writeFrame()
+		}
+		for len(z.queue) > 0 && z.err == nil {
+			z.writeQueued()
+		}
+	}
+	return z.err
+}
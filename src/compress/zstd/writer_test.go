@@ -0,0 +1,288 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+)
+
+func testInputs(t testing.TB) map[string][]byte {
+	random := make([]byte, 300<<10)
+	rand.New(rand.NewSource(1)).Read(random)
+	return map[string][]byte{
+		"empty":      nil,
+		"one byte":   []byte("x"),
+		"zeros":      make([]byte, 200<<10),
+		"random":     random,
+		"gettysburg": mustReadFile(t, "../testdata/gettysburg.txt"),
+		"e":          mustReadFile(t, "../testdata/e.txt"),
+		"newton":     mustReadFile(t, "../../testdata/Isaac.Newton-Opticks.txt"),
+	}
+}
+
+// roundTrip compresses data with w, which writes to buf, writing it in
+// pieces, and checks that r, which reads from buf, decompresses it.
+// It returns the compressed size.
+func roundTrip(t *testing.T, desc string, data []byte, w *Writer, buf *bytes.Buffer, r *Reader) int {
+	t.Helper()
+	for p := data; len(p) > 0; {
+		n := 50000
+		if n > len(p) {
+			n = len(p)
+		}
+		if _, err := w.Write(p[:n]); err != nil {
+			t.Fatalf("%s: Write: %v", desc, err)
+		}
+		p = p[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("%s: Close: %v", desc, err)
+	}
+	size := buf.Len()
+	got, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("%s: ReadAll: %v", desc, err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("%s: round trip produced %d bytes, which differ from the %d written", desc, len(got), len(data))
+	}
+	return size
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	for name, data := range testInputs(t) {
+		for level := DefaultCompression; level <= BestCompression; level++ {
+			if testing.Short() && level > BestSpeed && level < BestCompression {
+				continue
+			}
+			var buf bytes.Buffer
+			w, err := NewWriterLevel(&buf, level)
+			if err != nil {
+				t.Fatal(err)
+			}
+			size := roundTrip(t, name, data, w, &buf, NewReader(&buf))
+			if level != NoCompression && len(data) > 1000 && name != "random" && size >= len(data) {
+				t.Errorf("%s, level %d: compressed %d bytes to %d", name, level, len(data), size)
+			}
+		}
+	}
+}
+
+func TestWriterDict(t *testing.T) {
+	data := mustReadFile(t, "../testdata/gettysburg.txt")
+	newton := mustReadFile(t, "../../testdata/Isaac.Newton-Opticks.txt")
+	dicts := map[string][]byte{
+		"zstd":        mustReadFile(t, "testdata/opticks.dict"),
+		"raw content": newton[:16<<10],
+	}
+	plain := len(compress(t, data))
+	for name, dict := range dicts {
+		for _, level := range []int{BestSpeed, DefaultCompression, BestCompression} {
+			var buf bytes.Buffer
+			w, err := NewWriterDict(&buf, level, dict)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r, err := NewReaderDict(&buf, dict)
+			if err != nil {
+				t.Fatal(err)
+			}
+			roundTrip(t, name, data, w, &buf, r)
+		}
+
+		// The dictionary should help with such a small input.
+		var buf bytes.Buffer
+		w, _ := NewWriterDict(&buf, DefaultCompression, dict)
+		w.Write(data)
+		w.Close()
+		if buf.Len() >= plain {
+			t.Errorf("%s dictionary: compressed to %d bytes; %d without it", name, buf.Len(), plain)
+		}
+
+		// Inputs larger than the window can refer to the dictionary
+		// only at their start.
+		buf.Reset()
+		w, _ = NewWriterDict(&buf, BestSpeed, dict)
+		r, _ := NewReaderDict(&buf, dict)
+		roundTrip(t, name+" large", newton, w, &buf, r)
+	}
+}
+
+func TestWriterFrameSize(t *testing.T) {
+	data := mustReadFile(t, "../../testdata/Isaac.Newton-Opticks.txt")
+	const frameSize = 100000
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.FrameSize = frameSize
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewReader(&buf)
+	var got []byte
+	for frames := 0; buf.Len() > 0; frames++ {
+		r.Reset(&buf)
+		r.Multistream(false)
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("frame %d: %v", frames, err)
+		}
+		want := frameSize
+		if rest := len(data) - len(got); rest < want {
+			want = rest
+		}
+		if len(b) != want {
+			t.Errorf("frame %d holds %d bytes; want %d", frames, len(b), want)
+		}
+		got = append(got, b...)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("frames hold %d bytes, which differ from the %d written", len(got), len(data))
+	}
+}
+
+func TestWriterConcurrency(t *testing.T) {
+	data := mustReadFile(t, "../../testdata/Isaac.Newton-Opticks.txt")
+	compress := func(concurrency int) []byte {
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		w.FrameSize = 64 << 10
+		w.Concurrency = concurrency
+		if _, err := w.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	// The frames are independent, so compressing them in parallel
+	// must not change the output.
+	want, got := compress(1), compress(4)
+	if !bytes.Equal(got, want) {
+		t.Errorf("compressing with Concurrency 4 gives %d bytes, which differ from the %d of Concurrency 1", len(got), len(want))
+	}
+	if b, err := ioutil.ReadAll(NewReader(bytes.NewReader(got))); err != nil || !bytes.Equal(b, data) {
+		t.Errorf("decompressing frames compressed in parallel: %d bytes, %v", len(b), err)
+	}
+
+	// Concurrency alone uses independent frames too.
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.Concurrency = 2
+	roundTrip(t, "default frame size", data, w, &buf, NewReader(&buf))
+}
+
+func TestWriterFlush(t *testing.T) {
+	data := mustReadFile(t, "../testdata/e.txt")
+	for _, frameSize := range []int{0, 1 << 20} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf)
+		w.FrameSize = frameSize
+		r := NewReader(&buf)
+		got := make([]byte, len(data))
+		for off := 0; off < len(data); off += 30000 {
+			end := off + 30000
+			if end > len(data) {
+				end = len(data)
+			}
+			w.Write(data[off:end])
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush: %v", err)
+			}
+			// The data written so far must be readable.
+			if _, err := io.ReadFull(r, got[off:end]); err != nil {
+				t.Fatalf("frame size %d: reading flushed data at %d: %v", frameSize, off, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if n, err := r.Read(got[:1]); n != 0 || err != io.EOF {
+			t.Errorf("frame size %d: Read after end = %d, %v; want 0, EOF", frameSize, n, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("frame size %d: flushed data differs", frameSize)
+		}
+	}
+}
+
+func TestWriterReset(t *testing.T) {
+	data := mustReadFile(t, "../testdata/gettysburg.txt")
+	var buf1, buf2 bytes.Buffer
+	w := NewWriter(&buf1)
+	w.Write(data)
+	w.Close()
+	w.Reset(&buf2)
+	w.Write(data)
+	w.Close()
+	if !bytes.Equal(buf1.Bytes(), buf2.Bytes()) {
+		t.Error("output after Reset differs")
+	}
+}
+
+func TestWriterClosed(t *testing.T) {
+	w := NewWriter(ioutil.Discard)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+	if _, err := w.Write([]byte("x")); err == nil {
+		t.Error("Write after Close succeeded")
+	}
+}
+
+func TestNewWriterLevel(t *testing.T) {
+	for _, level := range []int{-2, 10} {
+		if _, err := NewWriterLevel(ioutil.Discard, level); err == nil {
+			t.Errorf("NewWriterLevel accepted level %d", level)
+		}
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	data := mustReadFile(b, "../../testdata/Isaac.Newton-Opticks.txt")
+	for _, level := range []int{BestSpeed, DefaultCompression, BestCompression} {
+		w, _ := NewWriterLevel(ioutil.Discard, level)
+		b.Run(levelName(level), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				w.Reset(ioutil.Discard)
+				w.Write(data)
+				w.Close()
+			}
+		})
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	data := mustReadFile(b, "../../testdata/Isaac.Newton-Opticks.txt")
+	compressed := compress(b, data)
+	b.SetBytes(int64(len(data)))
+	r := NewReader(nil)
+	for i := 0; i < b.N; i++ {
+		r.Reset(bytes.NewReader(compressed))
+		io.Copy(ioutil.Discard, r)
+	}
+}
+
+func levelName(level int) string {
+	switch level {
+	case BestSpeed:
+		return "BestSpeed"
+	case BestCompression:
+		return "BestCompression"
+	}
+	return "Default"
+}
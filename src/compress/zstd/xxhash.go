@@ -0,0 +1,118 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package zstd
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// xxhash64 computes the 64-bit xxHash of a stream with seed 0,
+// which zstd uses for its content checksum.
+// See https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md.
+type xxhash64 struct {
+	v     [4]uint64
+	total uint64
+	buf   [32]byte
+	n     int // bytes in buf
+}
+
+const (
+	xxhPrime1 uint64 = 11400714785074694791
+	xxhPrime2 uint64 = 14029467366897019727
+	xxhPrime3 uint64 = 1609587929392839161
+	xxhPrime4 uint64 = 9650029242287828579
+	xxhPrime5 uint64 = 2870177450012600261
+)
+
+func (h *xxhash64) reset() {
+	p1, p2 := xxhPrime1, xxhPrime2 // variables, to let the sums wrap
+	h.v[0] = p1 + p2
+	h.v[1] = p2
+	h.v[2] = 0
+	h.v[3] = -p1
+	h.total = 0
+	h.n = 0
+}
+
+func xxhRound(acc, input uint64) uint64 {
+	acc += input * xxhPrime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxhPrime1
+}
+
+func xxhMerge(acc, val uint64) uint64 {
+	acc ^= xxhRound(0, val)
+	return acc*xxhPrime1 + xxhPrime4
+}
+
+func (h *xxhash64) update(b []byte) {
+	h.total += uint64(len(b))
+	if h.n > 0 {
+		c := copy(h.buf[h.n:], b)
+		h.n += c
+		b = b[c:]
+		if h.n < len(h.buf) {
+			return
+		}
+		h.stripes(h.buf[:])
+		h.n = 0
+	}
+	if len(b) >= 32 {
+		n := len(b) &^ 31
+		h.stripes(b[:n])
+		b = b[n:]
+	}
+	h.n = copy(h.buf[:], b)
+}
+
+// stripes consumes b, whose length is a multiple of 32.
+func (h *xxhash64) stripes(b []byte) {
+	v0, v1, v2, v3 := h.v[0], h.v[1], h.v[2], h.v[3]
+	for ; len(b) >= 32; b = b[32:] {
+		v0 = xxhRound(v0, binary.LittleEndian.Uint64(b[0:]))
+		v1 = xxhRound(v1, binary.LittleEndian.Uint64(b[8:]))
+		v2 = xxhRound(v2, binary.LittleEndian.Uint64(b[16:]))
+		v3 = xxhRound(v3, binary.LittleEndian.Uint64(b[24:]))
+	}
+	h.v[0], h.v[1], h.v[2], h.v[3] = v0, v1, v2, v3
+}
+
+func (h *xxhash64) sum64() uint64 {
+	var acc uint64
+	if h.total >= 32 {
+		acc = bits.RotateLeft64(h.v[0], 1) + bits.RotateLeft64(h.v[1], 7) +
+			bits.RotateLeft64(h.v[2], 12) + bits.RotateLeft64(h.v[3], 18)
+		acc = xxhMerge(acc, h.v[0])
+		acc = xxhMerge(acc, h.v[1])
+		acc = xxhMerge(acc, h.v[2])
+		acc = xxhMerge(acc, h.v[3])
+	} else {
+		acc = xxhPrime5
+	}
+	acc += h.total
+
+	b := h.buf[:h.n]
+	for ; len(b) >= 8; b = b[8:] {
+		acc ^= xxhRound(0, binary.LittleEndian.Uint64(b))
+		acc = bits.RotateLeft64(acc, 27)*xxhPrime1 + xxhPrime4
+	}
+	if len(b) >= 4 {
+		acc ^= uint64(binary.LittleEndian.Uint32(b)) * xxhPrime1
+		acc = bits.RotateLeft64(acc, 23)*xxhPrime2 + xxhPrime3
+		b = b[4:]
+	}
+	for _, c := range b {
+		acc ^= uint64(c) * xxhPrime5
+		acc = bits.RotateLeft64(acc, 11) * xxhPrime1
+	}
+
+	acc ^= acc >> 33
+	acc *= xxhPrime2
+	acc ^= acc >> 29
+	acc *= xxhPrime3
+	acc ^= acc >> 32
+	return acc
+}
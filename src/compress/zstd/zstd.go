@@ -0,0 +1,87 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package zstd implements reading and writing of Zstandard compressed
+// data, as specified in RFC 8878.
+//
+// A Zstandard stream is a sequence of frames, each holding compressed
+// data that decodes independently of the other frames, optionally
+// interleaved with skippable frames carrying application metadata.
+// Both the Reader and the Writer support dictionaries, as produced
+// by the reference implementation's dictionary trainer, as well as
+// raw content dictionaries.
+package zstd
+
+import (
+	"errors"
+	"strconv"
+)
+
+const (
+	frameMagic         = 0xFD2FB528
+	skippableMagic     = 0x184D2A50 // low 4 bits are user defined
+	skippableMagicMask = 0xFFFFFFF0
+
+	maxBlockSize = 128 << 10
+
+	// maxWindowSize is the largest window the Reader accepts. The
+	// format allows larger windows, but the reference implementation
+	// rejects them by default too, as decoding needs that much memory.
+	maxWindowSize = 1 << 27
+	minWindowLog  = 10
+)
+
+// Block types (RFC 8878, section 3.1.1.2.2).
+const (
+	blockRaw = iota
+	blockRLE
+	blockCompressed
+	blockReserved
+)
+
+// Literals section and sequence table modes (RFC 8878, sections 3.1.1.3.1.1
+// and 3.1.1.3.2.1).
+const (
+	literalsRaw = iota
+	literalsRLE
+	literalsCompressed
+	literalsTreeless
+)
+
+const (
+	modePredefined = iota
+	modeRLE
+	modeCompressed
+	modeRepeat
+)
+
+var (
+	// ErrChecksum is returned when reading Zstandard data whose content
+	// checksum does not match.
+	ErrChecksum = errors.New("zstd: invalid checksum")
+	// ErrHeader is returned when reading Zstandard data that has an
+	// invalid frame header.
+	ErrHeader = errors.New("zstd: invalid header")
+	// ErrDictionary is returned when reading a frame that was compressed
+	// with a dictionary other than the one given to the Reader, if any.
+	ErrDictionary = errors.New("zstd: frame requires a different dictionary")
+)
+
+// A CorruptInputError reports the presence of corrupt input within the
+// frame starting at a given offset.
+type CorruptInputError struct {
+	Offset int64  // offset of the block holding the corrupt data
+	Reason string // description of the problem
+}
+
+func (e *CorruptInputError) Error() string {
+	return "zstd: corrupt input in block at offset " + strconv.FormatInt(e.Offset, 10) + ": " + e.Reason
+}
+
+// errCorrupt is returned by the block decoding functions, which do not
+// track their position in the input; the Reader converts it into a
+// *CorruptInputError.
+type errCorrupt string
+
+func (e errCorrupt) Error() string { return "zstd: " + string(e) }
@@ -365,3 +365,15 @@ func TestZeroHashSignature(t *testing.T) {
 		}
 	}
 }
+
+type zr struct{}
+
+// Read replaces the contents of dst with zeros.
+func (zr) Read(dst []byte) (n int, err error) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	return len(dst), nil
+}
+
+var zeroReader = zr{}
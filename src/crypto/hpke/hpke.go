@@ -0,0 +1,441 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hpke implements Hybrid Public Key Encryption (HPKE) as specified in
+// RFC 9180.
+//
+// A sender sets up an encryption context for a recipient's public key with
+// one of the SetupSender functions, and sends the returned encapsulated key
+// to the recipient, which sets up the matching decryption context with the
+// corresponding SetupRecipient function. The four functions of each side
+// implement the four HPKE modes: base, psk (authenticated with a pre-shared
+// key), auth (authenticated with the sender's private key), and auth_psk.
+//
+// Algorithms are identified by their code points in the IANA HPKE registry.
+// Keys are passed around in their serialized form, as defined by RFC 9180,
+// Section 7.1.1: 32 bytes for X25519 public and private keys, a 65-byte
+// uncompressed point for P-256 public keys, and a 32-byte big-endian scalar
+// for P-256 private keys.
+package hpke
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// KEM identifiers from the IANA HPKE registry.
+const (
+	DHKEM_P256_HKDF_SHA256   uint16 = 0x0010
+	DHKEM_X25519_HKDF_SHA256 uint16 = 0x0020
+)
+
+// KDF identifiers from the IANA HPKE registry.
+const (
+	KDF_HKDF_SHA256 uint16 = 0x0001
+	KDF_HKDF_SHA384 uint16 = 0x0002
+	KDF_HKDF_SHA512 uint16 = 0x0003
+)
+
+// AEAD identifiers from the IANA HPKE registry.
+const (
+	AEAD_AES_128_GCM      uint16 = 0x0001
+	AEAD_AES_256_GCM      uint16 = 0x0002
+	AEAD_ChaCha20Poly1305 uint16 = 0x0003
+
+	// AEAD_ExportOnly is the identifier for contexts which can only be used
+	// to export secrets. Seal and Open return an error on such contexts.
+	AEAD_ExportOnly uint16 = 0xffff
+)
+
+// HPKE modes, from RFC 9180, Section 5.
+const (
+	modeBase    uint8 = 0x00
+	modePSK     uint8 = 0x01
+	modeAuth    uint8 = 0x02
+	modeAuthPSK uint8 = 0x03
+)
+
+var errUnsupported = errors.New("hpke: unsupported algorithm")
+
+// SupportedKEM reports whether the KEM with the given identifier is
+// implemented by this package.
+func SupportedKEM(id uint16) bool {
+	_, err := newDHKEM(id)
+	return err == nil
+}
+
+// SupportedKDF reports whether the KDF with the given identifier is
+// implemented by this package.
+func SupportedKDF(id uint16) bool {
+	return kdfHash(id) != nil
+}
+
+// SupportedAEAD reports whether the AEAD with the given identifier is
+// implemented by this package.
+func SupportedAEAD(id uint16) bool {
+	_, ok := aeadKeySizes[id]
+	return ok
+}
+
+func kdfHash(id uint16) func() hash.Hash {
+	switch id {
+	case KDF_HKDF_SHA256:
+		return sha256.New
+	case KDF_HKDF_SHA384:
+		return sha512.New384
+	case KDF_HKDF_SHA512:
+		return sha512.New
+	default:
+		return nil
+	}
+}
+
+var aeadKeySizes = map[uint16]int{
+	AEAD_AES_128_GCM:      16,
+	AEAD_AES_256_GCM:      32,
+	AEAD_ChaCha20Poly1305: chacha20poly1305.KeySize,
+	AEAD_ExportOnly:       0,
+}
+
+func newAEAD(id uint16, key []byte) (cipher.AEAD, error) {
+	switch id {
+	case AEAD_AES_128_GCM, AEAD_AES_256_GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	case AEAD_ChaCha20Poly1305:
+		return chacha20poly1305.New(key)
+	default:
+		return nil, errUnsupported
+	}
+}
+
+// GenerateKey generates a key pair for the KEM with the given identifier,
+// using entropy from rand, and returns the serialized keys.
+func GenerateKey(kemID uint16, rand io.Reader) (priv, pub []byte, err error) {
+	kem, err := newDHKEM(kemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kem.generateKeyPair(rand)
+}
+
+// DeriveKeyPair deterministically derives a key pair for the KEM with the
+// given identifier from the input keying material ikm, as specified in
+// RFC 9180, Section 7.1.3, and returns the serialized keys. ikm must be at
+// least as long as a private key, and should have as much entropy.
+func DeriveKeyPair(kemID uint16, ikm []byte) (priv, pub []byte, err error) {
+	kem, err := newDHKEM(kemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kem.deriveKeyPair(ikm)
+}
+
+// i2osp2 returns the big-endian, two-byte encoding of v.
+func i2osp2(v uint16) []byte {
+	return []byte{byte(v >> 8), byte(v)}
+}
+
+// labeledKDF implements LabeledExtract and LabeledExpand from RFC 9180,
+// Section 4, over HKDF with a fixed hash and suite_id.
+type labeledKDF struct {
+	hash    func() hash.Hash
+	suiteID []byte
+}
+
+func (k labeledKDF) labeledExtract(salt []byte, label string, ikm []byte) []byte {
+	labeledIKM := make([]byte, 0, 7+len(k.suiteID)+len(label)+len(ikm))
+	labeledIKM = append(labeledIKM, "HPKE-v1"...)
+	labeledIKM = append(labeledIKM, k.suiteID...)
+	labeledIKM = append(labeledIKM, label...)
+	labeledIKM = append(labeledIKM, ikm...)
+	return hkdf.Extract(k.hash, labeledIKM, salt)
+}
+
+func (k labeledKDF) labeledExpand(prk []byte, label string, info []byte, length uint16) []byte {
+	labeledInfo := make([]byte, 0, 2+7+len(k.suiteID)+len(label)+len(info))
+	labeledInfo = append(labeledInfo, i2osp2(length)...)
+	labeledInfo = append(labeledInfo, "HPKE-v1"...)
+	labeledInfo = append(labeledInfo, k.suiteID...)
+	labeledInfo = append(labeledInfo, label...)
+	labeledInfo = append(labeledInfo, info...)
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.Expand(k.hash, prk, labeledInfo), out); err != nil {
+		panic("hpke: internal error: " + err.Error())
+	}
+	return out
+}
+
+type context struct {
+	kdf            labeledKDF
+	exporterSecret []byte
+
+	// aead is nil for AEAD_ExportOnly contexts.
+	aead      cipher.AEAD
+	baseNonce []byte
+	seqNum    uint64
+}
+
+// Sender is the sending side of an HPKE context.
+type Sender struct {
+	*context
+}
+
+// Recipient is the receiving side of an HPKE context.
+type Recipient struct {
+	*context
+}
+
+// verifyPSKInputs implements VerifyPSKInputs from RFC 9180, Section 5.1.
+func verifyPSKInputs(mode uint8, psk, pskID []byte) error {
+	gotPSK, gotPSKID := len(psk) != 0, len(pskID) != 0
+	if gotPSK != gotPSKID {
+		return errors.New("hpke: inconsistent PSK inputs")
+	}
+	if gotPSK && (mode == modeBase || mode == modeAuth) {
+		return errors.New("hpke: PSK input provided when not needed")
+	}
+	if !gotPSK && (mode == modePSK || mode == modeAuthPSK) {
+		return errors.New("hpke: missing required PSK input")
+	}
+	return nil
+}
+
+// newContext implements KeySchedule from RFC 9180, Section 5.1.
+func newContext(mode uint8, sharedSecret []byte, kemID, kdfID, aeadID uint16, info, psk, pskID []byte) (*context, error) {
+	h := kdfHash(kdfID)
+	if h == nil || !SupportedAEAD(aeadID) {
+		return nil, errUnsupported
+	}
+	if err := verifyPSKInputs(mode, psk, pskID); err != nil {
+		return nil, err
+	}
+	suiteID := []byte("HPKE")
+	suiteID = append(suiteID, i2osp2(kemID)...)
+	suiteID = append(suiteID, i2osp2(kdfID)...)
+	suiteID = append(suiteID, i2osp2(aeadID)...)
+	kdf := labeledKDF{h, suiteID}
+
+	pskIDHash := kdf.labeledExtract(nil, "psk_id_hash", pskID)
+	infoHash := kdf.labeledExtract(nil, "info_hash", info)
+	ksContext := append([]byte{mode}, pskIDHash...)
+	ksContext = append(ksContext, infoHash...)
+
+	secret := kdf.labeledExtract(sharedSecret, "secret", psk)
+
+	ctx := &context{
+		kdf:            kdf,
+		exporterSecret: kdf.labeledExpand(secret, "exp", ksContext, uint16(h().Size())),
+	}
+	if aeadID == AEAD_ExportOnly {
+		return ctx, nil
+	}
+
+	key := kdf.labeledExpand(secret, "key", ksContext, uint16(aeadKeySizes[aeadID]))
+	aead, err := newAEAD(aeadID, key)
+	if err != nil {
+		return nil, err
+	}
+	ctx.aead = aead
+	ctx.baseNonce = kdf.labeledExpand(secret, "base_nonce", ksContext, uint16(aead.NonceSize()))
+	return ctx, nil
+}
+
+func setupSender(mode uint8, kemID, kdfID, aeadID uint16, pub, info, psk, pskID, privS []byte, rand io.Reader) ([]byte, *Sender, error) {
+	kem, err := newDHKEM(kemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Check the PSK inputs before generating the ephemeral key, to avoid
+	// doing any work for a context that will be rejected.
+	if err := verifyPSKInputs(mode, psk, pskID); err != nil {
+		return nil, nil, err
+	}
+	sharedSecret, enc, err := kem.encap(rand, pub, privS)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, err := newContext(mode, sharedSecret, kemID, kdfID, aeadID, info, psk, pskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, &Sender{ctx}, nil
+}
+
+func setupRecipient(mode uint8, kemID, kdfID, aeadID uint16, priv, info, enc, psk, pskID, pubS []byte) (*Recipient, error) {
+	kem, err := newDHKEM(kemID)
+	if err != nil {
+		return nil, err
+	}
+	sharedSecret, err := kem.decap(enc, priv, pubS)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := newContext(mode, sharedSecret, kemID, kdfID, aeadID, info, psk, pskID)
+	if err != nil {
+		return nil, err
+	}
+	return &Recipient{ctx}, nil
+}
+
+// SetupSender generates an ephemeral key pair for the recipient public key
+// pub and returns the encapsulated key to send to the recipient, along with
+// the sending context. It implements SetupBaseS from RFC 9180, Section 5.1.1.
+func SetupSender(kemID, kdfID, aeadID uint16, pub, info []byte, rand io.Reader) ([]byte, *Sender, error) {
+	return setupSender(modeBase, kemID, kdfID, aeadID, pub, info, nil, nil, nil, rand)
+}
+
+// SetupSenderPSK is like SetupSender, but additionally authenticates the
+// sender as a holder of the pre-shared key psk, identified by pskID. It
+// implements SetupPSKS from RFC 9180, Section 5.1.2.
+func SetupSenderPSK(kemID, kdfID, aeadID uint16, pub, info, psk, pskID []byte, rand io.Reader) ([]byte, *Sender, error) {
+	return setupSender(modePSK, kemID, kdfID, aeadID, pub, info, psk, pskID, nil, rand)
+}
+
+// SetupSenderAuth is like SetupSender, but additionally authenticates the
+// sender as a holder of the private key priv. It implements SetupAuthS from
+// RFC 9180, Section 5.1.3.
+func SetupSenderAuth(kemID, kdfID, aeadID uint16, pub, info, priv []byte, rand io.Reader) ([]byte, *Sender, error) {
+	if priv == nil {
+		return nil, nil, errors.New("hpke: missing sender private key")
+	}
+	return setupSender(modeAuth, kemID, kdfID, aeadID, pub, info, nil, nil, priv, rand)
+}
+
+// SetupSenderAuthPSK combines SetupSenderPSK and SetupSenderAuth. It
+// implements SetupAuthPSKS from RFC 9180, Section 5.1.4.
+func SetupSenderAuthPSK(kemID, kdfID, aeadID uint16, pub, info, psk, pskID, priv []byte, rand io.Reader) ([]byte, *Sender, error) {
+	if priv == nil {
+		return nil, nil, errors.New("hpke: missing sender private key")
+	}
+	return setupSender(modeAuthPSK, kemID, kdfID, aeadID, pub, info, psk, pskID, priv, rand)
+}
+
+// SetupRecipient decapsulates enc with the private key priv and returns the
+// receiving context. It implements SetupBaseR from RFC 9180, Section 5.1.1.
+func SetupRecipient(kemID, kdfID, aeadID uint16, priv, info, enc []byte) (*Recipient, error) {
+	return setupRecipient(modeBase, kemID, kdfID, aeadID, priv, info, enc, nil, nil, nil)
+}
+
+// SetupRecipientPSK is like SetupRecipient, but additionally checks that the
+// sender holds the pre-shared key psk, identified by pskID. It implements
+// SetupPSKR from RFC 9180, Section 5.1.2.
+func SetupRecipientPSK(kemID, kdfID, aeadID uint16, priv, info, enc, psk, pskID []byte) (*Recipient, error) {
+	return setupRecipient(modePSK, kemID, kdfID, aeadID, priv, info, enc, psk, pskID, nil)
+}
+
+// SetupRecipientAuth is like SetupRecipient, but additionally checks that the
+// sender holds the private key corresponding to the public key pub. It
+// implements SetupAuthR from RFC 9180, Section 5.1.3.
+func SetupRecipientAuth(kemID, kdfID, aeadID uint16, priv, info, enc, pub []byte) (*Recipient, error) {
+	if pub == nil {
+		return nil, errors.New("hpke: missing sender public key")
+	}
+	return setupRecipient(modeAuth, kemID, kdfID, aeadID, priv, info, enc, nil, nil, pub)
+}
+
+// SetupRecipientAuthPSK combines SetupRecipientPSK and SetupRecipientAuth.
+// It implements SetupAuthPSKR from RFC 9180, Section 5.1.4.
+func SetupRecipientAuthPSK(kemID, kdfID, aeadID uint16, priv, info, enc, psk, pskID, pub []byte) (*Recipient, error) {
+	if pub == nil {
+		return nil, errors.New("hpke: missing sender public key")
+	}
+	return setupRecipient(modeAuthPSK, kemID, kdfID, aeadID, priv, info, enc, psk, pskID, pub)
+}
+
+var errExportOnly = errors.New("hpke: context is export-only")
+
+// nonce returns the nonce for the current sequence number. See RFC 9180,
+// Section 5.2.
+func (ctx *context) nonce() []byte {
+	nonce := make([]byte, len(ctx.baseNonce))
+	copy(nonce, ctx.baseNonce)
+	for i := 0; i < 8; i++ {
+		nonce[len(nonce)-1-i] ^= byte(ctx.seqNum >> (8 * uint(i)))
+	}
+	return nonce
+}
+
+func (ctx *context) incrementNonce() error {
+	if ctx.seqNum == ^uint64(0) {
+		return errors.New("hpke: message limit reached")
+	}
+	ctx.seqNum++
+	return nil
+}
+
+// Seal encrypts and authenticates plaintext, authenticates aad, and returns
+// the ciphertext. Each call uses the next nonce in the sequence.
+func (s *Sender) Seal(aad, plaintext []byte) ([]byte, error) {
+	if s.aead == nil {
+		return nil, errExportOnly
+	}
+	ciphertext := s.aead.Seal(nil, s.nonce(), plaintext, aad)
+	if err := s.incrementNonce(); err != nil {
+		return nil, err
+	}
+	return ciphertext, nil
+}
+
+// Open decrypts and authenticates ciphertext and aad. Each successful call
+// advances to the next nonce in the sequence.
+func (r *Recipient) Open(aad, ciphertext []byte) ([]byte, error) {
+	if r.aead == nil {
+		return nil, errExportOnly
+	}
+	plaintext, err := r.aead.Open(nil, r.nonce(), ciphertext, aad)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.incrementNonce(); err != nil {
+		return nil, err
+	}
+	return plaintext, nil
+}
+
+func (ctx *context) overhead() int {
+	if ctx.aead == nil {
+		return 0
+	}
+	return ctx.aead.Overhead()
+}
+
+// Overhead returns the difference in length between a ciphertext and its
+// plaintext. It returns zero for export-only contexts.
+func (s *Sender) Overhead() int { return s.overhead() }
+
+// Overhead returns the difference in length between a ciphertext and its
+// plaintext. It returns zero for export-only contexts.
+func (r *Recipient) Overhead() int { return r.overhead() }
+
+func (ctx *context) export(exporterContext []byte, length int) ([]byte, error) {
+	if length < 0 || length > 255*ctx.kdf.hash().Size() {
+		return nil, errors.New("hpke: invalid export length")
+	}
+	return ctx.kdf.labeledExpand(ctx.exporterSecret, "sec", exporterContext, uint16(length)), nil
+}
+
+// Export returns a secret of the given length derived from the context and
+// exporterContext. The sender and recipient of the same context derive the
+// same secrets. It implements the secret export interface from RFC 9180,
+// Section 5.3.
+func (s *Sender) Export(exporterContext []byte, length int) ([]byte, error) {
+	return s.export(exporterContext, length)
+}
+
+// Export is like Sender.Export, for the receiving side of the context.
+func (r *Recipient) Export(exporterContext []byte, length int) ([]byte, error) {
+	return r.export(exporterContext, length)
+}
@@ -0,0 +1,336 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpke
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// TestVectors checks the base mode test vectors for the RFC 9180, Appendix A
+// inputs, across all supported combinations of KEM, KDF and AEAD.
+func TestVectors(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vectors []struct {
+		Mode        uint8  `json:"mode"`
+		KEM         uint16 `json:"kem_id"`
+		KDF         uint16 `json:"kdf_id"`
+		AEAD        uint16 `json:"aead_id"`
+		Info        string `json:"info"`
+		IkmE        string `json:"ikmE"`
+		IkmR        string `json:"ikmR"`
+		SkRm        string `json:"skRm"`
+		PkRm        string `json:"pkRm"`
+		Enc         string `json:"enc"`
+		Encryptions []struct {
+			Aad string `json:"aad"`
+			Ct  string `json:"ct"`
+			Pt  string `json:"pt"`
+		} `json:"encryptions"`
+		Exports []struct {
+			Context string `json:"exporter_context"`
+			L       int    `json:"L"`
+			Value   string `json:"exported_value"`
+		} `json:"exports"`
+	}
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range vectors {
+		t.Run(fmt.Sprintf("%04x-%04x-%04x", v.KEM, v.KDF, v.AEAD), func(t *testing.T) {
+			if v.Mode != modeBase {
+				t.Fatalf("unexpected mode %d", v.Mode)
+			}
+			info := mustDecodeHex(t, v.Info)
+			skRm, pkRm, err := DeriveKeyPair(v.KEM, mustDecodeHex(t, v.IkmR))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(skRm); got != v.SkRm {
+				t.Errorf("skRm = %s, want %s", got, v.SkRm)
+			}
+			if got := hex.EncodeToString(pkRm); got != v.PkRm {
+				t.Errorf("pkRm = %s, want %s", got, v.PkRm)
+			}
+
+			// The ephemeral key is derived from ikmE, read from rand.
+			enc, sender, err := SetupSender(v.KEM, v.KDF, v.AEAD, pkRm, info, bytes.NewReader(mustDecodeHex(t, v.IkmE)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(enc); got != v.Enc {
+				t.Errorf("enc = %s, want %s", got, v.Enc)
+			}
+			recipient, err := SetupRecipient(v.KEM, v.KDF, v.AEAD, skRm, info, enc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var seq uint64
+			for _, e := range v.Encryptions {
+				aad, pt, ct := mustDecodeHex(t, e.Aad), mustDecodeHex(t, e.Pt), mustDecodeHex(t, e.Ct)
+				// Skip to the sequence number of this encryption.
+				var want uint64
+				fmt.Sscanf(string(aad), "Count-%d", &want)
+				for ; seq < want; seq++ {
+					sender.seqNum++
+					recipient.seqNum++
+				}
+				got, err := sender.Seal(aad, pt)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(got, ct) {
+					t.Errorf("seq %d: ciphertext = %x, want %x", seq, got, ct)
+				}
+				got, err = recipient.Open(aad, ct)
+				if err != nil {
+					t.Fatalf("seq %d: %v", seq, err)
+				}
+				if !bytes.Equal(got, pt) {
+					t.Errorf("seq %d: plaintext = %x, want %x", seq, got, pt)
+				}
+				seq++
+			}
+			if v.AEAD == AEAD_ExportOnly {
+				if _, err := sender.Seal(nil, nil); err == nil {
+					t.Error("Seal succeeded on an export-only context")
+				}
+				if _, err := recipient.Open(nil, nil); err == nil {
+					t.Error("Open succeeded on an export-only context")
+				}
+			}
+
+			for _, e := range v.Exports {
+				exporterContext := mustDecodeHex(t, e.Context)
+				for _, export := range []func([]byte, int) ([]byte, error){sender.Export, recipient.Export} {
+					got, err := export(exporterContext, e.L)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if hex.EncodeToString(got) != e.Value {
+						t.Errorf("Export(%x, %d) = %x, want %s", exporterContext, e.L, got, e.Value)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestModes(t *testing.T) {
+	for _, kemID := range []uint16{DHKEM_P256_HKDF_SHA256, DHKEM_X25519_HKDF_SHA256} {
+		t.Run(fmt.Sprintf("%04x", kemID), func(t *testing.T) {
+			testModes(t, kemID)
+		})
+	}
+}
+
+func testModes(t *testing.T, kemID uint16) {
+	const kdfID, aeadID = KDF_HKDF_SHA384, AEAD_ChaCha20Poly1305
+	info := []byte("info")
+	psk, pskID := []byte("0123456789abcdef0123456789abcdef"), []byte("psk id")
+
+	privR, pubR, err := GenerateKey(kemID, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privS, pubS, err := GenerateKey(kemID, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherPub, err := GenerateKey(kemID, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type setup struct {
+		name      string
+		sender    func() ([]byte, *Sender, error)
+		recipient func(enc []byte) (*Recipient, error)
+		// badRecipient sets up a recipient with the wrong credentials.
+		badRecipient func(enc []byte) (*Recipient, error)
+	}
+	setups := []setup{
+		{
+			"base",
+			func() ([]byte, *Sender, error) {
+				return SetupSender(kemID, kdfID, aeadID, pubR, info, rand.Reader)
+			},
+			func(enc []byte) (*Recipient, error) {
+				return SetupRecipient(kemID, kdfID, aeadID, privR, info, enc)
+			},
+			func(enc []byte) (*Recipient, error) {
+				return SetupRecipient(kemID, kdfID, aeadID, privR, []byte("other info"), enc)
+			},
+		},
+		{
+			"psk",
+			func() ([]byte, *Sender, error) {
+				return SetupSenderPSK(kemID, kdfID, aeadID, pubR, info, psk, pskID, rand.Reader)
+			},
+			func(enc []byte) (*Recipient, error) {
+				return SetupRecipientPSK(kemID, kdfID, aeadID, privR, info, enc, psk, pskID)
+			},
+			func(enc []byte) (*Recipient, error) {
+				return SetupRecipientPSK(kemID, kdfID, aeadID, privR, info, enc, []byte("wrong psk"), pskID)
+			},
+		},
+		{
+			"auth",
+			func() ([]byte, *Sender, error) {
+				return SetupSenderAuth(kemID, kdfID, aeadID, pubR, info, privS, rand.Reader)
+			},
+			func(enc []byte) (*Recipient, error) {
+				return SetupRecipientAuth(kemID, kdfID, aeadID, privR, info, enc, pubS)
+			},
+			func(enc []byte) (*Recipient, error) {
+				return SetupRecipientAuth(kemID, kdfID, aeadID, privR, info, enc, otherPub)
+			},
+		},
+		{
+			"auth_psk",
+			func() ([]byte, *Sender, error) {
+				return SetupSenderAuthPSK(kemID, kdfID, aeadID, pubR, info, psk, pskID, privS, rand.Reader)
+			},
+			func(enc []byte) (*Recipient, error) {
+				return SetupRecipientAuthPSK(kemID, kdfID, aeadID, privR, info, enc, psk, pskID, pubS)
+			},
+			func(enc []byte) (*Recipient, error) {
+				return SetupRecipientAuthPSK(kemID, kdfID, aeadID, privR, info, enc, psk, []byte("wrong id"), pubS)
+			},
+		},
+	}
+
+	exports := make(map[string]bool)
+	for _, s := range setups {
+		enc, sender, err := s.sender()
+		if err != nil {
+			t.Fatalf("%s: %v", s.name, err)
+		}
+		recipient, err := s.recipient(enc)
+		if err != nil {
+			t.Fatalf("%s: %v", s.name, err)
+		}
+		for i := 0; i < 3; i++ {
+			msg := []byte(fmt.Sprintf("message %d", i))
+			ct, err := sender.Seal(info, msg)
+			if err != nil {
+				t.Fatalf("%s: %v", s.name, err)
+			}
+			if len(ct) != len(msg)+sender.Overhead() {
+				t.Errorf("%s: ciphertext length %d, want %d", s.name, len(ct), len(msg)+sender.Overhead())
+			}
+			pt, err := recipient.Open(info, ct)
+			if err != nil {
+				t.Fatalf("%s: message %d: %v", s.name, i, err)
+			}
+			if !bytes.Equal(pt, msg) {
+				t.Errorf("%s: got %q, want %q", s.name, pt, msg)
+			}
+		}
+
+		sExp, err := sender.Export([]byte("context"), 42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rExp, err := recipient.Export([]byte("context"), 42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sExp, rExp) {
+			t.Errorf("%s: sender and recipient exported different secrets", s.name)
+		}
+		exports[string(sExp)] = true
+
+		// A recipient with the wrong credentials either fails to set up or
+		// derives a context that can't open the sender's messages.
+		_, sender, err = s.sender()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ct, err := sender.Seal(nil, []byte("hello"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bad, err := s.badRecipient(enc); err == nil {
+			if _, err := bad.Open(nil, ct); err == nil {
+				t.Errorf("%s: message opened with the wrong credentials", s.name)
+			}
+		}
+	}
+	if len(exports) != len(setups) {
+		t.Errorf("different modes exported the same secret")
+	}
+}
+
+func TestInvalidInputs(t *testing.T) {
+	psk, pskID := []byte("0123456789abcdef0123456789abcdef"), []byte("psk id")
+	priv, pub, err := GenerateKey(DHKEM_X25519_HKDF_SHA256, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := SetupSender(0x0011, KDF_HKDF_SHA256, AEAD_AES_128_GCM, pub, nil, rand.Reader); err == nil {
+		t.Error("SetupSender succeeded with an unsupported KEM")
+	}
+	if _, _, err := SetupSender(DHKEM_X25519_HKDF_SHA256, 0x0004, AEAD_AES_128_GCM, pub, nil, rand.Reader); err == nil {
+		t.Error("SetupSender succeeded with an unsupported KDF")
+	}
+	if _, _, err := SetupSender(DHKEM_X25519_HKDF_SHA256, KDF_HKDF_SHA256, 0x0004, pub, nil, rand.Reader); err == nil {
+		t.Error("SetupSender succeeded with an unsupported AEAD")
+	}
+	if _, _, err := SetupSender(DHKEM_X25519_HKDF_SHA256, KDF_HKDF_SHA256, AEAD_AES_128_GCM, pub[:31], nil, rand.Reader); err == nil {
+		t.Error("SetupSender succeeded with a short public key")
+	}
+	if _, _, err := SetupSender(DHKEM_P256_HKDF_SHA256, KDF_HKDF_SHA256, AEAD_AES_128_GCM, make([]byte, 65), nil, rand.Reader); err == nil {
+		t.Error("SetupSender succeeded with an invalid P-256 point")
+	}
+	if _, _, err := SetupSenderPSK(DHKEM_X25519_HKDF_SHA256, KDF_HKDF_SHA256, AEAD_AES_128_GCM, pub, nil, psk, nil, rand.Reader); err == nil {
+		t.Error("SetupSenderPSK succeeded without a PSK ID")
+	}
+	if _, _, err := SetupSenderPSK(DHKEM_X25519_HKDF_SHA256, KDF_HKDF_SHA256, AEAD_AES_128_GCM, pub, nil, nil, nil, rand.Reader); err == nil {
+		t.Error("SetupSenderPSK succeeded without a PSK")
+	}
+	if _, _, err := SetupSenderAuth(DHKEM_X25519_HKDF_SHA256, KDF_HKDF_SHA256, AEAD_AES_128_GCM, pub, nil, nil, rand.Reader); err == nil {
+		t.Error("SetupSenderAuth succeeded without a private key")
+	}
+	if _, err := SetupRecipient(DHKEM_X25519_HKDF_SHA256, KDF_HKDF_SHA256, AEAD_AES_128_GCM, priv, nil, make([]byte, 32)); err == nil {
+		t.Error("SetupRecipient succeeded with a low order encapsulated key")
+	}
+	if _, err := SetupRecipientPSK(DHKEM_X25519_HKDF_SHA256, KDF_HKDF_SHA256, AEAD_AES_128_GCM, priv, nil, pub, nil, pskID); err == nil {
+		t.Error("SetupRecipientPSK succeeded without a PSK")
+	}
+	if _, _, err := DeriveKeyPair(DHKEM_P256_HKDF_SHA256, make([]byte, 31)); err == nil {
+		t.Error("DeriveKeyPair succeeded with short input keying material")
+	}
+
+	_, sender, err := SetupSender(DHKEM_X25519_HKDF_SHA256, KDF_HKDF_SHA256, AEAD_AES_128_GCM, pub, nil, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sender.Export(nil, 255*32+1); err == nil {
+		t.Error("Export succeeded with a length over the limit")
+	}
+	if _, err := sender.Export(nil, 255*32); err != nil {
+		t.Errorf("Export failed with the maximum length: %v", err)
+	}
+}
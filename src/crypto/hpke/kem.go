@@ -0,0 +1,233 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpke
+
+import (
+	"crypto/elliptic"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+// dhKEM implements the DHKEM construction of RFC 9180, Section 4.1, over a
+// Diffie-Hellman group and HKDF-SHA256.
+type dhKEM struct {
+	id    uint16
+	kdf   labeledKDF
+	group dhGroup
+}
+
+// dhGroup is a Diffie-Hellman group as used by DHKEM, with keys in their
+// serialized form. See RFC 9180, Section 7.1.
+type dhGroup interface {
+	// privateKeySize and publicKeySize are Nsk and Npk.
+	privateKeySize() int
+	publicKeySize() int
+
+	// deriveKeyPair implements DeriveKeyPair from RFC 9180, Section 7.1.3.
+	deriveKeyPair(kdf labeledKDF, ikm []byte) (priv []byte, err error)
+	publicKey(priv []byte) ([]byte, error)
+	dh(priv, pub []byte) ([]byte, error)
+}
+
+func newDHKEM(id uint16) (*dhKEM, error) {
+	var group dhGroup
+	switch id {
+	case DHKEM_P256_HKDF_SHA256:
+		group = p256Group{}
+	case DHKEM_X25519_HKDF_SHA256:
+		group = x25519Group{}
+	default:
+		return nil, errUnsupported
+	}
+	suiteID := append([]byte("KEM"), i2osp2(id)...)
+	return &dhKEM{id: id, kdf: labeledKDF{sha256.New, suiteID}, group: group}, nil
+}
+
+// deriveKeyPair returns the serialized private and public keys derived from
+// ikm, which must be at least privateKeySize bytes long.
+func (kem *dhKEM) deriveKeyPair(ikm []byte) (priv, pub []byte, err error) {
+	if len(ikm) < kem.group.privateKeySize() {
+		return nil, nil, errors.New("hpke: input keying material too short")
+	}
+	priv, err = kem.group.deriveKeyPair(kem.kdf, ikm)
+	if err != nil {
+		return nil, nil, err
+	}
+	pub, err = kem.group.publicKey(priv)
+	if err != nil {
+		return nil, nil, err
+	}
+	return priv, pub, nil
+}
+
+// generateKeyPair generates a key pair from privateKeySize random bytes
+// read from rand, as allowed by RFC 9180, Section 7.1.3.
+func (kem *dhKEM) generateKeyPair(rand io.Reader) (priv, pub []byte, err error) {
+	ikm := make([]byte, kem.group.privateKeySize())
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		return nil, nil, err
+	}
+	return kem.deriveKeyPair(ikm)
+}
+
+func (kem *dhKEM) extractAndExpand(dh, kemContext []byte) []byte {
+	eaePRK := kem.kdf.labeledExtract(nil, "eae_prk", dh)
+	return kem.kdf.labeledExpand(eaePRK, "shared_secret", kemContext, sha256.Size)
+}
+
+// encap implements Encap and, if privS is not nil, AuthEncap from RFC 9180,
+// Section 4.1.
+func (kem *dhKEM) encap(rand io.Reader, pubR, privS []byte) (sharedSecret, enc []byte, err error) {
+	privE, pubE, err := kem.generateKeyPair(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	dh, err := kem.group.dh(privE, pubR)
+	if err != nil {
+		return nil, nil, err
+	}
+	kemContext := append(append([]byte{}, pubE...), pubR...)
+	if privS != nil {
+		dhS, err := kem.group.dh(privS, pubR)
+		if err != nil {
+			return nil, nil, err
+		}
+		dh = append(dh, dhS...)
+		pubS, err := kem.group.publicKey(privS)
+		if err != nil {
+			return nil, nil, err
+		}
+		kemContext = append(kemContext, pubS...)
+	}
+	return kem.extractAndExpand(dh, kemContext), pubE, nil
+}
+
+// decap implements Decap and, if pubS is not nil, AuthDecap from RFC 9180,
+// Section 4.1.
+func (kem *dhKEM) decap(enc, privR, pubS []byte) ([]byte, error) {
+	if len(enc) != kem.group.publicKeySize() {
+		return nil, errors.New("hpke: invalid encapsulated key")
+	}
+	dh, err := kem.group.dh(privR, enc)
+	if err != nil {
+		return nil, err
+	}
+	pubR, err := kem.group.publicKey(privR)
+	if err != nil {
+		return nil, err
+	}
+	kemContext := append(append([]byte{}, enc...), pubR...)
+	if pubS != nil {
+		dhS, err := kem.group.dh(privR, pubS)
+		if err != nil {
+			return nil, err
+		}
+		dh = append(dh, dhS...)
+		kemContext = append(kemContext, pubS...)
+	}
+	return kem.extractAndExpand(dh, kemContext), nil
+}
+
+const x25519Size = 32
+
+type x25519Group struct{}
+
+func (x25519Group) privateKeySize() int { return x25519Size }
+func (x25519Group) publicKeySize() int  { return x25519Size }
+
+func (x25519Group) deriveKeyPair(kdf labeledKDF, ikm []byte) ([]byte, error) {
+	dkpPRK := kdf.labeledExtract(nil, "dkp_prk", ikm)
+	return kdf.labeledExpand(dkpPRK, "sk", nil, x25519Size), nil
+}
+
+func (x25519Group) publicKey(priv []byte) ([]byte, error) {
+	if len(priv) != x25519Size {
+		return nil, errors.New("hpke: invalid X25519 private key")
+	}
+	var sk, pk [x25519Size]byte
+	copy(sk[:], priv)
+	curve25519.ScalarBaseMult(&pk, &sk)
+	return pk[:], nil
+}
+
+func (x25519Group) dh(priv, pub []byte) ([]byte, error) {
+	if len(priv) != x25519Size {
+		return nil, errors.New("hpke: invalid X25519 private key")
+	}
+	if len(pub) != x25519Size {
+		return nil, errors.New("hpke: invalid X25519 public key")
+	}
+	var dst, sk, pk [x25519Size]byte
+	copy(sk[:], priv)
+	copy(pk[:], pub)
+	curve25519.ScalarMult(&dst, &sk, &pk)
+	var zero [x25519Size]byte
+	if dst == zero {
+		return nil, errors.New("hpke: invalid X25519 shared secret")
+	}
+	return dst[:], nil
+}
+
+type p256Group struct{}
+
+func (p256Group) privateKeySize() int { return 32 }
+func (p256Group) publicKeySize() int  { return 65 }
+
+func (p256Group) deriveKeyPair(kdf labeledKDF, ikm []byte) ([]byte, error) {
+	dkpPRK := kdf.labeledExtract(nil, "dkp_prk", ikm)
+	n := elliptic.P256().Params().N
+	for counter := 0; counter < 256; counter++ {
+		candidate := kdf.labeledExpand(dkpPRK, "candidate", []byte{byte(counter)}, 32)
+		k := new(big.Int).SetBytes(candidate)
+		if k.Sign() != 0 && k.Cmp(n) < 0 {
+			return candidate, nil
+		}
+	}
+	return nil, errors.New("hpke: failed to derive P-256 key pair")
+}
+
+// p256Scalar returns priv as a scalar, after checking it's a valid private
+// key in the range [1, n-1].
+func p256Scalar(priv []byte) ([]byte, error) {
+	if len(priv) != 32 {
+		return nil, errors.New("hpke: invalid P-256 private key")
+	}
+	k := new(big.Int).SetBytes(priv)
+	if k.Sign() == 0 || k.Cmp(elliptic.P256().Params().N) >= 0 {
+		return nil, errors.New("hpke: invalid P-256 private key")
+	}
+	return priv, nil
+}
+
+func (p256Group) publicKey(priv []byte) ([]byte, error) {
+	k, err := p256Scalar(priv)
+	if err != nil {
+		return nil, err
+	}
+	curve := elliptic.P256()
+	x, y := curve.ScalarBaseMult(k)
+	return elliptic.Marshal(curve, x, y), nil
+}
+
+func (p256Group) dh(priv, pub []byte) ([]byte, error) {
+	k, err := p256Scalar(priv)
+	if err != nil {
+		return nil, err
+	}
+	curve := elliptic.P256()
+	x, y := elliptic.Unmarshal(curve, pub)
+	if x == nil {
+		return nil, errors.New("hpke: invalid P-256 public key")
+	}
+	x, _ = curve.ScalarMult(x, y, k)
+	xBytes := x.Bytes()
+	out := make([]byte, 32)
+	copy(out[len(out)-len(xBytes):], xBytes)
+	return out, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package aes
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/internal/fips"
+	"errors"
+)
+
+func init() {
+	// FIPS 197, Appendix C.3.
+	fips.CAST("AES-256", func() error {
+		key := []byte{
+			0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+			0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+			0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17,
+			0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
+		}
+		plaintext := []byte{
+			0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77,
+			0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+		}
+		want := []byte{
+			0x8e, 0xa2, 0xb7, 0xca, 0x51, 0x67, 0x45, 0xbf,
+			0xea, 0xfc, 0x49, 0x90, 0x4b, 0x49, 0x60, 0x89,
+		}
+		b, err := NewCipher(key)
+		if err != nil {
+			return err
+		}
+		got := make([]byte, BlockSize)
+		b.Encrypt(got, plaintext)
+		if !bytes.Equal(got, want) {
+			return errors.New("unexpected encryption result")
+		}
+		b.Decrypt(got, want)
+		if !bytes.Equal(got, plaintext) {
+			return errors.New("unexpected decryption result")
+		}
+		return nil
+	})
+
+	fips.CAST("AES-GCM", func() error {
+		key := []byte{
+			0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+			0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+		}
+		nonce := []byte{
+			0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47,
+			0x48, 0x49, 0x4a, 0x4b,
+		}
+		plaintext := []byte{
+			0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87,
+			0x88, 0x89, 0x8a, 0x8b, 0x8c, 0x8d, 0x8e, 0x8f,
+		}
+		additionalData := []byte{
+			0xc0, 0xc1, 0xc2, 0xc3, 0xc4, 0xc5, 0xc6, 0xc7,
+			0xc8, 0xc9, 0xca, 0xcb, 0xcc, 0xcd, 0xce, 0xcf,
+		}
+		want := []byte{
+			0x75, 0x6d, 0xd8, 0x6c, 0xa0, 0x11, 0xd6, 0x1b,
+			0xeb, 0x93, 0xed, 0xf1, 0xc1, 0xa7, 0x82, 0xfc,
+			0x48, 0x04, 0xd0, 0x3e, 0x0b, 0x9c, 0xc5, 0x41,
+			0xe9, 0xa6, 0xf1, 0xa8, 0xb3, 0x25, 0x7e, 0x65,
+		}
+		b, err := NewCipher(key)
+		if err != nil {
+			return err
+		}
+		g, err := cipher.NewGCM(b)
+		if err != nil {
+			return err
+		}
+		sealed := g.Seal(nil, nonce, plaintext, additionalData)
+		if !bytes.Equal(sealed, want) {
+			return errors.New("unexpected sealed result")
+		}
+		opened, err := g.Open(nil, nonce, want, additionalData)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(opened, plaintext) {
+			return errors.New("unexpected opened result")
+		}
+		return nil
+	})
+}
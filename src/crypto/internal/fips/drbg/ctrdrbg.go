@@ -0,0 +1,173 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package drbg implements CTR_DRBG, the AES-based deterministic random bit
+// generator of NIST SP 800-90A Rev. 1, which backs crypto/rand in FIPS 140
+// mode.
+package drbg
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/internal/fips"
+	"crypto/internal/fips/aes"
+	"errors"
+)
+
+// Counter is a CTR_DRBG instantiated with AES-256, without a derivation
+// function, as specified in SP 800-90A Rev. 1, Section 10.2.1.
+//
+// Without a derivation function, the entropy input and the additional input
+// must be SeedSize bytes long, and the entropy input must be full entropy.
+type Counter struct {
+	c cipher.Block        // keyed with K
+	v [aes.BlockSize]byte // V
+
+	reseedCounter uint64
+}
+
+const (
+	keySize = 256 / 8
+
+	// SeedSize is the size of the entropy input and of the additional input.
+	SeedSize = keySize + aes.BlockSize
+
+	// MaxRequestSize is the maximum size of a single Generate request,
+	// 2¹⁹ bits per SP 800-90A Rev. 1, Table 3.
+	MaxRequestSize = (1 << 19) / 8
+
+	// reseedInterval is the maximum number of Generate requests between
+	// reseeds, per SP 800-90A Rev. 1, Table 3.
+	reseedInterval = 1 << 48
+)
+
+// NewCounter instantiates a Counter from the given entropy input, as in
+// CTR_DRBG_Instantiate_algorithm, per Section 10.2.1.3.1. The personalization
+// string is empty.
+func NewCounter(entropy *[SeedSize]byte) *Counter {
+	c := &Counter{}
+	// K and V start as all zeroes.
+	var K [keySize]byte
+	c.c = newCipher(K[:])
+	c.update(entropy)
+	c.reseedCounter = 1
+	return c
+}
+
+func newCipher(key []byte) cipher.Block {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic("crypto/internal/fips/drbg: internal error: " + err.Error())
+	}
+	return block
+}
+
+// keystream fills out with the encryption of successive values of V,
+// incrementing V before each block. A trailing partial block is discarded.
+func (c *Counter) keystream(out []byte) {
+	var block [aes.BlockSize]byte
+	for len(out) > 0 {
+		increment(&c.v)
+		c.c.Encrypt(block[:], c.v[:])
+		n := copy(out, block[:])
+		out = out[n:]
+	}
+}
+
+// increment increments v as a 128-bit big-endian integer.
+func increment(v *[aes.BlockSize]byte) {
+	for i := len(v) - 1; i >= 0; i-- {
+		v[i]++
+		if v[i] != 0 {
+			return
+		}
+	}
+}
+
+// update implements CTR_DRBG_Update, per Section 10.2.1.2.
+func (c *Counter) update(providedData *[SeedSize]byte) {
+	var temp [SeedSize]byte
+	c.keystream(temp[:])
+	for i := range temp {
+		temp[i] ^= providedData[i]
+	}
+	c.c = newCipher(temp[:keySize])
+	copy(c.v[:], temp[keySize:])
+}
+
+// Reseed implements CTR_DRBG_Reseed_algorithm, per Section 10.2.1.4.1.
+func (c *Counter) Reseed(entropy, additionalInput *[SeedSize]byte) {
+	var seed [SeedSize]byte
+	for i := range seed {
+		seed[i] = entropy[i] ^ additionalInput[i]
+	}
+	c.update(&seed)
+	c.reseedCounter = 1
+}
+
+// Generate implements CTR_DRBG_Generate_algorithm, per Section 10.2.1.5.1.
+// additionalInput may be nil.
+//
+// If reseedRequired is true, out is left untouched and Reseed must be called
+// before trying again. len(out) must be at most MaxRequestSize.
+func (c *Counter) Generate(out []byte, additionalInput *[SeedSize]byte) (reseedRequired bool) {
+	if len(out) > MaxRequestSize {
+		panic("crypto/internal/fips/drbg: internal error: request size exceeds maximum")
+	}
+
+	// Step 1.
+	if c.reseedCounter > reseedInterval {
+		return true
+	}
+
+	// Step 2.
+	if additionalInput != nil {
+		c.update(additionalInput)
+	} else {
+		// If the additional input is null, the first CTR_DRBG_Update is
+		// skipped, but the additional input is replaced with an all-zero
+		// string for the second CTR_DRBG_Update.
+		additionalInput = new([SeedSize]byte)
+	}
+
+	// Steps 3-5.
+	c.keystream(out)
+
+	// Step 6.
+	c.update(additionalInput)
+
+	// Step 7.
+	c.reseedCounter++
+
+	// Step 8.
+	return false
+}
+
+func init() {
+	// A known-answer test of a DRBG instantiates it with known data, reseeds
+	// it with other known data, generates output, and compares it to a
+	// pre-computed value.
+	fips.CAST("CTR_DRBG", func() error {
+		var entropy, reseedEntropy, additionalInput [SeedSize]byte
+		for i := 0; i < SeedSize; i++ {
+			entropy[i] = byte(0x01 + i)
+			reseedEntropy[i] = byte(0x31 + i)
+			additionalInput[i] = byte(0x61 + i)
+		}
+		want := []byte{
+			0x6e, 0x6e, 0x47, 0x9d, 0x24, 0xf8, 0x6a, 0x3b,
+			0x77, 0x87, 0xa8, 0xf8, 0x18, 0x6d, 0x98, 0x5a,
+			0x53, 0xbe, 0xbe, 0xed, 0xde, 0xab, 0x92, 0x28,
+			0xf0, 0xf4, 0xac, 0x6e, 0x10, 0xbf, 0x01, 0x93,
+		}
+		c := NewCounter(&entropy)
+		c.Reseed(&reseedEntropy, &additionalInput)
+		got := make([]byte, len(want))
+		c.Generate(got, &additionalInput)
+		if !bytes.Equal(got, want) {
+			return errors.New("unexpected result")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ecdsa
+
+import (
+	"crypto/elliptic"
+	"crypto/internal/fips"
+	"errors"
+	"io"
+	"math/big"
+)
+
+func init() {
+	fips.CAST("ECDSA P-256 SHA2-256 sign and verify", func() error {
+		c := elliptic.P256()
+		d := []byte{
+			0x31, 0x8d, 0x24, 0x72, 0xd9, 0x9e, 0x83, 0x6e,
+			0x2c, 0x5d, 0x92, 0x35, 0x1b, 0x4e, 0x83, 0x0c,
+			0x66, 0x2d, 0x1c, 0x56, 0x0f, 0x93, 0xc4, 0x58,
+			0x2e, 0xd1, 0x3b, 0x7b, 0x59, 0x2f, 0xb3, 0x44,
+		}
+		hash := []byte{
+			0x53, 0xac, 0x46, 0x31, 0xc1, 0xb5, 0x98, 0x26,
+			0x14, 0xb5, 0xfa, 0xd1, 0x92, 0xe2, 0x41, 0xb5,
+			0x4d, 0x1f, 0x16, 0x62, 0xe7, 0xd5, 0x85, 0xe2,
+			0xe5, 0xb0, 0x45, 0x1c, 0xa1, 0x70, 0x4a, 0x77,
+		}
+		wantR := []byte{
+			0xc7, 0x06, 0xf9, 0x04, 0x67, 0x55, 0xfd, 0x42,
+			0x17, 0xa1, 0xe0, 0x52, 0x1f, 0x57, 0x0b, 0x2a,
+			0x6c, 0x12, 0x57, 0x1c, 0x1e, 0x89, 0xa8, 0xeb,
+			0xb3, 0x00, 0xfb, 0xeb, 0x29, 0x3e, 0xd9, 0xf1,
+		}
+		wantS := []byte{
+			0xe9, 0xbd, 0x0b, 0xf6, 0xa0, 0x5a, 0x80, 0x4c,
+			0x50, 0xdc, 0xf6, 0x98, 0x63, 0xc6, 0x33, 0x37,
+			0x34, 0x40, 0xf5, 0xf4, 0x9f, 0xa1, 0x18, 0x7f,
+			0x06, 0x9d, 0xf5, 0x8f, 0xd7, 0xbe, 0x16, 0xbd,
+		}
+		priv := &PrivateKey{D: new(big.Int).SetBytes(d)}
+		priv.Curve = c
+		priv.X, priv.Y = c.ScalarBaseMult(d)
+		// The signature is deterministic, as the entropy input is fixed.
+		r, s, err := Sign(zeroReader, priv, hash)
+		if err != nil {
+			return err
+		}
+		if r.Cmp(new(big.Int).SetBytes(wantR)) != 0 || s.Cmp(new(big.Int).SetBytes(wantS)) != 0 {
+			return errors.New("unexpected result")
+		}
+		if !Verify(&priv.PublicKey, hash, r, s) {
+			return errors.New("verification failed")
+		}
+		return nil
+	})
+}
+
+// pairwiseTest checks that a newly generated key can produce signatures that
+// its public key verifies.
+func pairwiseTest(priv *PrivateKey, rand io.Reader) error {
+	hash := make([]byte, 32)
+	r, s, err := Sign(rand, priv, hash)
+	if err != nil {
+		return err
+	}
+	if !Verify(&priv.PublicKey, hash, r, s) {
+		return errors.New("verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fips
+
+// CASTs runs every registered self-test, regardless of whether FIPS 140 mode
+// is enabled, and returns the names of the tests with their results.
+func CASTs() (names []string, errs []error) {
+	for _, c := range casts {
+		names = append(names, c.name)
+		errs = append(errs, c.f())
+	}
+	return names, errs
+}
+
+var GoDebugString = goDebugString
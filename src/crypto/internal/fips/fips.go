@@ -0,0 +1,91 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fips implements the FIPS 140 mode of the cryptographic module made
+// of the packages under crypto/internal/fips: aes, drbg, ecdsa, hmac, rsa,
+// sha256 and sha512. The public crypto packages wrap them, and all the
+// self-tests are registered inside the module.
+//
+// FIPS 140 mode is enabled by building with the fips140 build tag, or by
+// running with the GODEBUG environment variable (comma-separated key=value
+// options) including "fips140=on". In that mode, each package of the module
+// runs its self-tests when it's initialized, crypto/rand is backed by a
+// CTR_DRBG, and crypto/tls only negotiates approved algorithms.
+package fips
+
+import "syscall"
+
+// Enabled reports whether FIPS 140 mode is enabled. It's set before any
+// package of the module is initialized, and never changes afterwards.
+var Enabled = buildTagEnabled || goDebugString("fips140") == "on"
+
+// goDebugString returns the value of the named GODEBUG key.
+// GODEBUG is of the form "key=val,key2=val2".
+func goDebugString(key string) string {
+	s, _ := syscall.Getenv("GODEBUG")
+	for i := 0; i < len(s)-len(key)-1; i++ {
+		if i > 0 && s[i-1] != ',' {
+			continue
+		}
+		afterKey := s[i+len(key):]
+		if afterKey[0] != '=' || s[i:i+len(key)] != key {
+			continue
+		}
+		val := afterKey[1:]
+		for i, b := range val {
+			if b == ',' {
+				return val[:i]
+			}
+		}
+		return val
+	}
+	return ""
+}
+
+// cast is a registered self-test.
+type cast struct {
+	name string
+	f    func() error
+}
+
+// casts lists the self-tests registered so far, for testing.
+var casts []cast
+
+// CAST registers and, in FIPS 140 mode, runs the self-test of the named
+// algorithm. It must be called from the init function of the package that
+// implements the algorithm, so that no output of the algorithm is used before
+// it passes its self-test.
+//
+// If the self-test fails, CAST panics, as the module must not be used.
+func CAST(name string, f func() error) {
+	casts = append(casts, cast{name, f})
+	if !Enabled {
+		return
+	}
+	if err := f(); err != nil {
+		panic("crypto/internal/fips: self-test failed: " + name + ": " + err.Error())
+	}
+}
+
+// PCT runs the named pairwise consistency test on a newly generated key, in
+// FIPS 140 mode, returning its error, if any. Outside FIPS 140 mode, it does
+// nothing and returns nil.
+func PCT(name string, f func() error) error {
+	if !Enabled {
+		return nil
+	}
+	if err := f(); err != nil {
+		return &pctError{name, err}
+	}
+	return nil
+}
+
+type pctError struct {
+	name string
+	err  error
+}
+
+func (e *pctError) Error() string {
+	return "crypto/internal/fips: pairwise consistency test failed: " + e.name + ": " + e.err.Error()
+}
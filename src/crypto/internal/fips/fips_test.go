@@ -0,0 +1,98 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fips_test
+
+import (
+	"crypto/internal/fips"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	// Import the packages of the module, so that their self-tests are
+	// registered.
+	_ "crypto/internal/fips/aes"
+	_ "crypto/internal/fips/drbg"
+	_ "crypto/internal/fips/ecdsa"
+	_ "crypto/internal/fips/hmac"
+	_ "crypto/internal/fips/rsa"
+	_ "crypto/internal/fips/sha256"
+	_ "crypto/internal/fips/sha512"
+)
+
+var allCASTs = []string{
+	"AES-256",
+	"AES-GCM",
+	"CTR_DRBG",
+	"ECDSA P-256 SHA2-256 sign and verify",
+	"HMAC-SHA2-256",
+	"RSASSA-PKCS-v1.5 2048-bit sign and verify",
+	"SHA2-256",
+	"SHA2-512",
+}
+
+func TestCASTs(t *testing.T) {
+	names, errs := fips.CASTs()
+	registered := make(map[string]bool)
+	for i, name := range names {
+		if registered[name] {
+			t.Errorf("self-test %q registered twice", name)
+		}
+		registered[name] = true
+		if errs[i] != nil {
+			t.Errorf("self-test %q failed: %v", name, errs[i])
+		}
+	}
+	for _, name := range allCASTs {
+		if !registered[name] {
+			t.Errorf("self-test %q was not registered", name)
+		}
+	}
+	if len(names) != len(allCASTs) {
+		t.Errorf("got %d self-tests, expected %d: %q", len(names), len(allCASTs), names)
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	// Enabled is computed at initialization, so only check that it's
+	// consistent with the environment the test is running in.
+	if strings.Contains(","+os.Getenv("GODEBUG")+",", ",fips140=on,") && !fips.Enabled {
+		t.Error("GODEBUG=fips140=on is set, but FIPS 140 mode is disabled")
+	}
+}
+
+func TestGoDebugString(t *testing.T) {
+	tests := []struct {
+		godebug, key, want string
+	}{
+		{"", "fips140", ""},
+		{"fips140=on", "fips140", "on"},
+		{"x=1,fips140=on", "fips140", "on"},
+		{"fips140=on,x=1", "fips140", "on"},
+		{"xfips140=on", "fips140", ""},
+		{"fips140", "fips140", ""},
+		{"fips140=", "fips140", ""},
+	}
+	defer os.Setenv("GODEBUG", os.Getenv("GODEBUG"))
+	for _, tt := range tests {
+		os.Setenv("GODEBUG", tt.godebug)
+		if got := fips.GoDebugString(tt.key); got != tt.want {
+			t.Errorf("goDebugString(%q) with GODEBUG=%q = %q, want %q", tt.key, tt.godebug, got, tt.want)
+		}
+	}
+}
+
+func TestPCT(t *testing.T) {
+	err := fips.PCT("test", func() error { return errors.New("failure") })
+	if !fips.Enabled {
+		if err != nil {
+			t.Errorf("PCT outside FIPS 140 mode returned %v", err)
+		}
+		return
+	}
+	if err == nil || !strings.Contains(err.Error(), "test") {
+		t.Errorf("PCT returned %v, expected an error naming the test", err)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/internal/fips"
+	"errors"
+	"math/big"
+)
+
+func init() {
+	fips.CAST("RSASSA-PKCS-v1.5 2048-bit sign and verify", func() error {
+		k := &PrivateKey{
+			PublicKey: PublicKey{
+				N: castFromHex(
+					"d772a772c21ae2a42573b860027ba5cc6d71552bf0131d07d1254a4f712a3d64" +
+						"76c81b2a59b6f01360b646563031b5f0b3fa85cfe501941d7c9921ea1e81e801" +
+						"2e71f5edaafa1f6764a0f8136f2325e8c1c727b86965908460433647f89e3111" +
+						"33020f7676ae08ebcacc4f1b3c57d833c502db1e801e368f673d0ba24c1338ab" +
+						"e201ff3aba42144657b1c3c583b51effa9a891da0e6244d3e72115c6ffa2c625" +
+						"408dfd724cc3fe1417f16a8d0d7743780eec7fa7bccae541134d5ad197d2e334" +
+						"b16edbb9ee92f5c249755e13aedd630a895d5ae527d34fa3dfe9bf3966fc889b" +
+						"a31df72ce5b759da309cb1ed7de54670aa67e0cf0a427ba85f9c5b766d60b0d9"),
+				E: 65537,
+			},
+			D: castFromHex(
+				"4b2543ef5c71792c8c9d94a8f424c17b108f2f886037a270080c4c237252abd0" +
+					"1f3803759d8e17a5829d80e1edf460c3c05ab8927f0b3268a87d56dd6c5f9e16" +
+					"cf66d342ded857dcbdb60160f1ea1dfe559c4ba7e0271b2578d7e89668ca704d" +
+					"d1ef3d8a71b1990df91192e3ea83af36bb58182a46c08302389d8bb1c37c0ecf" +
+					"0cf45926c88ce3ecdafcf7a641817600b06823e5232e2ecde6dc1baf823c02a5" +
+					"41e13d852108b3a9cb0ece3a7d6dce6bd0ee15b20ebc386bf5f64263fba1eebe" +
+					"699cbf6e3e50ea1c108a9cbd0efa13634466708ee900468a297e7e36f3cf546e" +
+					"cacc7a3153a0a8d834a0bbcc2520693e6540bc1b860938bcb0c664d145b8e1a3"),
+			Primes: []*big.Int{
+				castFromHex(
+					"ec07dd77dd26a7c91d8a963de4873ade7e35875f1e297219cf625d1ec0fdcec5" +
+						"4aa05e2c6f2dc3519f091aa8e27642704bb029e2b164e9fa7eb03d95b8a29ee6" +
+						"a9a88b07b0f2e11bddf007d4399db6145c485cf852231da797cc96928686f4c4" +
+						"aeac32b40e89b0b27adde5d130bd93346ab5add8ec3c3617164a87252b079937"),
+				castFromHex(
+					"e9acfd5b34bc000834ceadddb597a12ac4c0de09c4ab75b00014a8ae17769ba6" +
+						"382aad2c395e060157c75ad5d79f1e2fb9397e9e20ab1b912432abf8e7b7c08d" +
+						"4d78d0ba26797a3623ae4470641e2b325a3db2439cb2c827077837df53a70961" +
+						"841b40b1102ee1bdc3b9065ef033312dfc8b1d6d830471756d8c6dc9200dce6f"),
+			},
+		}
+		k.Precompute()
+		hashed := []byte{
+			0xa3, 0x67, 0x91, 0x5b, 0x1e, 0xb0, 0xc8, 0x1a,
+			0xf6, 0xf5, 0x33, 0x75, 0x33, 0xd6, 0xf4, 0xfa,
+			0x8f, 0xaf, 0xb1, 0x45, 0x10, 0xdb, 0x62, 0x28,
+			0xe3, 0x21, 0x5b, 0xd1, 0x0e, 0x98, 0x6e, 0xc3,
+		}
+		want := []byte{
+			0x36, 0x35, 0x91, 0x61, 0x05, 0xc6, 0xb1, 0xa7,
+			0x3c, 0x04, 0xf6, 0x7a, 0xcb, 0x18, 0x6a, 0x70,
+			0x09, 0x79, 0xca, 0x86, 0x38, 0xc7, 0x32, 0xe1,
+			0xda, 0x5d, 0xb6, 0xad, 0xb1, 0x22, 0xcd, 0x3b,
+			0x61, 0xa9, 0xc5, 0x99, 0x52, 0x41, 0xac, 0x20,
+			0x0f, 0xbb, 0x6e, 0xe5, 0x79, 0xd6, 0xfc, 0x9d,
+			0x8a, 0x9e, 0x7b, 0x65, 0x2f, 0x60, 0xfa, 0x1e,
+			0x7b, 0x69, 0xfc, 0x2a, 0x1a, 0xc9, 0x57, 0xda,
+			0x30, 0x2b, 0x02, 0x7c, 0xdd, 0x39, 0x53, 0xe2,
+			0x96, 0x2a, 0x76, 0x42, 0x50, 0xfa, 0x60, 0x82,
+			0x71, 0x07, 0xe5, 0x2c, 0x4d, 0xfa, 0x60, 0xfa,
+			0xb2, 0x0d, 0x71, 0xcf, 0x1c, 0x75, 0xe8, 0xd1,
+			0x76, 0x17, 0x44, 0x90, 0xda, 0x42, 0xaa, 0x1d,
+			0x1b, 0x41, 0xea, 0xa1, 0xa6, 0xe3, 0x38, 0x63,
+			0xac, 0xb2, 0x1c, 0x4f, 0x7b, 0x7b, 0x16, 0x00,
+			0x25, 0xb6, 0x42, 0xc3, 0x01, 0xdc, 0xdd, 0x42,
+			0x06, 0x7d, 0xbe, 0x5d, 0xe5, 0x4c, 0x60, 0x39,
+			0xf2, 0x42, 0x45, 0x67, 0x1b, 0x3d, 0x58, 0x67,
+			0x31, 0x79, 0x79, 0x82, 0xf2, 0x53, 0x62, 0x10,
+			0xfc, 0xfe, 0xee, 0xeb, 0xee, 0xaf, 0x24, 0x23,
+			0x44, 0xee, 0xf7, 0x2b, 0xdd, 0xf1, 0x84, 0xd7,
+			0x36, 0x65, 0x74, 0xe5, 0xf1, 0x7e, 0x9a, 0x3c,
+			0x09, 0x14, 0x11, 0x2f, 0xa5, 0x34, 0x62, 0x60,
+			0xed, 0x7d, 0x0a, 0xc0, 0xe0, 0x80, 0x6c, 0xde,
+			0xc9, 0xda, 0x96, 0xe4, 0x35, 0x83, 0xca, 0x4b,
+			0x63, 0x27, 0x74, 0x2c, 0x59, 0x4b, 0x32, 0xea,
+			0x2d, 0x96, 0xae, 0xa8, 0x15, 0xf3, 0x28, 0x0f,
+			0x26, 0x84, 0x7f, 0xa3, 0x62, 0x3f, 0x8d, 0x5b,
+			0xe2, 0x15, 0xa8, 0x0b, 0xb7, 0xb7, 0x62, 0x5d,
+			0x5c, 0x65, 0x05, 0x5f, 0xed, 0xee, 0x57, 0xad,
+			0x27, 0xa3, 0x75, 0x01, 0xc4, 0x26, 0x8d, 0xbe,
+			0x8d, 0xe5, 0x83, 0x9b, 0xca, 0x25, 0xda, 0x71,
+		}
+		sig, err := SignPKCS1v15(nil, k, crypto.SHA256, hashed)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(sig, want) {
+			return errors.New("unexpected result")
+		}
+		return VerifyPKCS1v15(&k.PublicKey, crypto.SHA256, hashed, sig)
+	})
+}
+
+func castFromHex(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("crypto/rsa: internal error: invalid hex")
+	}
+	return n
+}
+
+// pairwiseTest checks that a newly generated key can decrypt what its public
+// key encrypts.
+func pairwiseTest(priv *PrivateKey) error {
+	m := big.NewInt(42)
+	c := Encrypt(new(big.Int), &priv.PublicKey, m)
+	m1, err := Decrypt(nil, priv, c)
+	if err != nil {
+		return err
+	}
+	if m1.Cmp(m) != 0 {
+		return errors.New("decrypted value doesn't match")
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sha3 implements the SHA3-256 and SHA3-512 hash functions and the
+// SHAKE128 and SHAKE256 extendable-output functions defined in FIPS 202, as
+// needed by the ML-KEM implementation.
+//
+// It favors simplicity over performance.
+package sha3
+
+import (
+	"hash"
+	"math/bits"
+)
+
+// Domain separation bytes, with the first bit of the pad10*1 padding.
+const (
+	dsbyteSHA3  = 0x06
+	dsbyteShake = 0x1f
+)
+
+// state is a Keccak sponge, with a 1600-bit state. Input and output are
+// processed one byte at a time, at position pos of the rate portion of the
+// state.
+type state struct {
+	a         [25]uint64
+	rate      int
+	dsbyte    byte
+	outputLen int // for Sum
+
+	pos       int
+	squeezing bool
+}
+
+// ShakeHash is an extendable-output function. Output is read with Read,
+// after which Write must not be called again.
+type ShakeHash interface {
+	// Write absorbs more data into the hash's state. It panics if called
+	// after Read.
+	Write(p []byte) (n int, err error)
+
+	// Read reads more output from the hash. It never returns an error.
+	Read(p []byte) (n int, err error)
+
+	// Reset resets the hash to its initial state.
+	Reset()
+}
+
+// New256 returns a new hash.Hash computing SHA3-256.
+func New256() hash.Hash { return &state{rate: 136, dsbyte: dsbyteSHA3, outputLen: 32} }
+
+// New512 returns a new hash.Hash computing SHA3-512.
+func New512() hash.Hash { return &state{rate: 72, dsbyte: dsbyteSHA3, outputLen: 64} }
+
+// NewShake128 returns a new ShakeHash computing SHAKE128.
+func NewShake128() ShakeHash { return &state{rate: 168, dsbyte: dsbyteShake, outputLen: 32} }
+
+// NewShake256 returns a new ShakeHash computing SHAKE256.
+func NewShake256() ShakeHash { return &state{rate: 136, dsbyte: dsbyteShake, outputLen: 64} }
+
+// Sum256 returns the SHA3-256 digest of data.
+func Sum256(data []byte) (digest [32]byte) {
+	h := New256()
+	h.Write(data)
+	h.Sum(digest[:0])
+	return
+}
+
+// Sum512 returns the SHA3-512 digest of data.
+func Sum512(data []byte) (digest [64]byte) {
+	h := New512()
+	h.Write(data)
+	h.Sum(digest[:0])
+	return
+}
+
+func (d *state) BlockSize() int { return d.rate }
+func (d *state) Size() int      { return d.outputLen }
+
+func (d *state) Reset() {
+	d.a = [25]uint64{}
+	d.pos = 0
+	d.squeezing = false
+}
+
+func (d *state) Write(p []byte) (int, error) {
+	if d.squeezing {
+		panic("sha3: Write after Read")
+	}
+	for _, b := range p {
+		d.a[d.pos/8] ^= uint64(b) << (8 * uint(d.pos%8))
+		d.pos++
+		if d.pos == d.rate {
+			keccakF1600(&d.a)
+			d.pos = 0
+		}
+	}
+	return len(p), nil
+}
+
+// padAndPermute applies the domain separation and pad10*1 padding, and
+// switches the sponge to squeezing.
+func (d *state) padAndPermute() {
+	d.a[d.pos/8] ^= uint64(d.dsbyte) << (8 * uint(d.pos%8))
+	d.a[(d.rate-1)/8] ^= 0x80 << (8 * uint((d.rate-1)%8))
+	keccakF1600(&d.a)
+	d.pos = 0
+	d.squeezing = true
+}
+
+func (d *state) Read(out []byte) (int, error) {
+	if !d.squeezing {
+		d.padAndPermute()
+	}
+	for i := range out {
+		if d.pos == d.rate {
+			keccakF1600(&d.a)
+			d.pos = 0
+		}
+		out[i] = byte(d.a[d.pos/8] >> (8 * uint(d.pos%8)))
+		d.pos++
+	}
+	return len(out), nil
+}
+
+// Sum appends the digest to b, without changing the underlying hash state.
+// It must not be called after Read.
+func (d *state) Sum(b []byte) []byte {
+	if d.squeezing {
+		panic("sha3: Sum after Read")
+	}
+	dup := *d
+	out := make([]byte, dup.outputLen)
+	dup.Read(out)
+	return append(b, out...)
+}
+
+// rc are the round constants of Keccak-f[1600].
+var rc = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// rotc and piln are the rotation offsets and the lane permutation of the ρ
+// and π steps, in the order in which they are applied.
+var rotc = [24]int{
+	1, 3, 6, 10, 15, 21, 28, 36, 45, 55, 2, 14,
+	27, 41, 56, 8, 25, 43, 62, 18, 39, 61, 20, 44,
+}
+var piln = [24]int{
+	10, 7, 11, 17, 18, 3, 5, 16, 8, 21, 24, 4,
+	15, 23, 19, 13, 12, 2, 20, 14, 22, 9, 6, 1,
+}
+
+// keccakF1600 applies the Keccak-f[1600] permutation to a.
+func keccakF1600(a *[25]uint64) {
+	var bc [5]uint64
+	for round := 0; round < 24; round++ {
+		// θ step.
+		for i := 0; i < 5; i++ {
+			bc[i] = a[i] ^ a[i+5] ^ a[i+10] ^ a[i+15] ^ a[i+20]
+		}
+		for i := 0; i < 5; i++ {
+			t := bc[(i+4)%5] ^ bits.RotateLeft64(bc[(i+1)%5], 1)
+			for j := 0; j < 25; j += 5 {
+				a[j+i] ^= t
+			}
+		}
+
+		// ρ and π steps.
+		t := a[1]
+		for i := 0; i < 24; i++ {
+			j := piln[i]
+			bc[0] = a[j]
+			a[j] = bits.RotateLeft64(t, rotc[i])
+			t = bc[0]
+		}
+
+		// χ step.
+		for j := 0; j < 25; j += 5 {
+			for i := 0; i < 5; i++ {
+				bc[i] = a[j+i]
+			}
+			for i := 0; i < 5; i++ {
+				a[j+i] ^= ^bc[(i+1)%5] & bc[(i+2)%5]
+			}
+		}
+
+		// ι step.
+		a[0] ^= rc[round]
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sha3
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+var golden = []struct {
+	name string
+	new  func() ShakeHash
+	in   string
+	out  string
+}{
+	{"SHA3-256", func() ShakeHash { return New256().(*state) }, "",
+		"a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a"},
+	{"SHA3-256", func() ShakeHash { return New256().(*state) }, "abc",
+		"3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"},
+	{"SHA3-512", func() ShakeHash { return New512().(*state) }, "abc",
+		"b751850b1a57168a5693cd924b6b096e08f621827444f70d884f5d0240d2712e10e116e9192af3c91a7ec57647e3934057340b4cf408d5a56592f8274eec53f0"},
+	{"SHAKE128", NewShake128, "",
+		"7f9c2ba4e88f827d616045507605853ed73b8093f6efbc88eb1a6eacfa66ef26"},
+	{"SHAKE256", NewShake256, "",
+		"46b9dd2b0ba88d13233b3feb743eeb243fcd52ea62b81b82b50c27646ed5762fd75dc4ddd8c0f200cb05019d67b592f6fc821c49479ab48640292eacb3b7c4be"},
+}
+
+func TestGolden(t *testing.T) {
+	for _, g := range golden {
+		h := g.new()
+		h.Write([]byte(g.in))
+		out := make([]byte, len(g.out)/2)
+		h.Read(out)
+		if got := hex.EncodeToString(out); got != g.out {
+			t.Errorf("%s(%q) = %s, want %s", g.name, g.in, got, g.out)
+		}
+	}
+}
+
+func TestSum(t *testing.T) {
+	h := New256()
+	h.Write([]byte("ab"))
+	partial := h.Sum(nil)
+	h.Write([]byte("c"))
+	if got := hex.EncodeToString(h.Sum(nil)); got != golden[1].out {
+		t.Errorf("Sum after Sum = %s, want %s", got, golden[1].out)
+	}
+	if sum := Sum256([]byte("ab")); !bytes.Equal(sum[:], partial) {
+		t.Errorf("Sum256 = %x, want %x", sum, partial)
+	}
+}
+
+// TestIncremental checks that splitting the input and output across calls,
+// and across block boundaries, doesn't change the result.
+func TestIncremental(t *testing.T) {
+	in := []byte(strings.Repeat("The quick brown fox jumps over the lazy dog. ", 20))
+	for _, newHash := range []func() ShakeHash{NewShake128, NewShake256} {
+		want := make([]byte, 500)
+		h := newHash()
+		h.Write(in)
+		h.Read(want)
+
+		h.Reset()
+		for i := 0; i < len(in); i += 7 {
+			end := i + 7
+			if end > len(in) {
+				end = len(in)
+			}
+			h.Write(in[i:end])
+		}
+		got := make([]byte, 0, len(want))
+		for len(got) < len(want) {
+			buf := make([]byte, 13)
+			h.Read(buf)
+			got = append(got, buf...)
+		}
+		if !bytes.Equal(got[:len(want)], want) {
+			t.Errorf("incremental output doesn't match one-shot output")
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"crypto/internal/fips"
+	"crypto/internal/fips/drbg"
+	"io"
+	"sync"
+)
+
+// fipsReader returns r, unless FIPS 140 mode is enabled, in which case it
+// returns a Reader backed by a CTR_DRBG, seeded and periodically reseeded with
+// entropy from r.
+func fipsReader(r io.Reader) io.Reader {
+	if !fips.Enabled {
+		return r
+	}
+	return &drbgReader{entropy: r}
+}
+
+// drbgReseedRequests is the number of Read calls after which drbgReader
+// reseeds its CTR_DRBG, well before it would be required to.
+const drbgReseedRequests = 1024
+
+type drbgReader struct {
+	entropy io.Reader
+
+	mu       sync.Mutex
+	drbg     *drbg.Counter
+	requests int
+}
+
+func (r *drbgReader) Read(b []byte) (n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.drbg == nil {
+		seed := new([drbg.SeedSize]byte)
+		if _, err := io.ReadFull(r.entropy, seed[:]); err != nil {
+			return 0, err
+		}
+		r.drbg = drbg.NewCounter(seed)
+	}
+	if r.requests >= drbgReseedRequests {
+		if err := r.reseed(); err != nil {
+			return 0, err
+		}
+	}
+	r.requests++
+
+	for n < len(b) {
+		size := len(b) - n
+		if size > drbg.MaxRequestSize {
+			size = drbg.MaxRequestSize
+		}
+		if reseedRequired := r.drbg.Generate(b[n:n+size], nil); reseedRequired {
+			if err := r.reseed(); err != nil {
+				return n, err
+			}
+			continue
+		}
+		n += size
+	}
+	return n, nil
+}
+
+func (r *drbgReader) reseed() error {
+	seed := new([drbg.SeedSize]byte)
+	if _, err := io.ReadFull(r.entropy, seed[:]); err != nil {
+		return err
+	}
+	r.drbg.Reseed(seed, new([drbg.SeedSize]byte))
+	r.requests = 0
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"bytes"
+	"crypto/internal/fips/drbg"
+	"io"
+	"testing"
+)
+
+// countingReader returns an endless stream of bytes and counts how many were
+// read from it.
+type countingReader struct {
+	n int
+}
+
+func (r *countingReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = byte(r.n)
+		r.n++
+	}
+	return len(b), nil
+}
+
+func TestDRBGReader(t *testing.T) {
+	entropy := &countingReader{}
+	r := &drbgReader{entropy: entropy}
+
+	// A request larger than the maximum DRBG request is split.
+	b := make([]byte, 2*drbg.MaxRequestSize+1)
+	if n, err := r.Read(b); n != len(b) || err != nil {
+		t.Fatalf("Read = %d, %v", n, err)
+	}
+	if entropy.n != drbg.SeedSize {
+		t.Errorf("read %d bytes of entropy, expected %d", entropy.n, drbg.SeedSize)
+	}
+	if bytes.Equal(b[:drbg.MaxRequestSize], b[drbg.MaxRequestSize:2*drbg.MaxRequestSize]) {
+		t.Error("split request returned repeated output")
+	}
+
+	for i := 1; i < drbgReseedRequests; i++ {
+		r.Read(b[:1])
+	}
+	if entropy.n != drbg.SeedSize {
+		t.Errorf("reseeded after %d requests, expected %d", r.requests, drbgReseedRequests)
+	}
+	r.Read(b[:1])
+	if entropy.n != 2*drbg.SeedSize {
+		t.Errorf("did not reseed after %d requests", drbgReseedRequests)
+	}
+
+	// Two readers with the same entropy source produce the same output.
+	r1 := &drbgReader{entropy: &countingReader{}}
+	r2 := &drbgReader{entropy: &countingReader{}}
+	b1, b2 := make([]byte, 100), make([]byte, 100)
+	io.ReadFull(r1, b1)
+	io.ReadFull(r2, b2)
+	if !bytes.Equal(b1, b2) {
+		t.Error("output is not a function of the entropy")
+	}
+}
+
+func TestDRBGReaderEntropyError(t *testing.T) {
+	r := &drbgReader{entropy: bytes.NewReader(make([]byte, drbg.SeedSize-1))}
+	if _, err := r.Read(make([]byte, 10)); err == nil {
+		t.Error("expected an error with insufficient entropy")
+	}
+}
@@ -16,6 +16,9 @@ import "io"
 // On other Unix-like systems, Reader reads from /dev/urandom.
 // On Windows systems, Reader uses the CryptGenRandom API.
 // On Wasm, Reader uses the Web Crypto API.
+//
+// In FIPS 140 mode, Reader is a CTR_DRBG, as specified in NIST SP 800-90A,
+// seeded from the sources above.
 var Reader io.Reader
 
 // Read is a helper function that calls Reader.Read using io.ReadFull.
@@ -17,7 +17,7 @@ import (
 
 // Implemented by using Windows CryptoAPI 2.0.
 
-func init() { Reader = &rngReader{} }
+func init() { Reader = fipsReader(&rngReader{}) }
 
 // A rngReader satisfies reads by reading from the Windows CryptGenRandom API.
 type rngReader struct {
@@ -24,18 +24,14 @@ package rsa
 
 import (
 	"crypto"
-	"crypto/rand"
+	"crypto/internal/fips/rsa"
 	"crypto/subtle"
 	"errors"
 	"hash"
 	"io"
-	"math"
 	"math/big"
-
-	"crypto/internal/randutil"
 )
 
-var bigZero = big.NewInt(0)
 var bigOne = big.NewInt(1)
 
 // A PublicKey represents the public part of an RSA key.
@@ -220,94 +216,16 @@ func GenerateKey(random io.Reader, bits int) (*PrivateKey, error) {
 // [1] US patent 4405829 (1972, expired)
 // [2] http://www.cacr.math.uwaterloo.ca/techreports/2006/cacr2006-16.pdf
 func GenerateMultiPrimeKey(random io.Reader, nprimes int, bits int) (*PrivateKey, error) {
-	randutil.MaybeReadByte(random)
-
-	priv := new(PrivateKey)
-	priv.E = 65537
-
-	if nprimes < 2 {
-		return nil, errors.New("crypto/rsa: GenerateMultiPrimeKey: nprimes must be >= 2")
+	k, err := rsa.GenerateMultiPrimeKey(random, nprimes, bits)
+	if err != nil {
+		return nil, err
 	}
-
-	if bits < 64 {
-		primeLimit := float64(uint64(1) << uint(bits/nprimes))
-		// pi approximates the number of primes less than primeLimit
-		pi := primeLimit / (math.Log(primeLimit) - 1)
-		// Generated primes start with 11 (in binary) so we can only
-		// use a quarter of them.
-		pi /= 4
-		// Use a factor of two to ensure that key generation terminates
-		// in a reasonable amount of time.
-		pi /= 2
-		if pi <= float64(nprimes) {
-			return nil, errors.New("crypto/rsa: too few primes of given length to generate an RSA key")
-		}
+	priv := &PrivateKey{
+		PublicKey: PublicKey{N: k.N, E: k.E},
+		D:         k.D,
+		Primes:    k.Primes,
 	}
-
-	primes := make([]*big.Int, nprimes)
-
-NextSetOfPrimes:
-	for {
-		todo := bits
-		// crypto/rand should set the top two bits in each prime.
-		// Thus each prime has the form
-		//   p_i = 2^bitlen(p_i) × 0.11... (in base 2).
-		// And the product is:
-		//   P = 2^todo × α
-		// where α is the product of nprimes numbers of the form 0.11...
-		//
-		// If α < 1/2 (which can happen for nprimes > 2), we need to
-		// shift todo to compensate for lost bits: the mean value of 0.11...
-		// is 7/8, so todo + shift - nprimes * log2(7/8) ~= bits - 1/2
-		// will give good results.
-		if nprimes >= 7 {
-			todo += (nprimes - 2) / 5
-		}
-		for i := 0; i < nprimes; i++ {
-			var err error
-			primes[i], err = rand.Prime(random, todo/(nprimes-i))
-			if err != nil {
-				return nil, err
-			}
-			todo -= primes[i].BitLen()
-		}
-
-		// Make sure that primes is pairwise unequal.
-		for i, prime := range primes {
-			for j := 0; j < i; j++ {
-				if prime.Cmp(primes[j]) == 0 {
-					continue NextSetOfPrimes
-				}
-			}
-		}
-
-		n := new(big.Int).Set(bigOne)
-		totient := new(big.Int).Set(bigOne)
-		pminus1 := new(big.Int)
-		for _, prime := range primes {
-			n.Mul(n, prime)
-			pminus1.Sub(prime, bigOne)
-			totient.Mul(totient, pminus1)
-		}
-		if n.BitLen() != bits {
-			// This should never happen for nprimes == 2 because
-			// crypto/rand should set the top two bits in each prime.
-			// For nprimes > 2 we hope it does not happen often.
-			continue NextSetOfPrimes
-		}
-
-		priv.D = new(big.Int)
-		e := big.NewInt(int64(priv.E))
-		ok := priv.D.ModInverse(e, totient)
-
-		if ok != nil {
-			priv.Primes = primes
-			priv.N = n
-			break
-		}
-	}
-
-	priv.Precompute()
+	priv.Precomputed = precomputedFromFIPS(&k.Precomputed)
 	return priv, nil
 }
 
@@ -348,12 +266,10 @@ func mgf1XOR(out []byte, hash hash.Hash, seed []byte) {
 
 // ErrMessageTooLong is returned when attempting to encrypt a message which is
 // too large for the size of the public key.
-var ErrMessageTooLong = errors.New("crypto/rsa: message too long for RSA public key size")
+var ErrMessageTooLong = rsa.ErrMessageTooLong
 
 func encrypt(c *big.Int, pub *PublicKey, m *big.Int) *big.Int {
-	e := big.NewInt(int64(pub.E))
-	c.Exp(m, e, pub.N)
-	return c
+	return rsa.Encrypt(c, fipsPublicKey(pub), m)
 }
 
 // EncryptOAEP encrypts the given message with RSA-OAEP.
@@ -420,11 +336,11 @@ func EncryptOAEP(hash hash.Hash, random io.Reader, pub *PublicKey, msg []byte, l
 
 // ErrDecryption represents a failure to decrypt a message.
 // It is deliberately vague to avoid adaptive attacks.
-var ErrDecryption = errors.New("crypto/rsa: decryption error")
+var ErrDecryption = rsa.ErrDecryption
 
 // ErrVerification represents a failure to verify a signature.
 // It is deliberately vague to avoid adaptive attacks.
-var ErrVerification = errors.New("crypto/rsa: verification error")
+var ErrVerification = rsa.ErrVerification
 
 // Precompute performs some calculations that speed up private key operations
 // in the future.
@@ -432,126 +348,55 @@ func (priv *PrivateKey) Precompute() {
 	if priv.Precomputed.Dp != nil {
 		return
 	}
-
-	priv.Precomputed.Dp = new(big.Int).Sub(priv.Primes[0], bigOne)
-	priv.Precomputed.Dp.Mod(priv.D, priv.Precomputed.Dp)
-
-	priv.Precomputed.Dq = new(big.Int).Sub(priv.Primes[1], bigOne)
-	priv.Precomputed.Dq.Mod(priv.D, priv.Precomputed.Dq)
-
-	priv.Precomputed.Qinv = new(big.Int).ModInverse(priv.Primes[1], priv.Primes[0])
-
-	r := new(big.Int).Mul(priv.Primes[0], priv.Primes[1])
-	priv.Precomputed.CRTValues = make([]CRTValue, len(priv.Primes)-2)
-	for i := 2; i < len(priv.Primes); i++ {
-		prime := priv.Primes[i]
-		values := &priv.Precomputed.CRTValues[i-2]
-
-		values.Exp = new(big.Int).Sub(prime, bigOne)
-		values.Exp.Mod(priv.D, values.Exp)
-
-		values.R = new(big.Int).Set(r)
-		values.Coeff = new(big.Int).ModInverse(r, prime)
-
-		r.Mul(r, prime)
-	}
+	k := fipsPrivateKey(priv)
+	k.Precompute()
+	priv.Precomputed = precomputedFromFIPS(&k.Precomputed)
 }
 
 // decrypt performs an RSA decryption, resulting in a plaintext integer. If a
 // random source is given, RSA blinding is used.
 func decrypt(random io.Reader, priv *PrivateKey, c *big.Int) (m *big.Int, err error) {
-	// TODO(agl): can we get away with reusing blinds?
-	if c.Cmp(priv.N) > 0 {
-		err = ErrDecryption
-		return
-	}
-	if priv.N.Sign() == 0 {
-		return nil, ErrDecryption
-	}
-
-	var ir *big.Int
-	if random != nil {
-		randutil.MaybeReadByte(random)
-
-		// Blinding enabled. Blinding involves multiplying c by r^e.
-		// Then the decryption operation performs (m^e * r^e)^d mod n
-		// which equals mr mod n. The factor of r can then be removed
-		// by multiplying by the multiplicative inverse of r.
-
-		var r *big.Int
-		ir = new(big.Int)
-		for {
-			r, err = rand.Int(random, priv.N)
-			if err != nil {
-				return
-			}
-			if r.Cmp(bigZero) == 0 {
-				r = bigOne
-			}
-			ok := ir.ModInverse(r, priv.N)
-			if ok != nil {
-				break
-			}
-		}
-		bigE := big.NewInt(int64(priv.E))
-		rpowe := new(big.Int).Exp(r, bigE, priv.N) // N != 0
-		cCopy := new(big.Int).Set(c)
-		cCopy.Mul(cCopy, rpowe)
-		cCopy.Mod(cCopy, priv.N)
-		c = cCopy
-	}
-
-	if priv.Precomputed.Dp == nil {
-		m = new(big.Int).Exp(c, priv.D, priv.N)
-	} else {
-		// We have the precalculated values needed for the CRT.
-		m = new(big.Int).Exp(c, priv.Precomputed.Dp, priv.Primes[0])
-		m2 := new(big.Int).Exp(c, priv.Precomputed.Dq, priv.Primes[1])
-		m.Sub(m, m2)
-		if m.Sign() < 0 {
-			m.Add(m, priv.Primes[0])
-		}
-		m.Mul(m, priv.Precomputed.Qinv)
-		m.Mod(m, priv.Primes[0])
-		m.Mul(m, priv.Primes[1])
-		m.Add(m, m2)
-
-		for i, values := range priv.Precomputed.CRTValues {
-			prime := priv.Primes[2+i]
-			m2.Exp(c, values.Exp, prime)
-			m2.Sub(m2, m)
-			m2.Mul(m2, values.Coeff)
-			m2.Mod(m2, prime)
-			if m2.Sign() < 0 {
-				m2.Add(m2, prime)
-			}
-			m2.Mul(m2, values.R)
-			m.Add(m, m2)
-		}
-	}
+	return rsa.Decrypt(random, fipsPrivateKey(priv), c)
+}
 
-	if ir != nil {
-		// Unblind.
-		m.Mul(m, ir)
-		m.Mod(m, priv.N)
-	}
+func decryptAndCheck(random io.Reader, priv *PrivateKey, c *big.Int) (m *big.Int, err error) {
+	return rsa.DecryptAndCheck(random, fipsPrivateKey(priv), c)
+}
 
-	return
+// fipsPublicKey returns pub as a key of the FIPS 140 module.
+func fipsPublicKey(pub *PublicKey) *rsa.PublicKey {
+	return &rsa.PublicKey{N: pub.N, E: pub.E}
 }
 
-func decryptAndCheck(random io.Reader, priv *PrivateKey, c *big.Int) (m *big.Int, err error) {
-	m, err = decrypt(random, priv, c)
-	if err != nil {
-		return nil, err
+// fipsPrivateKey returns priv as a key of the FIPS 140 module.
+func fipsPrivateKey(priv *PrivateKey) *rsa.PrivateKey {
+	k := &rsa.PrivateKey{
+		PublicKey: rsa.PublicKey{N: priv.N, E: priv.E},
+		D:         priv.D,
+		Primes:    priv.Primes,
+	}
+	k.Precomputed.Dp = priv.Precomputed.Dp
+	k.Precomputed.Dq = priv.Precomputed.Dq
+	k.Precomputed.Qinv = priv.Precomputed.Qinv
+	if priv.Precomputed.CRTValues != nil {
+		k.Precomputed.CRTValues = make([]rsa.CRTValue, len(priv.Precomputed.CRTValues))
+		for i, v := range priv.Precomputed.CRTValues {
+			k.Precomputed.CRTValues[i] = rsa.CRTValue(v)
+		}
 	}
+	return k
+}
 
-	// In order to defend against errors in the CRT computation, m^e is
-	// calculated, which should match the original ciphertext.
-	check := encrypt(new(big.Int), &priv.PublicKey, m)
-	if c.Cmp(check) != 0 {
-		return nil, errors.New("rsa: internal error")
+// precomputedFromFIPS returns the values precomputed by the FIPS 140 module.
+func precomputedFromFIPS(v *rsa.PrecomputedValues) PrecomputedValues {
+	p := PrecomputedValues{Dp: v.Dp, Dq: v.Dq, Qinv: v.Qinv}
+	if v.CRTValues != nil {
+		p.CRTValues = make([]CRTValue, len(v.CRTValues))
+		for i, c := range v.CRTValues {
+			p.CRTValues[i] = CRTValue(c)
+		}
 	}
-	return m, nil
+	return p
 }
 
 // DecryptOAEP decrypts ciphertext using RSA-OAEP.
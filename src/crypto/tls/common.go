@@ -7,6 +7,7 @@ package tls
 import (
 	"container/list"
 	"crypto"
+	"crypto/internal/fips"
 	"crypto/rand"
 	"crypto/sha512"
 	"crypto/x509"
@@ -94,6 +95,8 @@ const (
 	extensionSignatureAlgorithmsCert uint16 = 50
 	extensionKeyShare                uint16 = 51
 	extensionNextProtoNeg            uint16 = 13172 // not IANA assigned
+	extensionECHOuterExtensions      uint16 = 0xfd00
+	extensionEncryptedClientHello    uint16 = 0xfe0d
 	extensionRenegotiationInfo       uint16 = 0xff01
 )
 
@@ -106,7 +109,8 @@ const (
 // https://www.iana.org/assignments/tls-parameters/tls-parameters.xml#tls-parameters-8.
 //
 // In TLS 1.3, this type is called NamedGroup, but at this time this library
-// only supports Elliptic Curve based groups. See RFC 8446, Section 4.2.7.
+// only supports Elliptic Curve based groups and the X25519MLKEM768 hybrid
+// group. See RFC 8446, Section 4.2.7.
 type CurveID uint16
 
 const (
@@ -114,6 +118,11 @@ const (
 	CurveP384 CurveID = 24
 	CurveP521 CurveID = 25
 	X25519    CurveID = 29
+
+	// X25519MLKEM768 is a hybrid key exchange combining X25519 with the
+	// post-quantum ML-KEM-768 key encapsulation method. It is only
+	// supported in TLS 1.3. See draft-kwiatkowski-tls-ecdhe-mlkem-02.
+	X25519MLKEM768 CurveID = 4588
 )
 
 // TLS 1.3 Key Share. See RFC 8446, Section 4.2.8.
@@ -208,6 +217,7 @@ type ConnectionState struct {
 	VerifiedChains              [][]*x509.Certificate // verified chains built from PeerCertificates
 	SignedCertificateTimestamps [][]byte              // SCTs from the peer, if any
 	OCSPResponse                []byte                // stapled OCSP response from peer, if any
+	ECHAccepted                 bool                  // Encrypted Client Hello was offered and accepted
 
 	// ekm is a closure exposed via ExportKeyingMaterial.
 	ekm func(label string, context []byte, length int) ([]byte, error)
@@ -252,23 +262,6 @@ func requiresClientCert(c ClientAuthType) bool {
 	}
 }
 
-// ClientSessionState contains the state needed by clients to resume TLS
-// sessions.
-type ClientSessionState struct {
-	sessionTicket      []uint8               // Encrypted ticket used for session resumption with server
-	vers               uint16                // SSL/TLS version negotiated for the session
-	cipherSuite        uint16                // Ciphersuite negotiated for the session
-	masterSecret       []byte                // Full handshake MasterSecret, or TLS 1.3 resumption_master_secret
-	serverCertificates []*x509.Certificate   // Certificate chain presented by the server
-	verifiedChains     [][]*x509.Certificate // Certificate chains we built for verification
-	receivedAt         time.Time             // When the session ticket was received from the server
-
-	// TLS 1.3 fields.
-	nonce  []byte    // Ticket nonce sent by the server, to derive PSK
-	useBy  time.Time // Expiration of the ticket lifetime as set by the server
-	ageAdd uint32    // Random obfuscation factor for sending the ticket age
-}
-
 // ClientSessionCache is a cache of ClientSessionState objects that can be used
 // by a client to resume a TLS session with a given server. ClientSessionCache
 // implementations should expect to be called concurrently from different
@@ -550,6 +543,33 @@ type Config struct {
 	// connections using that key might be compromised.
 	SessionTicketKey [32]byte
 
+	// UnwrapSession is called on the server to turn a ticket/identity
+	// previously produced by WrapSession into a usable session.
+	//
+	// UnwrapSession will usually either decrypt a session state in the ticket
+	// (for example with Config.EncryptTicket), or use the ticket as a handle
+	// to recover a previously stored state. It must use ParseSessionState to
+	// deserialize the session state.
+	//
+	// If UnwrapSession returns an error, the connection is terminated. If it
+	// returns (nil, nil), the session is ignored. crypto/tls may still choose
+	// not to resume the returned session.
+	UnwrapSession func(identity []byte, cs ConnectionState) (*SessionState, error)
+
+	// WrapSession is called on the server to produce a session ticket. It
+	// must serialize the session state with SessionState.Bytes. It may add
+	// application data to SessionState.Extra before doing so.
+	//
+	// Warning: the return value will be exposed on the wire and to clients in
+	// plaintext. The application is in charge of encrypting and authenticating
+	// it (and rotating keys) or returning high-entropy identifiers. Failing to
+	// do so correctly can compromise current, previous, and future connections
+	// depending on the protocol version.
+	//
+	// If nil, Config.EncryptTicket is used. SessionTicketsDisabled takes
+	// precedence over both WrapSession and UnwrapSession.
+	WrapSession func(ConnectionState, *SessionState) ([]byte, error)
+
 	// ClientSessionCache is a cache of ClientSessionState entries for TLS
 	// session resumption. It is only used by clients.
 	ClientSessionCache ClientSessionCache
@@ -567,6 +587,10 @@ type Config struct {
 	// an ECDHE handshake, in preference order. If empty, the default will
 	// be used. The client will use the first preference as the type for
 	// its key share in TLS 1.3. This may change in the future.
+	//
+	// X25519MLKEM768 is ignored when negotiating TLS 1.2. When it is the
+	// client's first preference and X25519 is also listed, the client sends
+	// an X25519 key share alongside the hybrid one.
 	CurvePreferences []CurveID
 
 	// DynamicRecordSizingDisabled disables adaptive sizing of TLS records.
@@ -587,6 +611,29 @@ type Config struct {
 	// used for debugging.
 	KeyLogWriter io.Writer
 
+	// EncryptedClientHelloConfigList is a serialized ECHConfigList. If set,
+	// clients will attempt to connect using Encrypted Client Hello (ECH),
+	// using the first supported ECHConfig in the list, and the real
+	// ServerName will be only sent encrypted. ECH requires TLS 1.3, so only
+	// TLS 1.3 is offered, and the handshake fails if MaxVersion is lower.
+	//
+	// If the server rejects ECH, the client verifies the server certificate
+	// against the public name of the ECHConfig, completes the handshake
+	// without sending any client certificate, and then aborts it returning
+	// an *ECHRejectionError, which may contain a new ECHConfigList to retry
+	// the connection with.
+	//
+	// See draft-ietf-tls-esni-18.
+	EncryptedClientHelloConfigList []byte
+
+	// EncryptedClientHelloKeys are the ECH configurations and private keys
+	// a server uses to decrypt the ClientHellos of clients attempting ECH.
+	// If a client's ClientHello can't be decrypted with any of them, the
+	// handshake continues using the outer ClientHello, and the Config fields
+	// of the keys with SendAsRetry set are offered to the client as retry
+	// configurations.
+	EncryptedClientHelloKeys []EncryptedClientHelloKey
+
 	serverInitOnce sync.Once // guards calling (*Config).serverInit
 
 	// mutex protects sessionTicketKeys.
@@ -598,6 +645,22 @@ type Config struct {
 	sessionTicketKeys []ticketKey
 }
 
+// EncryptedClientHelloKey holds a private key that is associated with a
+// specific ECH config known to a client.
+type EncryptedClientHelloKey struct {
+	// Config should be a marshalled ECHConfig associated with PrivateKey.
+	// This must match the config provided to clients byte-for-byte.
+	Config []byte
+	// PrivateKey should be a marshalled private key for the KEM of Config,
+	// in the form used by crypto/hpke. A Config for a key pair generated
+	// with hpke.GenerateKey can be built with MarshalECHConfig.
+	PrivateKey []byte
+	// SendAsRetry indicates if Config should be sent as part of the list of
+	// retry configs when ECH is requested by the client but rejected by the
+	// server.
+	SendAsRetry bool
+}
+
 // ticketKeyNameLen is the number of bytes of identifier that is prepended to
 // an encrypted session ticket in order to identify the key used to encrypt it.
 const ticketKeyNameLen = 16
@@ -639,32 +702,36 @@ func (c *Config) Clone() *Config {
 	c.mutex.RUnlock()
 
 	return &Config{
-		Rand:                        c.Rand,
-		Time:                        c.Time,
-		Certificates:                c.Certificates,
-		NameToCertificate:           c.NameToCertificate,
-		GetCertificate:              c.GetCertificate,
-		GetClientCertificate:        c.GetClientCertificate,
-		GetConfigForClient:          c.GetConfigForClient,
-		VerifyPeerCertificate:       c.VerifyPeerCertificate,
-		RootCAs:                     c.RootCAs,
-		NextProtos:                  c.NextProtos,
-		ServerName:                  c.ServerName,
-		ClientAuth:                  c.ClientAuth,
-		ClientCAs:                   c.ClientCAs,
-		InsecureSkipVerify:          c.InsecureSkipVerify,
-		CipherSuites:                c.CipherSuites,
-		PreferServerCipherSuites:    c.PreferServerCipherSuites,
-		SessionTicketsDisabled:      c.SessionTicketsDisabled,
-		SessionTicketKey:            c.SessionTicketKey,
-		ClientSessionCache:          c.ClientSessionCache,
-		MinVersion:                  c.MinVersion,
-		MaxVersion:                  c.MaxVersion,
-		CurvePreferences:            c.CurvePreferences,
-		DynamicRecordSizingDisabled: c.DynamicRecordSizingDisabled,
-		Renegotiation:               c.Renegotiation,
-		KeyLogWriter:                c.KeyLogWriter,
-		sessionTicketKeys:           sessionTicketKeys,
+		Rand:                           c.Rand,
+		Time:                           c.Time,
+		Certificates:                   c.Certificates,
+		NameToCertificate:              c.NameToCertificate,
+		GetCertificate:                 c.GetCertificate,
+		GetClientCertificate:           c.GetClientCertificate,
+		GetConfigForClient:             c.GetConfigForClient,
+		VerifyPeerCertificate:          c.VerifyPeerCertificate,
+		RootCAs:                        c.RootCAs,
+		NextProtos:                     c.NextProtos,
+		ServerName:                     c.ServerName,
+		ClientAuth:                     c.ClientAuth,
+		ClientCAs:                      c.ClientCAs,
+		InsecureSkipVerify:             c.InsecureSkipVerify,
+		CipherSuites:                   c.CipherSuites,
+		PreferServerCipherSuites:       c.PreferServerCipherSuites,
+		SessionTicketsDisabled:         c.SessionTicketsDisabled,
+		SessionTicketKey:               c.SessionTicketKey,
+		UnwrapSession:                  c.UnwrapSession,
+		WrapSession:                    c.WrapSession,
+		ClientSessionCache:             c.ClientSessionCache,
+		MinVersion:                     c.MinVersion,
+		MaxVersion:                     c.MaxVersion,
+		CurvePreferences:               c.CurvePreferences,
+		DynamicRecordSizingDisabled:    c.DynamicRecordSizingDisabled,
+		Renegotiation:                  c.Renegotiation,
+		KeyLogWriter:                   c.KeyLogWriter,
+		EncryptedClientHelloConfigList: c.EncryptedClientHelloConfigList,
+		EncryptedClientHelloKeys:       c.EncryptedClientHelloKeys,
+		sessionTicketKeys:              sessionTicketKeys,
 	}
 }
 
@@ -752,6 +819,9 @@ func (c *Config) cipherSuites() []uint16 {
 	if s == nil {
 		s = defaultCipherSuites()
 	}
+	if fips.Enabled {
+		s = fipsFilterCipherSuites(s, fipsCipherSuites)
+	}
 	return s
 }
 
@@ -776,6 +846,9 @@ func (c *Config) supportedVersions(isClient bool) []uint16 {
 		if isClient && v < VersionTLS10 {
 			continue
 		}
+		if fips.Enabled && !fipsAllowedVersion(v) {
+			continue
+		}
 		// TLS 1.3 is opt-out in Go 1.13.
 		if v == VersionTLS13 && !isTLS13Supported() {
 			continue
@@ -800,6 +873,22 @@ func isTLS13Supported() bool {
 	return tls13Support.cached
 }
 
+// mlkemSupport caches the result for isMLKEMEnabled.
+var mlkemSupport struct {
+	sync.Once
+	cached bool
+}
+
+// isMLKEMEnabled returns whether X25519MLKEM768 should be part of the default
+// curve preferences, that is, whether the program didn't opt out with
+// GODEBUG=tlsmlkem=0. It's cached after the first execution.
+func isMLKEMEnabled() bool {
+	mlkemSupport.Do(func() {
+		mlkemSupport.cached = goDebugString("tlsmlkem") != "0"
+	})
+	return mlkemSupport.cached
+}
+
 // goDebugString returns the value of the named GODEBUG key.
 // GODEBUG is of the form "key=val,key2=val2".
 func goDebugString(key string) string {
@@ -847,11 +936,34 @@ func supportedVersionsFromMax(maxVersion uint16) []uint16 {
 
 var defaultCurvePreferences = []CurveID{X25519, CurveP256, CurveP384, CurveP521}
 
-func (c *Config) curvePreferences() []CurveID {
+var defaultCurvePreferencesWithMLKEM = []CurveID{X25519MLKEM768, X25519, CurveP256, CurveP384, CurveP521}
+
+// curvePreferences returns the groups that may be used at the given protocol
+// version, in preference order.
+func (c *Config) curvePreferences(version uint16) []CurveID {
+	var curvePreferences []CurveID
 	if c == nil || len(c.CurvePreferences) == 0 {
-		return defaultCurvePreferences
+		curvePreferences = defaultCurvePreferences
+		if isMLKEMEnabled() {
+			curvePreferences = defaultCurvePreferencesWithMLKEM
+		}
+	} else {
+		curvePreferences = c.CurvePreferences
+	}
+	if fips.Enabled {
+		curvePreferences = fipsFilterCurves(curvePreferences)
+	}
+	if version >= VersionTLS13 {
+		return curvePreferences
 	}
-	return c.CurvePreferences
+	// The hybrid group is only defined for TLS 1.3.
+	filtered := make([]CurveID, 0, len(curvePreferences))
+	for _, id := range curvePreferences {
+		if id != X25519MLKEM768 {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered
 }
 
 // mutualVersion returns the protocol version to use given the advertised
@@ -1157,6 +1269,10 @@ NextCipherSuite:
 		}
 		varDefaultCipherSuites = append(varDefaultCipherSuites, suite.id)
 	}
+
+	if fips.Enabled {
+		varDefaultCipherSuitesTLS13 = fipsFilterCipherSuites(varDefaultCipherSuitesTLS13, fipsCipherSuitesTLS13)
+	}
 }
 
 func unexpectedMessageError(wanted, got interface{}) error {
@@ -0,0 +1,784 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"crypto/ecdsa"
+	"crypto/hpke"
+	"crypto/internal/fips"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/cryptobyte"
+	"hash"
+	"io"
+)
+
+// This file implements Encrypted Client Hello, as specified in
+// draft-ietf-tls-esni-18. The client sends a ClientHelloOuter with the public
+// name of the client-facing server, carrying an encrypted ClientHelloInner
+// with the real server name. If the server can decrypt it, it continues the
+// handshake with the ClientHelloInner, and signals acceptance in the random
+// of the ServerHello.
+
+// ECHClientHello types. See draft-ietf-tls-esni-18, Section 5.
+const (
+	echClientHelloOuterType uint8 = 0
+	echClientHelloInnerType uint8 = 1
+)
+
+// echConfigVersion is the only supported ECHConfig version.
+const echConfigVersion = extensionEncryptedClientHello
+
+// echAcceptConfirmationLength is the length of the ECH acceptance signal.
+const echAcceptConfirmationLength = 8
+
+// ECHRejectionError is the error type returned when ECH is rejected by a
+// remote server. If the server offered an ECHConfigList to use for retries,
+// the RetryConfigList field will contain it.
+//
+// The client may treat an ECHRejectionError with an empty RetryConfigList as
+// a secure signal from the server that it doesn't support ECH.
+type ECHRejectionError struct {
+	RetryConfigList []byte
+}
+
+func (e *ECHRejectionError) Error() string {
+	return "tls: server rejected ECH"
+}
+
+type echCipherSuite struct {
+	kdfID  uint16
+	aeadID uint16
+}
+
+// echConfig is a parsed ECHConfig. See draft-ietf-tls-esni-18, Section 4.
+type echConfig struct {
+	raw []byte // including the version and length fields
+
+	version       uint16
+	configID      uint8
+	kemID         uint16
+	publicKey     []byte
+	cipherSuites  []echCipherSuite
+	maxNameLength uint8
+	publicName    []byte
+
+	// hasMandatoryExtension is set if the config has an extension we don't
+	// support, but the client must not ignore.
+	hasMandatoryExtension bool
+}
+
+// parseECHConfig parses a single ECHConfig from s. It returns false if the
+// encoding is invalid. Configs with unknown versions are returned with only
+// the version and raw fields set.
+func parseECHConfig(s *cryptobyte.String) (echConfig, bool) {
+	var config echConfig
+	orig := *s
+	var contents cryptobyte.String
+	if !s.ReadUint16(&config.version) ||
+		!s.ReadUint16LengthPrefixed(&contents) {
+		return config, false
+	}
+	config.raw = orig[:len(orig)-len(*s)]
+	if config.version != echConfigVersion {
+		return config, true
+	}
+
+	var cipherSuites, extensions cryptobyte.String
+	if !contents.ReadUint8(&config.configID) ||
+		!contents.ReadUint16(&config.kemID) ||
+		!readUint16LengthPrefixed(&contents, &config.publicKey) ||
+		len(config.publicKey) == 0 ||
+		!contents.ReadUint16LengthPrefixed(&cipherSuites) ||
+		cipherSuites.Empty() {
+		return config, false
+	}
+	for !cipherSuites.Empty() {
+		var suite echCipherSuite
+		if !cipherSuites.ReadUint16(&suite.kdfID) ||
+			!cipherSuites.ReadUint16(&suite.aeadID) {
+			return config, false
+		}
+		config.cipherSuites = append(config.cipherSuites, suite)
+	}
+	if !contents.ReadUint8(&config.maxNameLength) ||
+		!readUint8LengthPrefixed(&contents, &config.publicName) ||
+		len(config.publicName) == 0 ||
+		!contents.ReadUint16LengthPrefixed(&extensions) ||
+		!contents.Empty() {
+		return config, false
+	}
+	for !extensions.Empty() {
+		var extType uint16
+		var extData cryptobyte.String
+		if !extensions.ReadUint16(&extType) ||
+			!extensions.ReadUint16LengthPrefixed(&extData) {
+			return config, false
+		}
+		// Extensions with the high bit set are mandatory, and none are
+		// currently defined. See draft-ietf-tls-esni-18, Section 4.2.
+		if extType&0x8000 != 0 {
+			config.hasMandatoryExtension = true
+		}
+	}
+
+	return config, true
+}
+
+// parseECHConfigList parses an ECHConfigList, as found in
+// Config.EncryptedClientHelloConfigList and in retry_configs.
+func parseECHConfigList(data []byte) ([]echConfig, error) {
+	s := cryptobyte.String(data)
+	var list cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&list) || !s.Empty() || list.Empty() {
+		return nil, errors.New("tls: malformed ECHConfigList")
+	}
+	var configs []echConfig
+	for !list.Empty() {
+		config, ok := parseECHConfig(&list)
+		if !ok {
+			return nil, errors.New("tls: malformed ECHConfig")
+		}
+		configs = append(configs, config)
+	}
+	return configs, nil
+}
+
+// echConfigCipherSuites are the HPKE cipher suites offered by the configs
+// returned by MarshalECHConfig.
+var echConfigCipherSuites = []echCipherSuite{
+	{hpke.KDF_HKDF_SHA256, hpke.AEAD_AES_128_GCM},
+	{hpke.KDF_HKDF_SHA256, hpke.AEAD_AES_256_GCM},
+	{hpke.KDF_HKDF_SHA256, hpke.AEAD_ChaCha20Poly1305},
+}
+
+// MarshalECHConfig returns an ECHConfig for the public key pub of the HPKE
+// KEM identified by kemID, such as hpke.DHKEM_X25519_HKDF_SHA256, for use in
+// EncryptedClientHelloKey.Config along with the matching private key. A key
+// pair can be generated with hpke.GenerateKey.
+//
+// configID identifies the config among those of the server, and publicName
+// is the name of the client-facing server, which clients send in the clear.
+// Clients pad the encrypted server name to at least maxNameLength bytes.
+// The config offers HKDF-SHA256 with AES-128-GCM, AES-256-GCM and
+// ChaCha20-Poly1305.
+func MarshalECHConfig(configID uint8, kemID uint16, pub []byte, publicName string, maxNameLength uint8) ([]byte, error) {
+	if !hpke.SupportedKEM(kemID) {
+		return nil, errors.New("tls: unsupported ECH KEM")
+	}
+	if len(pub) == 0 || len(pub) > 0xffff {
+		return nil, errors.New("tls: invalid ECH public key")
+	}
+	if len(publicName) == 0 || len(publicName) > 255 {
+		return nil, errors.New("tls: invalid ECH public name")
+	}
+
+	var b cryptobyte.Builder
+	b.AddUint16(echConfigVersion)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint8(configID)
+		b.AddUint16(kemID)
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(pub)
+		})
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, suite := range echConfigCipherSuites {
+				b.AddUint16(suite.kdfID)
+				b.AddUint16(suite.aeadID)
+			}
+		})
+		b.AddUint8(maxNameLength)
+		b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes([]byte(publicName))
+		})
+		b.AddUint16(0) // extensions
+	})
+	return b.Bytes()
+}
+
+// MarshalECHConfigList returns an ECHConfigList containing configs, in
+// order of preference. The list is what clients use as
+// Config.EncryptedClientHelloConfigList, and what servers publish, for
+// example in DNS. Each config must be a single ECHConfig, such as one
+// returned by MarshalECHConfig.
+func MarshalECHConfigList(configs [][]byte) ([]byte, error) {
+	if len(configs) == 0 {
+		return nil, errors.New("tls: empty ECHConfigList")
+	}
+	for _, c := range configs {
+		s := cryptobyte.String(c)
+		if _, ok := parseECHConfig(&s); !ok || !s.Empty() {
+			return nil, errors.New("tls: malformed ECHConfig")
+		}
+	}
+	var b cryptobyte.Builder
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, c := range configs {
+			b.AddBytes(c)
+		}
+	})
+	return b.Bytes()
+}
+
+// pickECHCipherSuite returns the first HPKE cipher suite in suites that is
+// supported by this implementation.
+func pickECHCipherSuite(suites []echCipherSuite) (echCipherSuite, bool) {
+	for _, suite := range suites {
+		if hpke.SupportedKDF(suite.kdfID) && hpke.SupportedAEAD(suite.aeadID) {
+			return suite, true
+		}
+	}
+	return echCipherSuite{}, false
+}
+
+// pickECHConfig returns the first config in the list the client can use.
+func pickECHConfig(list []echConfig) *echConfig {
+	for i := range list {
+		config := &list[i]
+		if config.version != echConfigVersion ||
+			config.hasMandatoryExtension ||
+			!hpke.SupportedKEM(config.kemID) {
+			continue
+		}
+		if _, ok := pickECHCipherSuite(config.cipherSuites); !ok {
+			continue
+		}
+		return config
+	}
+	return nil
+}
+
+// echClientContext holds the client state of an ECH handshake.
+type echClientContext struct {
+	config          *echConfig
+	suite           echCipherSuite
+	hpkeContext     *hpke.Sender
+	encapsulatedKey []byte
+
+	innerHello      *clientHelloMsg
+	innerTranscript hash.Hash
+	outerHello      *clientHelloMsg
+
+	// rejected is set once the server didn't confirm acceptance of ECH, and
+	// retryConfigs are the retry_configs it sent in that case.
+	rejected     bool
+	retryConfigs []byte
+}
+
+// newECHClientContext picks a configuration from the ECHConfigList in the
+// Config, and sets up the HPKE context to encrypt the ClientHelloInner.
+func newECHClientContext(config *Config) (*echClientContext, error) {
+	list, err := parseECHConfigList(config.EncryptedClientHelloConfigList)
+	if err != nil {
+		return nil, err
+	}
+	echConfig := pickECHConfig(list)
+	if echConfig == nil {
+		return nil, errors.New("tls: EncryptedClientHelloConfigList contains no supported configurations")
+	}
+	suite, _ := pickECHCipherSuite(echConfig.cipherSuites)
+
+	info := append([]byte("tls ech\x00"), echConfig.raw...)
+	enc, hpkeContext, err := hpke.SetupSender(echConfig.kemID, suite.kdfID, suite.aeadID,
+		echConfig.publicKey, info, config.rand())
+	if err != nil {
+		return nil, err
+	}
+
+	return &echClientContext{
+		config:          echConfig,
+		suite:           suite,
+		hpkeContext:     hpkeContext,
+		encapsulatedKey: enc,
+	}, nil
+}
+
+// makeOuterClientHello derives the ClientHelloOuter from the ClientHelloInner
+// in ech.innerHello, and encrypts the latter into it.
+func (ech *echClientContext) makeOuterClientHello(rand io.Reader) (*clientHelloMsg, error) {
+	outer := *ech.innerHello
+	outer.raw = nil
+	outer.random = make([]byte, 32)
+	if _, err := io.ReadFull(rand, outer.random); err != nil {
+		return nil, errors.New("tls: short read from Rand: " + err.Error())
+	}
+	outer.serverName = string(ech.config.publicName)
+	// The PSKs are only valid for the server in the ClientHelloInner.
+	outer.pskIdentities = nil
+	outer.pskBinders = nil
+	outer.earlyData = false
+
+	if err := ech.sealInnerClientHello(&outer, ech.encapsulatedKey); err != nil {
+		return nil, err
+	}
+	ech.outerHello = &outer
+	return &outer, nil
+}
+
+// sealInnerClientHello encrypts ech.innerHello into the encrypted_client_hello
+// extension of outer. The encapsulated key is only sent in the first
+// ClientHello, and enc must be empty after a HelloRetryRequest.
+func (ech *echClientContext) sealInnerClientHello(outer *clientHelloMsg, enc []byte) error {
+	encodedInner := encodeInnerClientHello(ech.innerHello, int(ech.config.maxNameLength))
+
+	// The AAD is the ClientHelloOuter with the payload replaced by zeroes.
+	// See draft-ietf-tls-esni-18, Section 5.2.
+	payloadLen := len(encodedInner) + ech.hpkeContext.Overhead()
+	outer.encryptedClientHello = ech.marshalOuterExtension(enc, make([]byte, payloadLen))
+	outer.raw = nil
+	aad := outer.marshal()[4:]
+
+	payload, err := ech.hpkeContext.Seal(aad, encodedInner)
+	if err != nil {
+		return err
+	}
+	outer.encryptedClientHello = ech.marshalOuterExtension(enc, payload)
+	outer.raw = nil
+	return nil
+}
+
+func (ech *echClientContext) marshalOuterExtension(enc, payload []byte) []byte {
+	var b cryptobyte.Builder
+	b.AddUint8(echClientHelloOuterType)
+	b.AddUint16(ech.suite.kdfID)
+	b.AddUint16(ech.suite.aeadID)
+	b.AddUint8(ech.config.configID)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes(enc)
+	})
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes(payload)
+	})
+	return b.BytesOrPanic()
+}
+
+// encodeInnerClientHello returns the EncodedClientHelloInner for inner,
+// without the handshake message header and legacy_session_id, and padded to
+// hide the length of the server name. See draft-ietf-tls-esni-18, Section 6.1.3.
+func encodeInnerClientHello(inner *clientHelloMsg, maxNameLength int) []byte {
+	h := *inner
+	h.raw = nil
+	h.sessionId = nil
+	encoded := h.marshal()[4:]
+
+	var paddingLen int
+	if len(inner.serverName) > 0 {
+		paddingLen = maxNameLength - len(inner.serverName)
+		if paddingLen < 0 {
+			paddingLen = 0
+		}
+	} else {
+		paddingLen = maxNameLength + 9
+	}
+	paddingLen += 31 - ((len(encoded) + paddingLen - 1) % 32)
+
+	return append(encoded, make([]byte, paddingLen)...)
+}
+
+// echAcceptConfirmation computes the acceptance signal that a server sends in
+// the ServerHello random or in the HelloRetryRequest encrypted_client_hello
+// extension, where msg is the message with the signal replaced by zeroes. See
+// draft-ietf-tls-esni-18, Section 7.2.
+func echAcceptConfirmation(suite *cipherSuiteTLS13, innerRandom []byte, label string, transcript hash.Hash, msg []byte) []byte {
+	h := cloneHash(transcript, suite.hash)
+	if h == nil {
+		return nil
+	}
+	h.Write(msg)
+	return suite.expandLabel(suite.extract(innerRandom, nil), label,
+		h.Sum(nil), echAcceptConfirmationLength)
+}
+
+// echServerHelloConfirmationMessage returns a copy of the marshaled
+// ServerHello with the last 8 bytes of the random replaced by zeroes.
+func echServerHelloConfirmationMessage(raw []byte) []byte {
+	const randomEnd = 4 + 2 + 32 // message header, legacy_version, random
+	msg := append([]byte(nil), raw...)
+	for i := randomEnd - echAcceptConfirmationLength; i < randomEnd; i++ {
+		msg[i] = 0
+	}
+	return msg
+}
+
+// echHelloRetryRequestConfirmationMessage returns a copy of the marshaled
+// HelloRetryRequest with the contents of the encrypted_client_hello extension
+// replaced by zeroes.
+func echHelloRetryRequestConfirmationMessage(raw []byte) []byte {
+	msg := append([]byte(nil), raw...)
+	s := cryptobyte.String(raw)
+	var extensions cryptobyte.String
+	if !s.Skip(4+2+32) || // message header, legacy_version, random
+		!s.Skip(1+int(raw[4+2+32])) || // legacy_session_id_echo
+		!s.Skip(2+1) || // cipher_suite, legacy_compression_method
+		!s.ReadUint16LengthPrefixed(&extensions) {
+		return msg
+	}
+	for !extensions.Empty() {
+		var extType uint16
+		var extData cryptobyte.String
+		if !extensions.ReadUint16(&extType) ||
+			!extensions.ReadUint16LengthPrefixed(&extData) {
+			return msg
+		}
+		if extType != extensionEncryptedClientHello {
+			continue
+		}
+		start := len(raw) - len(extensions) - len(extData)
+		for i := start; i < start+len(extData); i++ {
+			msg[i] = 0
+		}
+	}
+	return msg
+}
+
+// verifyECHProviderCertificate parses and verifies the certificates sent by a
+// server that rejected ECH. They must be valid for the public name of the ECH
+// configuration and are not passed to VerifyPeerCertificate, which is meant for
+// the certificates of the server in Config.ServerName.
+func (c *Conn) verifyECHProviderCertificate(certificates [][]byte, publicName string) error {
+	certs := make([]*x509.Certificate, len(certificates))
+	for i, asn1Data := range certificates {
+		cert, err := x509.ParseCertificate(asn1Data)
+		if err != nil {
+			c.sendAlert(alertBadCertificate)
+			return errors.New("tls: failed to parse certificate from server: " + err.Error())
+		}
+		certs[i] = cert
+	}
+
+	if !c.config.InsecureSkipVerify {
+		opts := x509.VerifyOptions{
+			Roots:         c.config.RootCAs,
+			CurrentTime:   c.config.time(),
+			DNSName:       publicName,
+			Intermediates: x509.NewCertPool(),
+		}
+		for _, cert := range certs[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		if _, err := certs[0].Verify(opts); err != nil {
+			c.sendAlert(alertBadCertificate)
+			return err
+		}
+	}
+
+	switch certs[0].PublicKey.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		break
+	default:
+		c.sendAlert(alertUnsupportedCertificate)
+		return fmt.Errorf("tls: server's certificate contains an unsupported type of public key: %T", certs[0].PublicKey)
+	}
+
+	c.peerCertificates = certs
+
+	return nil
+}
+
+// echServerContext holds the server state of an ECH handshake. It's only
+// created if the client offered ECH and the server has keys configured.
+type echServerContext struct {
+	accepted bool
+
+	hpkeContext *hpke.Recipient
+	configID    uint8
+	suite       echCipherSuite
+
+	// retryConfigs is the ECHConfigList sent to clients when ECH is rejected.
+	retryConfigs []byte
+}
+
+// echRetryConfigList returns the ECHConfigList of the keys that are marked
+// to be sent to clients as retry configurations, or nil if there are none.
+func (c *Config) echRetryConfigList() []byte {
+	var b cryptobyte.Builder
+	var any bool
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, key := range c.EncryptedClientHelloKeys {
+			if key.SendAsRetry {
+				b.AddBytes(key.Config)
+				any = true
+			}
+		}
+	})
+	if !any {
+		return nil
+	}
+	return b.BytesOrPanic()
+}
+
+// parseOuterECHExtension parses the contents of an encrypted_client_hello
+// extension of type outer.
+func parseOuterECHExtension(ext []byte) (suite echCipherSuite, configID uint8, enc, payload []byte, ok bool) {
+	s := cryptobyte.String(ext)
+	var echType uint8
+	if !s.ReadUint8(&echType) || echType != echClientHelloOuterType ||
+		!s.ReadUint16(&suite.kdfID) || !s.ReadUint16(&suite.aeadID) ||
+		!s.ReadUint8(&configID) ||
+		!readUint16LengthPrefixed(&s, &enc) ||
+		!readUint16LengthPrefixed(&s, &payload) ||
+		len(payload) == 0 || !s.Empty() {
+		return suite, 0, nil, nil, false
+	}
+	return suite, configID, enc, payload, true
+}
+
+// processECHClientHello attempts to decrypt the ClientHelloInner carried by
+// outer. If ECH is not offered, no keys are configured, or FIPS 140 mode is
+// enabled, it returns outer and a nil context. If decryption fails, it returns outer and a context
+// marking ECH as rejected. Otherwise, it returns the ClientHelloInner.
+func (c *Conn) processECHClientHello(outer *clientHelloMsg) (*clientHelloMsg, *echServerContext, error) {
+	if len(outer.encryptedClientHello) == 0 || len(c.config.EncryptedClientHelloKeys) == 0 || fips.Enabled {
+		return outer, nil, nil
+	}
+
+	echType := outer.encryptedClientHello[0]
+	if echType == echClientHelloInnerType {
+		c.sendAlert(alertIllegalParameter)
+		return nil, nil, errors.New("tls: client sent an inner encrypted_client_hello extension in the ClientHelloOuter")
+	}
+	suite, configID, enc, payload, ok := parseOuterECHExtension(outer.encryptedClientHello)
+	if !ok {
+		c.sendAlert(alertDecodeError)
+		return nil, nil, errors.New("tls: malformed encrypted_client_hello extension")
+	}
+
+	ech := &echServerContext{
+		configID:     configID,
+		suite:        suite,
+		retryConfigs: c.config.echRetryConfigList(),
+	}
+
+	for _, key := range c.config.EncryptedClientHelloKeys {
+		s := cryptobyte.String(key.Config)
+		config, ok := parseECHConfig(&s)
+		if !ok || !s.Empty() {
+			c.sendAlert(alertInternalError)
+			return nil, nil, errors.New("tls: invalid ECHConfig in EncryptedClientHelloKeys")
+		}
+		if config.version != echConfigVersion || config.configID != configID {
+			continue
+		}
+		supportedSuite := false
+		for _, s := range config.cipherSuites {
+			if s == suite {
+				supportedSuite = true
+				break
+			}
+		}
+		if !supportedSuite {
+			continue
+		}
+
+		info := append([]byte("tls ech\x00"), config.raw...)
+		hpkeContext, err := hpke.SetupRecipient(config.kemID, suite.kdfID, suite.aeadID,
+			key.PrivateKey, info, enc)
+		if err != nil {
+			continue
+		}
+		inner, err := decryptInnerClientHello(hpkeContext, outer, payload)
+		if err != nil {
+			continue
+		}
+
+		ech.hpkeContext = hpkeContext
+		ech.accepted = true
+		return inner, ech, nil
+	}
+
+	return outer, ech, nil
+}
+
+// decryptSecondClientHello decrypts the ClientHelloInner carried by the
+// ClientHelloOuter sent after a HelloRetryRequest, once ECH was accepted.
+func (c *Conn) decryptSecondClientHello(ech *echServerContext, outer *clientHelloMsg) (*clientHelloMsg, error) {
+	suite, configID, enc, payload, ok := parseOuterECHExtension(outer.encryptedClientHello)
+	if !ok || suite != ech.suite || configID != ech.configID || len(enc) != 0 {
+		c.sendAlert(alertIllegalParameter)
+		return nil, errors.New("tls: client sent invalid encrypted_client_hello extension in second ClientHello")
+	}
+	inner, err := decryptInnerClientHello(ech.hpkeContext, outer, payload)
+	if err != nil {
+		c.sendAlert(alertDecryptError)
+		return nil, err
+	}
+	return inner, nil
+}
+
+// decryptInnerClientHello decrypts and decodes the ClientHelloInner from the
+// payload of the encrypted_client_hello extension of outer.
+func decryptInnerClientHello(hpkeContext *hpke.Recipient, outer *clientHelloMsg, payload []byte) (*clientHelloMsg, error) {
+	aad, ok := outerClientHelloAAD(outer.marshal(), len(payload))
+	if !ok {
+		return nil, errors.New("tls: malformed ClientHelloOuter")
+	}
+	encodedInner, err := hpkeContext.Open(aad, payload)
+	if err != nil {
+		return nil, err
+	}
+	innerRaw, err := decodeInnerClientHello(outer, encodedInner)
+	if err != nil {
+		return nil, err
+	}
+	inner := new(clientHelloMsg)
+	if !inner.unmarshal(innerRaw) {
+		return nil, errors.New("tls: malformed ClientHelloInner")
+	}
+	if len(inner.encryptedClientHello) != 1 || inner.encryptedClientHello[0] != echClientHelloInnerType {
+		return nil, errors.New("tls: ClientHelloInner lacks an inner encrypted_client_hello extension")
+	}
+	for _, v := range inner.supportedVersions {
+		if v < VersionTLS13 {
+			return nil, errors.New("tls: ClientHelloInner offers a version older than TLS 1.3")
+		}
+	}
+	return inner, nil
+}
+
+// clientHelloExtensions returns the extensions block of a marshaled
+// ClientHello, and its offset in raw.
+func clientHelloExtensions(raw []byte) (cryptobyte.String, int, bool) {
+	s := cryptobyte.String(raw)
+	var ignored, extensions cryptobyte.String
+	if !s.Skip(4+2+32) || // message header, legacy_version, random
+		!s.ReadUint8LengthPrefixed(&ignored) || // legacy_session_id
+		!s.ReadUint16LengthPrefixed(&ignored) || // cipher_suites
+		!s.ReadUint8LengthPrefixed(&ignored) || // legacy_compression_methods
+		!s.ReadUint16LengthPrefixed(&extensions) || !s.Empty() {
+		return nil, 0, false
+	}
+	return extensions, len(raw) - len(extensions), true
+}
+
+// outerClientHelloAAD returns the ClientHelloOuterAAD for the marshaled
+// ClientHelloOuter raw, whose encrypted_client_hello payload is payloadLen
+// bytes long. See draft-ietf-tls-esni-18, Section 5.2.
+func outerClientHelloAAD(raw []byte, payloadLen int) ([]byte, bool) {
+	extensions, _, ok := clientHelloExtensions(raw)
+	if !ok {
+		return nil, false
+	}
+	aad := append([]byte(nil), raw...)
+	for !extensions.Empty() {
+		var extType uint16
+		var extData cryptobyte.String
+		if !extensions.ReadUint16(&extType) ||
+			!extensions.ReadUint16LengthPrefixed(&extData) {
+			return nil, false
+		}
+		if extType != extensionEncryptedClientHello {
+			continue
+		}
+		// The payload is at the end of the extension data.
+		end := len(raw) - len(extensions)
+		for i := end - payloadLen; i < end; i++ {
+			aad[i] = 0
+		}
+		return aad[4:], true
+	}
+	return nil, false
+}
+
+// decodeInnerClientHello reconstructs the marshaled ClientHelloInner from the
+// EncodedClientHelloInner, restoring the legacy_session_id from outer,
+// expanding any ech_outer_extensions reference, and removing the padding. See
+// draft-ietf-tls-esni-18, Section 5.1.
+func decodeInnerClientHello(outer *clientHelloMsg, encoded []byte) ([]byte, error) {
+	errMalformed := errors.New("tls: malformed EncodedClientHelloInner")
+
+	s := cryptobyte.String(encoded)
+	var vers uint16
+	var random, sessionID, cipherSuites, compressionMethods []byte
+	var extensions cryptobyte.String
+	if !s.ReadUint16(&vers) || !s.ReadBytes(&random, 32) ||
+		!readUint8LengthPrefixed(&s, &sessionID) || len(sessionID) != 0 ||
+		!readUint16LengthPrefixed(&s, &cipherSuites) ||
+		!readUint8LengthPrefixed(&s, &compressionMethods) ||
+		!s.ReadUint16LengthPrefixed(&extensions) {
+		return nil, errMalformed
+	}
+	for _, b := range s {
+		if b != 0 {
+			return nil, errors.New("tls: EncodedClientHelloInner has non-zero padding")
+		}
+	}
+
+	outerExtensions, _, ok := clientHelloExtensions(outer.marshal())
+	if !ok {
+		return nil, errMalformed
+	}
+
+	// Expand the extensions first, as the length-prefixed Builder callbacks
+	// can't report errors.
+	var expanded []byte
+	for !extensions.Empty() {
+		var extType uint16
+		var extData cryptobyte.String
+		if !extensions.ReadUint16(&extType) ||
+			!extensions.ReadUint16LengthPrefixed(&extData) {
+			return nil, errMalformed
+		}
+		if extType != extensionECHOuterExtensions {
+			expanded = appendExtension(expanded, extType, extData)
+			continue
+		}
+		// Copy the referenced extensions from the ClientHelloOuter, where
+		// they must appear in the same order.
+		var refs cryptobyte.String
+		if !extData.ReadUint8LengthPrefixed(&refs) || refs.Empty() || !extData.Empty() {
+			return nil, errMalformed
+		}
+		for !refs.Empty() {
+			var ref uint16
+			if !refs.ReadUint16(&ref) || ref == extensionEncryptedClientHello {
+				return nil, errMalformed
+			}
+			found := false
+			for !outerExtensions.Empty() {
+				var outerType uint16
+				var outerData cryptobyte.String
+				if !outerExtensions.ReadUint16(&outerType) ||
+					!outerExtensions.ReadUint16LengthPrefixed(&outerData) {
+					return nil, errMalformed
+				}
+				if outerType == ref {
+					expanded = appendExtension(expanded, outerType, outerData)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, errors.New("tls: ech_outer_extensions references a missing extension")
+			}
+		}
+	}
+
+	var b cryptobyte.Builder
+	b.AddUint8(typeClientHello)
+	b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint16(vers)
+		b.AddBytes(random)
+		b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(outer.sessionId)
+		})
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(cipherSuites)
+		})
+		b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(compressionMethods)
+		})
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes(expanded)
+		})
+	})
+	return b.Bytes()
+}
+
+func appendExtension(b []byte, extType uint16, data []byte) []byte {
+	b = append(b, byte(extType>>8), byte(extType), byte(len(data)>>8), byte(len(data)))
+	return append(b, data...)
+}
@@ -0,0 +1,303 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"bytes"
+	"crypto/hpke"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+func marshalTestECHConfigList(configs ...[]byte) []byte {
+	list, err := MarshalECHConfigList(configs)
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+func newTestECHKey(id uint8, seed byte, publicName string) EncryptedClientHelloKey {
+	var priv, pub [32]byte
+	for i := range priv {
+		priv[i] = seed + byte(i)
+	}
+	curve25519.ScalarBaseMult(&pub, &priv)
+	config, err := MarshalECHConfig(id, hpke.DHKEM_X25519_HKDF_SHA256, pub[:], publicName, 32)
+	if err != nil {
+		panic(err)
+	}
+	return EncryptedClientHelloKey{
+		Config:     config,
+		PrivateKey: priv[:],
+	}
+}
+
+func TestParseECHConfigList(t *testing.T) {
+	key := newTestECHKey(1, 42, "public.example")
+
+	unknownVersion := []byte{0xfe, 0x0a, 0x00, 0x02, 0xaa, 0xbb}
+	configs, err := parseECHConfigList(marshalTestECHConfigList(unknownVersion, key.Config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configs) != 2 {
+		t.Fatalf("got %d configs, want 2", len(configs))
+	}
+	config := pickECHConfig(configs)
+	if config == nil {
+		t.Fatal("no config picked")
+	}
+	if config.configID != 1 || string(config.publicName) != "public.example" ||
+		config.maxNameLength != 32 || len(config.cipherSuites) != 3 ||
+		!bytes.Equal(config.raw, key.Config) {
+		t.Errorf("unexpected parsed config: %+v", config)
+	}
+
+	for _, bad := range [][]byte{
+		nil,
+		{0x00, 0x00},
+		marshalTestECHConfigList(key.Config)[:10],
+		append(marshalTestECHConfigList(key.Config), 0),
+	} {
+		if _, err := parseECHConfigList(bad); err == nil {
+			t.Errorf("parseECHConfigList(%x) succeeded, want error", bad)
+		}
+	}
+
+	if config := pickECHConfig(configs[:1]); config != nil {
+		t.Errorf("picked config with unsupported version")
+	}
+}
+
+func TestMarshalECHConfig(t *testing.T) {
+	for _, kemID := range []uint16{hpke.DHKEM_X25519_HKDF_SHA256, hpke.DHKEM_P256_HKDF_SHA256} {
+		t.Run(fmt.Sprintf("KEM=%#04x", kemID), func(t *testing.T) {
+			priv, pub, err := hpke.GenerateKey(kemID, rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			config, err := MarshalECHConfig(3, kemID, pub, "public.example", 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+			list, err := MarshalECHConfigList([][]byte{config})
+			if err != nil {
+				t.Fatal(err)
+			}
+			configs, err := parseECHConfigList(list)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(configs) != 1 {
+				t.Fatalf("got %d configs, want 1", len(configs))
+			}
+			parsed := configs[0]
+			if parsed.configID != 3 || parsed.kemID != kemID || !bytes.Equal(parsed.publicKey, pub) ||
+				string(parsed.publicName) != "public.example" || parsed.maxNameLength != 64 ||
+				len(parsed.cipherSuites) != len(echConfigCipherSuites) || !bytes.Equal(parsed.raw, config) {
+				t.Errorf("unexpected parsed config: %+v", parsed)
+			}
+
+			serverConfig := testConfig.Clone()
+			serverConfig.EncryptedClientHelloKeys = []EncryptedClientHelloKey{{Config: config, PrivateKey: priv}}
+			clientConfig := testConfig.Clone()
+			clientConfig.ServerName = "example.golang"
+			clientConfig.EncryptedClientHelloConfigList = list
+			serverState, clientState, err := testHandshake(t, clientConfig, serverConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !serverState.ECHAccepted || !clientState.ECHAccepted {
+				t.Errorf("ECH not accepted: server %v, client %v", serverState.ECHAccepted, clientState.ECHAccepted)
+			}
+		})
+	}
+
+	pub := make([]byte, 32)
+	for _, tt := range []struct {
+		kemID      uint16
+		pub        []byte
+		publicName string
+	}{
+		{0xffff, pub, "public.example"},
+		{hpke.DHKEM_X25519_HKDF_SHA256, nil, "public.example"},
+		{hpke.DHKEM_X25519_HKDF_SHA256, pub, ""},
+		{hpke.DHKEM_X25519_HKDF_SHA256, pub, string(make([]byte, 256))},
+	} {
+		if _, err := MarshalECHConfig(1, tt.kemID, tt.pub, tt.publicName, 0); err == nil {
+			t.Errorf("MarshalECHConfig(%#04x, %x, %q) succeeded, want error", tt.kemID, tt.pub, tt.publicName)
+		}
+	}
+	for _, configs := range [][][]byte{
+		nil,
+		{{0x00}},
+		{append(newTestECHKey(1, 42, "public.example").Config, 0)},
+	} {
+		if _, err := MarshalECHConfigList(configs); err == nil {
+			t.Errorf("MarshalECHConfigList(%x) succeeded, want error", configs)
+		}
+	}
+}
+
+func TestEncodeInnerClientHelloPadding(t *testing.T) {
+	for _, name := range []string{"", "a.example", "a.much.longer.name.example.com"} {
+		hello := &clientHelloMsg{
+			vers:                 VersionTLS12,
+			random:               make([]byte, 32),
+			sessionId:            make([]byte, 32),
+			cipherSuites:         []uint16{TLS_AES_128_GCM_SHA256},
+			compressionMethods:   []uint8{compressionNone},
+			serverName:           name,
+			encryptedClientHello: []byte{echClientHelloInnerType},
+		}
+		encoded := encodeInnerClientHello(hello, 64)
+		if len(encoded)%32 != 0 {
+			t.Errorf("%q: encoded length %d is not a multiple of 32", name, len(encoded))
+		}
+
+		decoded, err := decodeInnerClientHello(hello, encoded)
+		if err != nil {
+			t.Errorf("%q: %v", name, err)
+			continue
+		}
+		if !bytes.Equal(decoded, hello.marshal()) {
+			t.Errorf("%q: decoded ClientHelloInner doesn't match the original", name)
+		}
+	}
+}
+
+func testECHConfigs(t *testing.T) (clientConfig, serverConfig *Config) {
+	key := newTestECHKey(1, 42, "public.example")
+	key.SendAsRetry = true
+
+	serverConfig = testConfig.Clone()
+	serverConfig.EncryptedClientHelloKeys = []EncryptedClientHelloKey{key}
+
+	clientConfig = testConfig.Clone()
+	clientConfig.ServerName = "example.golang"
+	clientConfig.EncryptedClientHelloConfigList = marshalTestECHConfigList(key.Config)
+
+	return clientConfig, serverConfig
+}
+
+func TestECHHandshake(t *testing.T) {
+	clientConfig, serverConfig := testECHConfigs(t)
+
+	serverState, clientState, err := testHandshake(t, clientConfig, serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !serverState.ECHAccepted || !clientState.ECHAccepted {
+		t.Errorf("ECH not accepted: server %v, client %v", serverState.ECHAccepted, clientState.ECHAccepted)
+	}
+	if serverState.ServerName != "example.golang" {
+		t.Errorf("server saw ServerName %q, want the inner name", serverState.ServerName)
+	}
+	if clientState.Version != VersionTLS13 {
+		t.Errorf("negotiated version %x, want TLS 1.3", clientState.Version)
+	}
+}
+
+func TestECHHelloRetryRequest(t *testing.T) {
+	clientConfig, serverConfig := testECHConfigs(t)
+	clientConfig.CurvePreferences = []CurveID{X25519, CurveP256}
+	serverConfig.CurvePreferences = []CurveID{CurveP256}
+
+	serverState, clientState, err := testHandshake(t, clientConfig, serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !serverState.ECHAccepted || !clientState.ECHAccepted {
+		t.Errorf("ECH not accepted: server %v, client %v", serverState.ECHAccepted, clientState.ECHAccepted)
+	}
+	if serverState.ServerName != "example.golang" {
+		t.Errorf("server saw ServerName %q, want the inner name", serverState.ServerName)
+	}
+}
+
+func TestECHResumption(t *testing.T) {
+	clientConfig, serverConfig := testECHConfigs(t)
+	clientConfig.ClientSessionCache = NewLRUClientSessionCache(1)
+
+	for i := 0; i < 2; i++ {
+		_, clientState, err := testHandshake(t, clientConfig, serverConfig)
+		if err != nil {
+			t.Fatalf("handshake %d: %v", i, err)
+		}
+		if !clientState.ECHAccepted {
+			t.Errorf("handshake %d: ECH not accepted", i)
+		}
+		if clientState.DidResume != (i == 1) {
+			t.Errorf("handshake %d: DidResume = %v", i, clientState.DidResume)
+		}
+	}
+}
+
+func TestECHRejected(t *testing.T) {
+	clientConfig, serverConfig := testECHConfigs(t)
+	retryKey := serverConfig.EncryptedClientHelloKeys[0]
+
+	// The client uses a configuration the server doesn't have the key for.
+	staleKey := newTestECHKey(2, 7, "public.example")
+	clientConfig.EncryptedClientHelloConfigList = marshalTestECHConfigList(staleKey.Config)
+
+	for _, hrr := range []bool{false, true} {
+		t.Run(fmt.Sprintf("HelloRetryRequest=%v", hrr), func(t *testing.T) {
+			clientConfig := clientConfig.Clone()
+			serverConfig := serverConfig.Clone()
+			if hrr {
+				clientConfig.CurvePreferences = []CurveID{X25519, CurveP256}
+				serverConfig.CurvePreferences = []CurveID{CurveP256}
+			}
+
+			c, s := localPipe(t)
+			done := make(chan bool)
+			var serverState ConnectionState
+			go func() {
+				defer close(done)
+				server := Server(s, serverConfig)
+				server.Handshake()
+				serverState = server.ConnectionState()
+				server.Close()
+			}()
+			cli := Client(c, clientConfig)
+			err := cli.Handshake()
+			cli.Close()
+			<-done
+
+			echErr, ok := err.(*ECHRejectionError)
+			if !ok {
+				t.Fatalf("got error %v, want *ECHRejectionError", err)
+			}
+			want := marshalTestECHConfigList(retryKey.Config)
+			if !bytes.Equal(echErr.RetryConfigList, want) {
+				t.Errorf("got retry configs %x, want %x", echErr.RetryConfigList, want)
+			}
+			if serverState.ECHAccepted {
+				t.Errorf("server accepted ECH")
+			}
+			if serverState.ServerName != "public.example" {
+				t.Errorf("server saw ServerName %q, want the public name", serverState.ServerName)
+			}
+		})
+	}
+}
+
+func TestECHRequiresTLS13(t *testing.T) {
+	clientConfig, _ := testECHConfigs(t)
+	clientConfig.MaxVersion = VersionTLS12
+
+	c, s := localPipe(t)
+	defer s.Close()
+	cli := Client(c, clientConfig)
+	defer cli.Close()
+	if err := cli.Handshake(); err == nil {
+		t.Fatal("ECH handshake with MaxVersion TLS 1.2 succeeded")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import "crypto/internal/fips"
+
+// The following lists are the algorithms approved for use in FIPS 140 mode.
+// They are not defaults, but filters applied to the default or configured
+// lists when crypto/internal/fips.Enabled is set. Notably, they exclude SHA-1,
+// RC4, 3DES, ChaCha20-Poly1305, RSA key exchange, X25519 and TLS 1.0-1.1.
+
+var fipsSupportedVersions = []uint16{
+	VersionTLS12,
+	VersionTLS13,
+}
+
+var fipsCurvePreferences = []CurveID{
+	CurveP256,
+	CurveP384,
+	CurveP521,
+}
+
+var fipsSignatureAlgorithms = []SignatureScheme{
+	PSSWithSHA256,
+	PSSWithSHA384,
+	PSSWithSHA512,
+	PKCS1WithSHA256,
+	ECDSAWithP256AndSHA256,
+	PKCS1WithSHA384,
+	ECDSAWithP384AndSHA384,
+	PKCS1WithSHA512,
+	ECDSAWithP521AndSHA512,
+}
+
+var fipsCipherSuites = []uint16{
+	TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+}
+
+var fipsCipherSuitesTLS13 = []uint16{
+	TLS_AES_128_GCM_SHA256,
+	TLS_AES_256_GCM_SHA384,
+}
+
+func init() {
+	// The mode can't change after initialization, so the advertised
+	// signature algorithms can be filtered once and for all.
+	if fips.Enabled {
+		supportedSignatureAlgorithms = fipsFilterSignatureSchemes(supportedSignatureAlgorithms)
+	}
+}
+
+func fipsAllowedVersion(v uint16) bool {
+	for _, allowed := range fipsSupportedVersions {
+		if v == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// fipsFilterCipherSuites returns the elements of ids that are in allowed.
+func fipsFilterCipherSuites(ids, allowed []uint16) []uint16 {
+	filtered := make([]uint16, 0, len(ids))
+	for _, id := range ids {
+		for _, a := range allowed {
+			if id == a {
+				filtered = append(filtered, id)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func fipsFilterCurves(curves []CurveID) []CurveID {
+	filtered := make([]CurveID, 0, len(curves))
+	for _, c := range curves {
+		for _, allowed := range fipsCurvePreferences {
+			if c == allowed {
+				filtered = append(filtered, c)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func fipsFilterSignatureSchemes(schemes []SignatureScheme) []SignatureScheme {
+	filtered := make([]SignatureScheme, 0, len(schemes))
+	for _, s := range schemes {
+		for _, allowed := range fipsSignatureAlgorithms {
+			if s == allowed {
+				filtered = append(filtered, s)
+				break
+			}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,59 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFIPSFilters(t *testing.T) {
+	suites := fipsFilterCipherSuites([]uint16{
+		TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+		TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		TLS_RSA_WITH_AES_128_GCM_SHA256,
+		TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		TLS_ECDHE_RSA_WITH_RC4_128_SHA,
+	}, fipsCipherSuites)
+	if want := []uint16{
+		TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	}; !reflect.DeepEqual(suites, want) {
+		t.Errorf("fipsFilterCipherSuites = %v, want %v", suites, want)
+	}
+
+	suites13 := fipsFilterCipherSuites(defaultCipherSuitesTLS13(), fipsCipherSuitesTLS13)
+	for _, id := range suites13 {
+		if id == TLS_CHACHA20_POLY1305_SHA256 {
+			t.Errorf("fipsFilterCipherSuites allowed ChaCha20-Poly1305 in TLS 1.3")
+		}
+	}
+	if len(suites13) == 0 {
+		t.Error("fipsFilterCipherSuites removed all TLS 1.3 cipher suites")
+	}
+
+	curves := fipsFilterCurves([]CurveID{X25519MLKEM768, X25519, CurveP256, CurveP521})
+	if want := []CurveID{CurveP256, CurveP521}; !reflect.DeepEqual(curves, want) {
+		t.Errorf("fipsFilterCurves = %v, want %v", curves, want)
+	}
+
+	schemes := fipsFilterSignatureSchemes([]SignatureScheme{
+		PKCS1WithSHA1, ECDSAWithSHA1, PSSWithSHA256, ECDSAWithP256AndSHA256,
+	})
+	if want := []SignatureScheme{PSSWithSHA256, ECDSAWithP256AndSHA256}; !reflect.DeepEqual(schemes, want) {
+		t.Errorf("fipsFilterSignatureSchemes = %v, want %v", schemes, want)
+	}
+
+	for _, v := range []uint16{VersionSSL30, VersionTLS10, VersionTLS11} {
+		if fipsAllowedVersion(v) {
+			t.Errorf("fipsAllowedVersion(%x) = true", v)
+		}
+	}
+	for _, v := range []uint16{VersionTLS12, VersionTLS13} {
+		if !fipsAllowedVersion(v) {
+			t.Errorf("fipsAllowedVersion(%x) = false", v)
+		}
+	}
+}
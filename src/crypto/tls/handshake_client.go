@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/internal/fips"
 	"crypto/rsa"
 	"crypto/subtle"
 	"crypto/x509"
@@ -31,27 +32,49 @@ type clientHandshakeState struct {
 	session      *ClientSessionState
 }
 
-func (c *Conn) makeClientHello() (*clientHelloMsg, ecdheParameters, error) {
+func (c *Conn) makeClientHello() (*clientHelloMsg, ecdheParameters, *echClientContext, error) {
 	config := c.config
 	if len(config.ServerName) == 0 && !config.InsecureSkipVerify {
-		return nil, nil, errors.New("tls: either ServerName or InsecureSkipVerify must be specified in the tls.Config")
+		return nil, nil, nil, errors.New("tls: either ServerName or InsecureSkipVerify must be specified in the tls.Config")
 	}
 
 	nextProtosLength := 0
 	for _, proto := range config.NextProtos {
 		if l := len(proto); l == 0 || l > 255 {
-			return nil, nil, errors.New("tls: invalid NextProtos value")
+			return nil, nil, nil, errors.New("tls: invalid NextProtos value")
 		} else {
 			nextProtosLength += 1 + l
 		}
 	}
 	if nextProtosLength > 0xffff {
-		return nil, nil, errors.New("tls: NextProtos values too large")
+		return nil, nil, nil, errors.New("tls: NextProtos values too large")
 	}
 
 	supportedVersions := config.supportedVersions(true)
 	if len(supportedVersions) == 0 {
-		return nil, nil, errors.New("tls: no supported versions satisfy MinVersion and MaxVersion")
+		return nil, nil, nil, errors.New("tls: no supported versions satisfy MinVersion and MaxVersion")
+	}
+
+	// Encrypted Client Hello is only defined for TLS 1.3, and offering older
+	// versions would let an attacker downgrade the connection to send the
+	// real server name in the clear.
+	var ech *echClientContext
+	if config.EncryptedClientHelloConfigList != nil {
+		if fips.Enabled {
+			return nil, nil, nil, errors.New("tls: Encrypted Client Hello is not supported in FIPS 140 mode")
+		}
+		if supportedVersions[0] != VersionTLS13 {
+			return nil, nil, nil, errors.New("tls: EncryptedClientHelloConfigList requires TLS 1.3 to be enabled")
+		}
+		supportedVersions = supportedVersions[:1]
+		if c.handshakes > 0 {
+			return nil, nil, nil, errors.New("tls: renegotiation is not supported with Encrypted Client Hello")
+		}
+		var err error
+		ech, err = newECHClientContext(config)
+		if err != nil {
+			return nil, nil, nil, err
+		}
 	}
 
 	clientHelloVersion := supportedVersions[0]
@@ -70,7 +93,7 @@ func (c *Conn) makeClientHello() (*clientHelloMsg, ecdheParameters, error) {
 		ocspStapling:                 true,
 		scts:                         true,
 		serverName:                   hostnameInSNI(config.ServerName),
-		supportedCurves:              config.curvePreferences(),
+		supportedCurves:              config.curvePreferences(supportedVersions[0]),
 		supportedPoints:              []uint8{pointFormatUncompressed},
 		nextProtoNeg:                 len(config.NextProtos) > 0,
 		secureRenegotiationSupported: true,
@@ -102,14 +125,14 @@ func (c *Conn) makeClientHello() (*clientHelloMsg, ecdheParameters, error) {
 
 	_, err := io.ReadFull(config.rand(), hello.random)
 	if err != nil {
-		return nil, nil, errors.New("tls: short read from Rand: " + err.Error())
+		return nil, nil, nil, errors.New("tls: short read from Rand: " + err.Error())
 	}
 
 	// A random session ID is used to detect when the server accepted a ticket
 	// and is resuming a session (see RFC 5077). In TLS 1.3, it's always set as
 	// a compatibility measure (see RFC 8446, Section 4.1.2).
 	if _, err := io.ReadFull(config.rand(), hello.sessionId); err != nil {
-		return nil, nil, errors.New("tls: short read from Rand: " + err.Error())
+		return nil, nil, nil, errors.New("tls: short read from Rand: " + err.Error())
 	}
 
 	if hello.vers >= VersionTLS12 {
@@ -120,18 +143,34 @@ func (c *Conn) makeClientHello() (*clientHelloMsg, ecdheParameters, error) {
 	if hello.supportedVersions[0] == VersionTLS13 {
 		hello.cipherSuites = append(hello.cipherSuites, defaultCipherSuitesTLS13()...)
 
-		curveID := config.curvePreferences()[0]
-		if _, ok := curveForCurveID(curveID); curveID != X25519 && !ok {
-			return nil, nil, errors.New("tls: CurvePreferences includes unsupported curve")
+		curveID := hello.supportedCurves[0]
+		if _, ok := curveForCurveID(curveID); curveID != X25519 && curveID != X25519MLKEM768 && !ok {
+			return nil, nil, nil, errors.New("tls: CurvePreferences includes unsupported curve")
 		}
 		params, err = generateECDHEParameters(config.rand(), curveID)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		hello.keyShares = []keyShare{{group: curveID, data: params.PublicKey()}}
+		// If X25519 is also supported, send its share on its own as well,
+		// reusing the hybrid's X25519 key, so that servers that don't
+		// support X25519MLKEM768 don't need a HelloRetryRequest.
+		if hybrid, ok := params.(*x25519MLKEMParameters); ok {
+			for _, id := range hello.supportedCurves {
+				if id == X25519 {
+					hello.keyShares = append(hello.keyShares,
+						keyShare{group: X25519, data: hybrid.x25519.PublicKey()})
+					break
+				}
+			}
+		}
 	}
 
-	return hello, params, nil
+	if ech != nil {
+		hello.encryptedClientHello = []byte{echClientHelloInnerType}
+	}
+
+	return hello, params, ech, nil
 }
 
 func (c *Conn) clientHandshake() (err error) {
@@ -143,7 +182,7 @@ func (c *Conn) clientHandshake() (err error) {
 	// need to be reset.
 	c.didResume = false
 
-	hello, ecdheParams, err := c.makeClientHello()
+	hello, ecdheParams, ech, err := c.makeClientHello()
 	if err != nil {
 		return err
 	}
@@ -163,7 +202,18 @@ func (c *Conn) clientHandshake() (err error) {
 		}()
 	}
 
-	if _, err := c.writeRecord(recordTypeHandshake, hello.marshal()); err != nil {
+	// With Encrypted Client Hello, the ClientHello built so far is the
+	// ClientHelloInner, and a ClientHelloOuter carrying it is sent instead.
+	helloToSend := hello
+	if ech != nil {
+		ech.innerHello = hello
+		helloToSend, err = ech.makeOuterClientHello(c.config.rand())
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.writeRecord(recordTypeHandshake, helloToSend.marshal()); err != nil {
 		return err
 	}
 
@@ -182,15 +232,21 @@ func (c *Conn) clientHandshake() (err error) {
 		return err
 	}
 
+	if ech != nil && c.vers != VersionTLS13 {
+		c.sendAlert(alertProtocolVersion)
+		return errors.New("tls: server selected a version older than TLS 1.3 in response to Encrypted Client Hello")
+	}
+
 	if c.vers == VersionTLS13 {
 		hs := &clientHandshakeStateTLS13{
 			c:           c,
 			serverHello: serverHello,
-			hello:       hello,
+			hello:       helloToSend,
 			ecdheParams: ecdheParams,
 			session:     session,
 			earlySecret: earlySecret,
 			binderKey:   binderKey,
+			echContext:  ech,
 		}
 
 		// In TLS 1.3, session tickets are delivered after the handshake.
@@ -240,15 +296,17 @@ func (c *Conn) loadSession(hello *clientHelloMsg) (cacheKey string,
 
 	// Try to resume a previously negotiated TLS session, if available.
 	cacheKey = clientSessionCacheKey(c.conn.RemoteAddr(), c.config)
-	session, ok := c.config.ClientSessionCache.Get(cacheKey)
-	if !ok || session == nil {
+	cs, ok := c.config.ClientSessionCache.Get(cacheKey)
+	if !ok || cs == nil || cs.session == nil {
 		return cacheKey, nil, nil, nil
 	}
+	session = cs
+	state := cs.session
 
 	// Check that version used for the previous session is still valid.
 	versOk := false
 	for _, v := range hello.supportedVersions {
-		if v == session.vers {
+		if v == state.version {
 			versOk = true
 			break
 		}
@@ -261,11 +319,11 @@ func (c *Conn) loadSession(hello *clientHelloMsg) (cacheKey string,
 	// valid for the ServerName. This should be ensured by the cache key, but
 	// protect the application from a faulty ClientSessionCache implementation.
 	if !c.config.InsecureSkipVerify {
-		if len(session.verifiedChains) == 0 {
+		if len(state.verifiedChains) == 0 {
 			// The original connection had InsecureSkipVerify, while this doesn't.
 			return cacheKey, nil, nil, nil
 		}
-		serverCert := session.serverCertificates[0]
+		serverCert := state.peerCertificates[0]
 		if c.config.time().After(serverCert.NotAfter) {
 			// Expired certificate, delete the entry.
 			c.config.ClientSessionCache.Put(cacheKey, nil)
@@ -276,26 +334,26 @@ func (c *Conn) loadSession(hello *clientHelloMsg) (cacheKey string,
 		}
 	}
 
-	if session.vers != VersionTLS13 {
+	if state.version != VersionTLS13 {
 		// In TLS 1.2 the cipher suite must match the resumed session. Ensure we
 		// are still offering it.
-		if mutualCipherSuite(hello.cipherSuites, session.cipherSuite) == nil {
+		if mutualCipherSuite(hello.cipherSuites, state.cipherSuite) == nil {
 			return cacheKey, nil, nil, nil
 		}
 
-		hello.sessionTicket = session.sessionTicket
+		hello.sessionTicket = cs.ticket
 		return
 	}
 
 	// Check that the session ticket is not expired.
-	if c.config.time().After(session.useBy) {
+	if c.config.time().After(time.Unix(int64(state.useBy), 0)) {
 		c.config.ClientSessionCache.Put(cacheKey, nil)
 		return cacheKey, nil, nil, nil
 	}
 
 	// In TLS 1.3 the KDF hash must match the resumed session. Ensure we
 	// offer at least one cipher suite with that hash.
-	cipherSuite := cipherSuiteTLS13ByID(session.cipherSuite)
+	cipherSuite := cipherSuiteTLS13ByID(state.cipherSuite)
 	if cipherSuite == nil {
 		return cacheKey, nil, nil, nil
 	}
@@ -312,18 +370,16 @@ func (c *Conn) loadSession(hello *clientHelloMsg) (cacheKey string,
 	}
 
 	// Set the pre_shared_key extension. See RFC 8446, Section 4.2.11.1.
-	ticketAge := uint32(c.config.time().Sub(session.receivedAt) / time.Millisecond)
+	ticketAge := uint32(c.config.time().Sub(time.Unix(int64(state.createdAt), 0)) / time.Millisecond)
 	identity := pskIdentity{
-		label:               session.sessionTicket,
-		obfuscatedTicketAge: ticketAge + session.ageAdd,
+		label:               cs.ticket,
+		obfuscatedTicketAge: ticketAge + state.ageAdd,
 	}
 	hello.pskIdentities = []pskIdentity{identity}
 	hello.pskBinders = [][]byte{make([]byte, cipherSuite.hash.Size())}
 
 	// Compute the PSK binders. See RFC 8446, Section 4.2.11.2.
-	psk := cipherSuite.expandLabel(session.masterSecret, "resumption",
-		session.nonce, cipherSuite.hash.Size())
-	earlySecret = cipherSuite.extract(psk, nil)
+	earlySecret = cipherSuite.extract(state.secret, nil)
 	binderKey = cipherSuite.deriveSecret(earlySecret, resumptionBinderLabel, nil)
 	transcript := cipherSuite.hash.New()
 	transcript.Write(hello.marshalWithoutBinders())
@@ -702,20 +758,20 @@ func (hs *clientHandshakeState) processServerHello() (bool, error) {
 		return false, nil
 	}
 
-	if hs.session.vers != c.vers {
+	if hs.session.session.version != c.vers {
 		c.sendAlert(alertHandshakeFailure)
 		return false, errors.New("tls: server resumed a session with a different version")
 	}
 
-	if hs.session.cipherSuite != hs.suite.id {
+	if hs.session.session.cipherSuite != hs.suite.id {
 		c.sendAlert(alertHandshakeFailure)
 		return false, errors.New("tls: server resumed a session with a different cipher suite")
 	}
 
 	// Restore masterSecret and peerCerts from previous state
-	hs.masterSecret = hs.session.masterSecret
-	c.peerCertificates = hs.session.serverCertificates
-	c.verifiedChains = hs.session.verifiedChains
+	hs.masterSecret = hs.session.session.secret
+	c.peerCertificates = hs.session.session.peerCertificates
+	c.verifiedChains = hs.session.session.verifiedChains
 	return true, nil
 }
 
@@ -764,15 +820,10 @@ func (hs *clientHandshakeState) readSessionTicket() error {
 	}
 	hs.finishedHash.Write(sessionTicketMsg.marshal())
 
-	hs.session = &ClientSessionState{
-		sessionTicket:      sessionTicketMsg.ticket,
-		vers:               c.vers,
-		cipherSuite:        hs.suite.id,
-		masterSecret:       hs.masterSecret,
-		serverCertificates: c.peerCertificates,
-		verifiedChains:     c.verifiedChains,
-		receivedAt:         c.config.time(),
-	}
+	session := c.sessionState()
+	session.secret = hs.masterSecret
+
+	hs.session = &ClientSessionState{ticket: sessionTicketMsg.ticket, session: session}
 
 	return nil
 }
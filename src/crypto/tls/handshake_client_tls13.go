@@ -32,10 +32,15 @@ type clientHandshakeStateTLS13 struct {
 	transcript    hash.Hash
 	masterSecret  []byte
 	trafficSecret []byte // client_application_traffic_secret_0
+
+	// echContext is set if Encrypted Client Hello was offered, in which case
+	// hs.hello is the ClientHelloOuter until the server accepts it.
+	echContext *echClientContext
 }
 
 // handshake requires hs.c, hs.hello, hs.serverHello, hs.ecdheParams, and,
-// optionally, hs.session, hs.earlySecret and hs.binderKey to be set.
+// optionally, hs.session, hs.earlySecret, hs.binderKey and hs.echContext to
+// be set.
 func (hs *clientHandshakeStateTLS13) handshake() error {
 	c := hs.c
 
@@ -46,8 +51,9 @@ func (hs *clientHandshakeStateTLS13) handshake() error {
 		return errors.New("tls: server selected TLS 1.3 in a renegotiation")
 	}
 
-	// Consistency check on the presence of a keyShare and its parameters.
-	if hs.ecdheParams == nil || len(hs.hello.keyShares) != 1 {
+	// Consistency check on the presence of a keyShare and its parameters. A
+	// second key share is only sent alongside X25519MLKEM768.
+	if hs.ecdheParams == nil || len(hs.hello.keyShares) == 0 || len(hs.hello.keyShares) > 2 {
 		return c.sendAlert(alertInternalError)
 	}
 
@@ -58,7 +64,14 @@ func (hs *clientHandshakeStateTLS13) handshake() error {
 	hs.transcript = hs.suite.hash.New()
 	hs.transcript.Write(hs.hello.marshal())
 
+	if ech := hs.echContext; ech != nil {
+		ech.innerTranscript = hs.suite.hash.New()
+		ech.innerTranscript.Write(ech.innerHello.marshal())
+	}
+
+	sawHRR := false
 	if bytes.Equal(hs.serverHello.random, helloRetryRequestRandom) {
+		sawHRR = true
 		if err := hs.sendDummyChangeCipherSpec(); err != nil {
 			return err
 		}
@@ -67,6 +80,24 @@ func (hs *clientHandshakeStateTLS13) handshake() error {
 		}
 	}
 
+	if ech := hs.echContext; ech != nil && !ech.rejected {
+		confirmation := echAcceptConfirmation(hs.suite, ech.innerHello.random,
+			"ech accept confirmation", ech.innerTranscript,
+			echServerHelloConfirmationMessage(hs.serverHello.marshal()))
+		if hmac.Equal(confirmation, hs.serverHello.random[32-echAcceptConfirmationLength:]) {
+			hs.hello = ech.innerHello
+			hs.transcript = ech.innerTranscript
+			c.echAccepted = true
+		} else if sawHRR {
+			// The server accepted ECH in the HelloRetryRequest, and must not
+			// change its mind. See draft-ietf-tls-esni-18, Section 6.1.5.
+			c.sendAlert(alertIllegalParameter)
+			return errors.New("tls: server rejected ECH after accepting it in the HelloRetryRequest")
+		} else {
+			ech.rejected = true
+		}
+	}
+
 	hs.transcript.Write(hs.serverHello.marshal())
 
 	c.buffering = true
@@ -98,6 +129,14 @@ func (hs *clientHandshakeStateTLS13) handshake() error {
 		return err
 	}
 
+	// If ECH was rejected, the handshake was completed with the
+	// client-facing server only to securely receive the retry configurations.
+	// The connection must not be used. See draft-ietf-tls-esni-18, Section 6.1.6.
+	if ech := hs.echContext; ech != nil && ech.rejected {
+		c.sendAlert(alertECHRequired)
+		return &ECHRejectionError{RetryConfigList: ech.retryConfigs}
+	}
+
 	atomic.StoreUint32(&c.handshakeStatus, 1)
 
 	return nil
@@ -185,6 +224,34 @@ func (hs *clientHandshakeStateTLS13) processHelloRetryRequest() error {
 	hs.transcript.Write(chHash)
 	hs.transcript.Write(hs.serverHello.marshal())
 
+	// With Encrypted Client Hello, the HelloRetryRequest is applied to the
+	// ClientHelloInner, from which the second ClientHelloOuter is derived.
+	hello := hs.hello
+	if ech := hs.echContext; ech != nil {
+		innerCHHash := ech.innerTranscript.Sum(nil)
+		ech.innerTranscript.Reset()
+		ech.innerTranscript.Write([]byte{typeMessageHash, 0, 0, uint8(len(innerCHHash))})
+		ech.innerTranscript.Write(innerCHHash)
+
+		if len(hs.serverHello.encryptedClientHello) == 0 {
+			ech.rejected = true
+		} else {
+			if len(hs.serverHello.encryptedClientHello) != echAcceptConfirmationLength {
+				c.sendAlert(alertDecodeError)
+				return errors.New("tls: received malformed encrypted_client_hello extension")
+			}
+			confirmation := echAcceptConfirmation(hs.suite, ech.innerHello.random,
+				"hrr ech accept confirmation", ech.innerTranscript,
+				echHelloRetryRequestConfirmationMessage(hs.serverHello.marshal()))
+			if !hmac.Equal(confirmation, hs.serverHello.encryptedClientHello) {
+				ech.rejected = true
+			}
+		}
+
+		ech.innerTranscript.Write(hs.serverHello.marshal())
+		hello, chHash = ech.innerHello, innerCHHash
+	}
+
 	if hs.serverHello.serverShare.group != 0 {
 		c.sendAlert(alertDecodeError)
 		return errors.New("tls: received malformed key_share extension")
@@ -206,11 +273,13 @@ func (hs *clientHandshakeStateTLS13) processHelloRetryRequest() error {
 		c.sendAlert(alertIllegalParameter)
 		return errors.New("tls: server selected unsupported group")
 	}
-	if hs.ecdheParams.CurveID() == curveID {
-		c.sendAlert(alertIllegalParameter)
-		return errors.New("tls: server sent an unnecessary HelloRetryRequest message")
+	for _, ks := range hs.hello.keyShares {
+		if ks.group == curveID {
+			c.sendAlert(alertIllegalParameter)
+			return errors.New("tls: server sent an unnecessary HelloRetryRequest message")
+		}
 	}
-	if _, ok := curveForCurveID(curveID); curveID != X25519 && !ok {
+	if _, ok := curveForCurveID(curveID); curveID != X25519 && curveID != X25519MLKEM768 && !ok {
 		c.sendAlert(alertInternalError)
 		return errors.New("tls: CurvePreferences includes unsupported curve")
 	}
@@ -220,32 +289,44 @@ func (hs *clientHandshakeStateTLS13) processHelloRetryRequest() error {
 		return err
 	}
 	hs.ecdheParams = params
-	hs.hello.keyShares = []keyShare{{group: curveID, data: params.PublicKey()}}
+	hello.keyShares = []keyShare{{group: curveID, data: params.PublicKey()}}
 
-	hs.hello.cookie = hs.serverHello.cookie
+	hello.cookie = hs.serverHello.cookie
 
-	hs.hello.raw = nil
-	if len(hs.hello.pskIdentities) > 0 {
-		pskSuite := cipherSuiteTLS13ByID(hs.session.cipherSuite)
+	hello.raw = nil
+	if len(hello.pskIdentities) > 0 {
+		pskSuite := cipherSuiteTLS13ByID(hs.session.session.cipherSuite)
 		if pskSuite == nil {
 			return c.sendAlert(alertInternalError)
 		}
 		if pskSuite.hash == hs.suite.hash {
 			// Update binders and obfuscated_ticket_age.
-			ticketAge := uint32(c.config.time().Sub(hs.session.receivedAt) / time.Millisecond)
-			hs.hello.pskIdentities[0].obfuscatedTicketAge = ticketAge + hs.session.ageAdd
+			ticketAge := uint32(c.config.time().Sub(time.Unix(int64(hs.session.session.createdAt), 0)) / time.Millisecond)
+			hello.pskIdentities[0].obfuscatedTicketAge = ticketAge + hs.session.session.ageAdd
 
 			transcript := hs.suite.hash.New()
 			transcript.Write([]byte{typeMessageHash, 0, 0, uint8(len(chHash))})
 			transcript.Write(chHash)
 			transcript.Write(hs.serverHello.marshal())
-			transcript.Write(hs.hello.marshalWithoutBinders())
+			transcript.Write(hello.marshalWithoutBinders())
 			pskBinders := [][]byte{hs.suite.finishedHash(hs.binderKey, transcript)}
-			hs.hello.updateBinders(pskBinders)
+			hello.updateBinders(pskBinders)
 		} else {
 			// Server selected a cipher suite incompatible with the PSK.
-			hs.hello.pskIdentities = nil
-			hs.hello.pskBinders = nil
+			hello.pskIdentities = nil
+			hello.pskBinders = nil
+		}
+	}
+
+	if ech := hs.echContext; ech != nil {
+		ech.innerTranscript.Write(hello.marshal())
+
+		hs.hello.keyShares = hello.keyShares
+		hs.hello.cookie = hello.cookie
+		// The encapsulated key is only sent in the first ClientHelloOuter.
+		if err := ech.sealInnerClientHello(hs.hello, nil); err != nil {
+			c.sendAlert(alertInternalError)
+			return err
 		}
 	}
 
@@ -291,10 +372,22 @@ func (hs *clientHandshakeStateTLS13) processServerHello() error {
 		return errors.New("tls: malformed key_share extension")
 	}
 
+	if len(hs.serverHello.encryptedClientHello) != 0 {
+		c.sendAlert(alertUnsupportedExtension)
+		return errors.New("tls: server sent an encrypted_client_hello extension in a normal ServerHello")
+	}
+
 	if hs.serverHello.serverShare.group == 0 {
 		c.sendAlert(alertIllegalParameter)
 		return errors.New("tls: server did not send a key share")
 	}
+	// If the server picked the X25519 share sent alongside the hybrid one,
+	// switch to the X25519 half of the hybrid key.
+	if hybrid, ok := hs.ecdheParams.(*x25519MLKEMParameters); ok &&
+		hs.serverHello.serverShare.group == X25519 && len(hs.hello.keyShares) == 2 &&
+		hs.hello.keyShares[1].group == X25519 {
+		hs.ecdheParams = hybrid.x25519
+	}
 	if hs.serverHello.serverShare.group != hs.ecdheParams.CurveID() {
 		c.sendAlert(alertIllegalParameter)
 		return errors.New("tls: server selected unsupported group")
@@ -312,7 +405,7 @@ func (hs *clientHandshakeStateTLS13) processServerHello() error {
 	if len(hs.hello.pskIdentities) != 1 || hs.session == nil {
 		return c.sendAlert(alertInternalError)
 	}
-	pskSuite := cipherSuiteTLS13ByID(hs.session.cipherSuite)
+	pskSuite := cipherSuiteTLS13ByID(hs.session.session.cipherSuite)
 	if pskSuite == nil {
 		return c.sendAlert(alertInternalError)
 	}
@@ -323,8 +416,10 @@ func (hs *clientHandshakeStateTLS13) processServerHello() error {
 
 	hs.usingPSK = true
 	c.didResume = true
-	c.peerCertificates = hs.session.serverCertificates
-	c.verifiedChains = hs.session.verifiedChains
+	c.peerCertificates = hs.session.session.peerCertificates
+	c.verifiedChains = hs.session.session.verifiedChains
+	c.ocspResponse = hs.session.session.ocspResponse
+	c.scts = hs.session.session.scts
 	return nil
 }
 
@@ -389,6 +484,14 @@ func (hs *clientHandshakeStateTLS13) readServerParameters() error {
 	}
 	c.clientProtocol = encryptedExtensions.alpnProtocol
 
+	if len(encryptedExtensions.echRetryConfigs) != 0 {
+		if hs.echContext == nil || !hs.echContext.rejected {
+			c.sendAlert(alertUnsupportedExtension)
+			return errors.New("tls: server sent unexpected ECH retry configurations")
+		}
+		hs.echContext.retryConfigs = encryptedExtensions.echRetryConfigs
+	}
+
 	return nil
 }
 
@@ -432,7 +535,13 @@ func (hs *clientHandshakeStateTLS13) readServerCertificate() error {
 	c.scts = certMsg.certificate.SignedCertificateTimestamps
 	c.ocspResponse = certMsg.certificate.OCSPStaple
 
-	if err := c.verifyServerCertificate(certMsg.certificate.Certificate); err != nil {
+	if ech := hs.echContext; ech != nil && ech.rejected {
+		// The server that rejected ECH authenticates as the public name.
+		if err := c.verifyECHProviderCertificate(certMsg.certificate.Certificate,
+			string(ech.config.publicName)); err != nil {
+			return err
+		}
+	} else if err := c.verifyServerCertificate(certMsg.certificate.Certificate); err != nil {
 		return err
 	}
 
@@ -528,12 +637,18 @@ func (hs *clientHandshakeStateTLS13) sendClientCertificate() error {
 		return nil
 	}
 
-	cert, err := c.getClientCertificate(&CertificateRequestInfo{
-		AcceptableCAs:    hs.certReq.certificateAuthorities,
-		SignatureSchemes: hs.certReq.supportedSignatureAlgorithms,
-	})
-	if err != nil {
-		return err
+	// The client certificate is not sent to a server that rejected ECH, as
+	// it's meant for the server in the ClientHelloInner.
+	cert := new(Certificate)
+	if ech := hs.echContext; ech == nil || !ech.rejected {
+		var err error
+		cert, err = c.getClientCertificate(&CertificateRequestInfo{
+			AcceptableCAs:    hs.certReq.certificateAuthorities,
+			SignatureSchemes: hs.certReq.supportedSignatureAlgorithms,
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	certMsg := new(certificateMsgTLS13)
@@ -649,25 +764,17 @@ func (c *Conn) handleNewSessionTicket(msg *newSessionTicketMsgTLS13) error {
 		return c.sendAlert(alertInternalError)
 	}
 
-	// Save the resumption_master_secret and nonce instead of deriving the PSK
-	// to do the least amount of work on NewSessionTicket messages before we
-	// know if the ticket will be used. Forward secrecy of resumed connections
-	// is guaranteed by the requirement for pskModeDHE.
-	session := &ClientSessionState{
-		sessionTicket:      msg.label,
-		vers:               c.vers,
-		cipherSuite:        c.cipherSuite,
-		masterSecret:       c.resumptionSecret,
-		serverCertificates: c.peerCertificates,
-		verifiedChains:     c.verifiedChains,
-		receivedAt:         c.config.time(),
-		nonce:              msg.nonce,
-		useBy:              c.config.time().Add(lifetime),
-		ageAdd:             msg.ageAdd,
-	}
+	psk := cipherSuite.expandLabel(c.resumptionSecret, "resumption",
+		msg.nonce, cipherSuite.hash.Size())
+
+	session := c.sessionState()
+	session.secret = psk
+	session.useBy = uint64(c.config.time().Add(lifetime).Unix())
+	session.ageAdd = msg.ageAdd
+	cs := &ClientSessionState{ticket: msg.label, session: session}
 
 	cacheKey := clientSessionCacheKey(c.conn.RemoteAddr(), c.config)
-	c.config.ClientSessionCache.Put(cacheKey, session)
+	c.config.ClientSessionCache.Put(cacheKey, cs)
 
 	return nil
 }
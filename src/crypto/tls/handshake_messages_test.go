@@ -6,6 +6,7 @@ package tls
 
 import (
 	"bytes"
+	"crypto/x509"
 	"math/rand"
 	"reflect"
 	"strings"
@@ -28,8 +29,7 @@ var tests = []interface{}{
 	&clientKeyExchangeMsg{},
 	&nextProtoMsg{},
 	&newSessionTicketMsg{},
-	&sessionState{},
-	&sessionStateTLS13{},
+	&SessionState{},
 	&encryptedExtensionsMsg{},
 	&endOfEarlyDataMsg{},
 	&keyUpdateMsg{},
@@ -194,6 +194,9 @@ func (*clientHelloMsg) Generate(rand *rand.Rand, size int) reflect.Value {
 	if rand.Intn(10) > 5 {
 		m.earlyData = true
 	}
+	if rand.Intn(10) > 5 {
+		m.encryptedClientHello = randomBytes(rand.Intn(500)+1, rand)
+	}
 
 	return reflect.ValueOf(m)
 }
@@ -249,6 +252,9 @@ func (*serverHelloMsg) Generate(rand *rand.Rand, size int) reflect.Value {
 		m.selectedIdentityPresent = true
 		m.selectedIdentity = uint16(rand.Intn(0xffff))
 	}
+	if rand.Intn(10) > 5 {
+		m.encryptedClientHello = randomBytes(8, rand)
+	}
 
 	return reflect.ValueOf(m)
 }
@@ -259,6 +265,9 @@ func (*encryptedExtensionsMsg) Generate(rand *rand.Rand, size int) reflect.Value
 	if rand.Intn(10) > 5 {
 		m.alpnProtocol = randomString(rand.Intn(32)+1, rand)
 	}
+	if rand.Intn(10) > 5 {
+		m.echRetryConfigs = randomBytes(rand.Intn(200)+1, rand)
+	}
 
 	return reflect.ValueOf(m)
 }
@@ -320,36 +329,64 @@ func (*newSessionTicketMsg) Generate(rand *rand.Rand, size int) reflect.Value {
 	return reflect.ValueOf(m)
 }
 
-func (*sessionState) Generate(rand *rand.Rand, size int) reflect.Value {
-	s := &sessionState{}
-	s.vers = uint16(rand.Intn(10000))
-	s.cipherSuite = uint16(rand.Intn(10000))
-	s.masterSecret = randomBytes(rand.Intn(100), rand)
-	numCerts := rand.Intn(20)
-	s.certificates = make([][]byte, numCerts)
-	for i := 0; i < numCerts; i++ {
-		s.certificates[i] = randomBytes(rand.Intn(10)+1, rand)
+// marshal and unmarshal let the encoding of a SessionState
+// be tested like those of the handshake messages.
+func (s *SessionState) marshal() []byte {
+	b, err := s.Bytes()
+	if err != nil {
+		panic(err)
 	}
-	return reflect.ValueOf(s)
+	return b
+}
+
+func (s *SessionState) unmarshal(b []byte) bool {
+	ss, err := ParseSessionState(b)
+	if err != nil {
+		return false
+	}
+	*s = *ss
+	return true
 }
 
-func (*sessionStateTLS13) Generate(rand *rand.Rand, size int) reflect.Value {
-	s := &sessionStateTLS13{}
+func (*SessionState) Generate(rand *rand.Rand, size int) reflect.Value {
+	s := &SessionState{}
+	s.version = uint16(rand.Intn(10000))
+	s.isClient = rand.Intn(10) > 5
 	s.cipherSuite = uint16(rand.Intn(10000))
-	s.resumptionSecret = randomBytes(rand.Intn(100)+1, rand)
 	s.createdAt = uint64(rand.Int63())
-	for i := 0; i < rand.Intn(2)+1; i++ {
-		s.certificate.Certificate = append(
-			s.certificate.Certificate, randomBytes(rand.Intn(500)+1, rand))
+	s.secret = randomBytes(rand.Intn(100)+1, rand)
+	for i, n := 0, rand.Intn(3); i < n; i++ {
+		s.Extra = append(s.Extra, randomBytes(rand.Intn(100), rand))
 	}
 	if rand.Intn(10) > 5 {
-		s.certificate.OCSPStaple = randomBytes(rand.Intn(100)+1, rand)
+		s.EarlyData = true
+		s.alpnProtocol = randomString(rand.Intn(10)+1, rand)
 	}
 	if rand.Intn(10) > 5 {
-		for i := 0; i < rand.Intn(2)+1; i++ {
-			s.certificate.SignedCertificateTimestamps = append(
-				s.certificate.SignedCertificateTimestamps, randomBytes(rand.Intn(500)+1, rand))
+		leaf, err := x509.ParseCertificate(testRSACertificate)
+		if err != nil {
+			panic(err)
+		}
+		issuer, err := x509.ParseCertificate(testRSACertificateIssuer)
+		if err != nil {
+			panic(err)
+		}
+		s.peerCertificates = []*x509.Certificate{leaf}
+		if rand.Intn(10) > 5 {
+			s.ocspResponse = randomBytes(rand.Intn(100)+1, rand)
 		}
+		if rand.Intn(10) > 5 {
+			for i := 0; i < rand.Intn(2)+1; i++ {
+				s.scts = append(s.scts, randomBytes(rand.Intn(500)+1, rand))
+			}
+		}
+		if rand.Intn(10) > 5 {
+			s.verifiedChains = [][]*x509.Certificate{{leaf, issuer}}
+		}
+	}
+	if s.isClient && s.version >= VersionTLS13 {
+		s.useBy = uint64(rand.Int63())
+		s.ageAdd = uint32(rand.Int63())
 	}
 	return reflect.ValueOf(s)
 }
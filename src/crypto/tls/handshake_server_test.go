@@ -88,7 +88,7 @@ func testClientHelloFailure(t *testing.T, serverConfig *Config, m handshakeMessa
 		c.Close()
 	}()
 	conn := Server(s, serverConfig)
-	ch, err := conn.readClientHello()
+	ch, _, err := conn.readClientHello()
 	hs := serverHandshakeState{
 		c:           conn,
 		clientHello: ch,
@@ -1458,7 +1458,7 @@ func TestSNIGivenOnFailure(t *testing.T) {
 		c.Close()
 	}()
 	conn := Server(s, serverConfig)
-	ch, err := conn.readClientHello()
+	ch, _, err := conn.readClientHello()
 	hs := serverHandshakeState{
 		c:           conn,
 		clientHello: ch,
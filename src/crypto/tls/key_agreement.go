@@ -137,7 +137,7 @@ type ecdheKeyAgreement struct {
 }
 
 func (ka *ecdheKeyAgreement) generateServerKeyExchange(config *Config, cert *Certificate, clientHello *clientHelloMsg, hello *serverHelloMsg) (*serverKeyExchangeMsg, error) {
-	preferredCurves := config.curvePreferences()
+	preferredCurves := config.curvePreferences(hello.vers)
 
 	var curveID CurveID
 NextCandidate:
@@ -7,6 +7,7 @@ package tls
 import (
 	"crypto/elliptic"
 	"crypto/hmac"
+	"crypto/internal/mlkem768"
 	"errors"
 	"golang.org/x/crypto/cryptobyte"
 	"golang.org/x/crypto/curve25519"
@@ -101,7 +102,8 @@ func (c *cipherSuiteTLS13) exportKeyingMaterial(masterSecret []byte, transcript
 }
 
 // ecdheParameters implements Diffie-Hellman with either NIST curves or X25519,
-// according to RFC 8446, Section 4.2.8.2.
+// according to RFC 8446, Section 4.2.8.2, or the client side of the
+// X25519MLKEM768 hybrid key exchange.
 type ecdheParameters interface {
 	CurveID() CurveID
 	PublicKey() []byte
@@ -110,12 +112,20 @@ type ecdheParameters interface {
 
 func generateECDHEParameters(rand io.Reader, curveID CurveID) (ecdheParameters, error) {
 	if curveID == X25519 {
-		p := &x25519Parameters{}
-		if _, err := io.ReadFull(rand, p.privateKey[:]); err != nil {
+		return generateX25519Parameters(rand)
+	}
+
+	if curveID == X25519MLKEM768 {
+		// The ML-KEM key goes first, as it does in the key share.
+		dk, err := mlkem768.GenerateKey(rand)
+		if err != nil {
+			return nil, err
+		}
+		x, err := generateX25519Parameters(rand)
+		if err != nil {
 			return nil, err
 		}
-		curve25519.ScalarBaseMult(&p.publicKey, &p.privateKey)
-		return p, nil
+		return &x25519MLKEMParameters{mlkem: dk, x25519: x}, nil
 	}
 
 	curve, ok := curveForCurveID(curveID)
@@ -176,6 +186,15 @@ func (p *nistParameters) SharedKey(peerPublicKey []byte) []byte {
 	return sharedKey
 }
 
+func generateX25519Parameters(rand io.Reader) (*x25519Parameters, error) {
+	p := &x25519Parameters{}
+	if _, err := io.ReadFull(rand, p.privateKey[:]); err != nil {
+		return nil, err
+	}
+	curve25519.ScalarBaseMult(&p.publicKey, &p.privateKey)
+	return p, nil
+}
+
 type x25519Parameters struct {
 	privateKey [32]byte
 	publicKey  [32]byte
@@ -198,3 +217,62 @@ func (p *x25519Parameters) SharedKey(peerPublicKey []byte) []byte {
 	curve25519.ScalarMult(&sharedKey, &p.privateKey, &theirPublicKey)
 	return sharedKey[:]
 }
+
+// x25519MLKEMParameters holds the client keys for the X25519MLKEM768 hybrid
+// key exchange. The key share is the ML-KEM-768 encapsulation key followed by
+// the X25519 public key, and the shared secret is the ML-KEM-768 shared key
+// followed by the X25519 shared secret. See
+// draft-kwiatkowski-tls-ecdhe-mlkem-02, Section 3.
+type x25519MLKEMParameters struct {
+	mlkem  *mlkem768.DecapsulationKey
+	x25519 *x25519Parameters
+}
+
+func (p *x25519MLKEMParameters) CurveID() CurveID {
+	return X25519MLKEM768
+}
+
+func (p *x25519MLKEMParameters) PublicKey() []byte {
+	return append(p.mlkem.EncapsulationKey(), p.x25519.PublicKey()...)
+}
+
+func (p *x25519MLKEMParameters) SharedKey(peerPublicKey []byte) []byte {
+	if len(peerPublicKey) != mlkem768.CiphertextSize+32 {
+		return nil
+	}
+	mlkemShared, err := mlkem768.Decapsulate(p.mlkem, peerPublicKey[:mlkem768.CiphertextSize])
+	if err != nil {
+		return nil
+	}
+	x25519Shared := p.x25519.SharedKey(peerPublicKey[mlkem768.CiphertextSize:])
+	if x25519Shared == nil {
+		return nil
+	}
+	return append(mlkemShared, x25519Shared...)
+}
+
+// x25519MLKEMEncapsulate implements the server side of the X25519MLKEM768
+// hybrid key exchange. It returns the server key share and the shared secret,
+// or a nil shared secret if the client key share is invalid. An error is only
+// returned if reading from rand fails.
+func x25519MLKEMEncapsulate(rand io.Reader, clientShare []byte) (serverShare, sharedKey []byte, err error) {
+	if len(clientShare) != mlkem768.EncapsulationKeySize+32 {
+		return nil, nil, nil
+	}
+	m := make([]byte, 32)
+	if _, err := io.ReadFull(rand, m); err != nil {
+		return nil, nil, err
+	}
+	x, err := generateX25519Parameters(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	ciphertext, mlkemShared, err := mlkem768.EncapsulateDerand(clientShare[:mlkem768.EncapsulationKeySize], m)
+	if err != nil {
+		return nil, nil, nil
+	}
+	x25519Shared := x.SharedKey(clientShare[mlkem768.EncapsulationKeySize:])
+	serverShare = append(ciphertext, x.PublicKey()...)
+	sharedKey = append(mlkemShared, x25519Shared...)
+	return serverShare, sharedKey, nil
+}
@@ -0,0 +1,228 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSessionStateRoundTrip(t *testing.T) {
+	leaf, err := x509.ParseCertificate(testRSACertificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := x509.ParseCertificate(testRSACertificateIssuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	states := []*SessionState{
+		{
+			version:     VersionTLS12,
+			cipherSuite: TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			createdAt:   1234,
+			secret:      bytes.Repeat([]byte{1}, 48),
+		},
+		{
+			Extra:            [][]byte{[]byte("app1"), nil, []byte("app2")},
+			version:          VersionTLS12,
+			isClient:         true,
+			cipherSuite:      TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			createdAt:        5678,
+			secret:           bytes.Repeat([]byte{2}, 48),
+			peerCertificates: []*x509.Certificate{leaf, issuer},
+			verifiedChains:   [][]*x509.Certificate{{leaf, issuer}, {leaf}},
+		},
+		{
+			EarlyData:        true,
+			version:          VersionTLS13,
+			isClient:         true,
+			cipherSuite:      TLS_AES_128_GCM_SHA256,
+			createdAt:        91011,
+			secret:           bytes.Repeat([]byte{3}, 32),
+			peerCertificates: []*x509.Certificate{leaf},
+			ocspResponse:     []byte("ocsp"),
+			scts:             [][]byte{[]byte("sct1"), []byte("sct2")},
+			alpnProtocol:     "h2",
+			useBy:            121314,
+			ageAdd:           0xdeadbeef,
+		},
+	}
+
+	for i, s := range states {
+		b, err := s.Bytes()
+		if err != nil {
+			t.Fatalf("#%d: Bytes: %v", i, err)
+		}
+		s2, err := ParseSessionState(b)
+		if err != nil {
+			t.Fatalf("#%d: ParseSessionState: %v", i, err)
+		}
+		b2, err := s2.Bytes()
+		if err != nil {
+			t.Fatalf("#%d: Bytes after parsing: %v", i, err)
+		}
+		if !bytes.Equal(b, b2) {
+			t.Errorf("#%d: encoding changed after a round-trip", i)
+		}
+		if s2.version != s.version || s2.isClient != s.isClient ||
+			s2.cipherSuite != s.cipherSuite || s2.createdAt != s.createdAt ||
+			!bytes.Equal(s2.secret, s.secret) || s2.EarlyData != s.EarlyData ||
+			s2.alpnProtocol != s.alpnProtocol || s2.useBy != s.useBy ||
+			s2.ageAdd != s.ageAdd || len(s2.Extra) != len(s.Extra) ||
+			len(s2.peerCertificates) != len(s.peerCertificates) ||
+			len(s2.verifiedChains) != len(s.verifiedChains) {
+			t.Errorf("#%d: parsed session doesn't match: got %+v, want %+v", i, s2, s)
+		}
+		for j := range s.verifiedChains {
+			if len(s2.verifiedChains[j]) != len(s.verifiedChains[j]) {
+				t.Errorf("#%d: verified chain %d has length %d, want %d", i, j,
+					len(s2.verifiedChains[j]), len(s.verifiedChains[j]))
+			}
+		}
+
+		for n := 0; n < len(b); n++ {
+			if _, err := ParseSessionState(b[:n]); err == nil {
+				t.Errorf("#%d: ParseSessionState accepted a truncated encoding of %d bytes", i, n)
+				break
+			}
+		}
+		if _, err := ParseSessionState(append(b, 0)); err == nil {
+			t.Errorf("#%d: ParseSessionState accepted trailing data", i)
+		}
+	}
+}
+
+func TestWrapUnwrapSession(t *testing.T) {
+	for _, version := range []uint16{VersionTLS12, VersionTLS13} {
+		t.Run(fmt.Sprintf("%x", version), func(t *testing.T) {
+			testWrapUnwrapSession(t, version)
+		})
+	}
+}
+
+func testWrapUnwrapSession(t *testing.T, version uint16) {
+	var wrapped, unwrapped int
+	serverConfig := testConfig.Clone()
+	serverConfig.MaxVersion = version
+	serverConfig.WrapSession = func(cs ConnectionState, ss *SessionState) ([]byte, error) {
+		wrapped++
+		if cs.Version != version {
+			t.Errorf("WrapSession got version %x, want %x", cs.Version, version)
+		}
+		ss.Extra = append(ss.Extra, []byte("test extra"))
+		return serverConfig.EncryptTicket(cs, ss)
+	}
+	serverConfig.UnwrapSession = func(identity []byte, cs ConnectionState) (*SessionState, error) {
+		unwrapped++
+		ss, err := serverConfig.DecryptTicket(identity, cs)
+		if err != nil || ss == nil {
+			return ss, err
+		}
+		if len(ss.Extra) != 1 || string(ss.Extra[0]) != "test extra" {
+			return nil, errors.New("missing Extra in unwrapped session")
+		}
+		return ss, nil
+	}
+
+	clientConfig := testConfig.Clone()
+	clientConfig.MaxVersion = version
+	clientConfig.ClientSessionCache = NewLRUClientSessionCache(1)
+
+	for i, wantResume := range []bool{false, true} {
+		_, cs, err := testHandshake(t, clientConfig, serverConfig)
+		if err != nil {
+			t.Fatalf("handshake %d: %v", i, err)
+		}
+		if cs.DidResume != wantResume {
+			t.Errorf("handshake %d: DidResume = %v, want %v", i, cs.DidResume, wantResume)
+		}
+	}
+	if wrapped == 0 || unwrapped == 0 {
+		t.Errorf("WrapSession called %d times, UnwrapSession called %d times", wrapped, unwrapped)
+	}
+
+	serverConfig.UnwrapSession = func(identity []byte, cs ConnectionState) (*SessionState, error) {
+		return nil, errors.New("unwrap failure")
+	}
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err == nil {
+		t.Error("handshake succeeded with a failing UnwrapSession")
+	}
+}
+
+func TestClientSessionPersistence(t *testing.T) {
+	for _, version := range []uint16{VersionTLS12, VersionTLS13} {
+		t.Run(fmt.Sprintf("%x", version), func(t *testing.T) {
+			serverConfig := testConfig.Clone()
+			serverConfig.MaxVersion = version
+
+			clientConfig := testConfig.Clone()
+			clientConfig.MaxVersion = version
+			cache := &persistentSessionCache{t: t, m: make(map[string][2][]byte)}
+			clientConfig.ClientSessionCache = cache
+
+			if _, cs, err := testHandshake(t, clientConfig, serverConfig); err != nil {
+				t.Fatal(err)
+			} else if cs.DidResume {
+				t.Fatal("first handshake resumed")
+			}
+			if len(cache.m) == 0 {
+				t.Fatal("no session was stored")
+			}
+			if _, cs, err := testHandshake(t, clientConfig, serverConfig); err != nil {
+				t.Fatal(err)
+			} else if !cs.DidResume {
+				t.Fatal("session loaded from serialized state was not resumed")
+			}
+		})
+	}
+}
+
+// persistentSessionCache is a ClientSessionCache that stores sessions in
+// serialized form, as if to persist them across process restarts.
+type persistentSessionCache struct {
+	t *testing.T
+	m map[string][2][]byte
+}
+
+func (c *persistentSessionCache) Get(sessionKey string) (*ClientSessionState, bool) {
+	v, ok := c.m[sessionKey]
+	if !ok {
+		return nil, false
+	}
+	state, err := ParseSessionState(v[1])
+	if err != nil {
+		c.t.Errorf("ParseSessionState: %v", err)
+		return nil, false
+	}
+	session, err := NewResumptionState(v[0], state)
+	if err != nil {
+		c.t.Errorf("NewResumptionState: %v", err)
+		return nil, false
+	}
+	return session, true
+}
+
+func (c *persistentSessionCache) Put(sessionKey string, cs *ClientSessionState) {
+	if cs == nil {
+		delete(c.m, sessionKey)
+		return
+	}
+	ticket, state, err := cs.ResumptionState()
+	if err != nil {
+		c.t.Errorf("ResumptionState: %v", err)
+		return
+	}
+	b, err := state.Bytes()
+	if err != nil {
+		c.t.Errorf("Bytes: %v", err)
+		return
+	}
+	c.m[sessionKey] = [2][]byte{ticket, b}
+}
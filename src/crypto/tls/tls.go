@@ -8,6 +8,10 @@
 // TLS 1.3 is available on an opt-out basis in Go 1.13. To disable
 // it, set the GODEBUG environment variable (comma-separated key=value
 // options) such that it includes "tls13=0".
+//
+// The X25519MLKEM768 post-quantum hybrid key exchange is enabled by default
+// when Config.CurvePreferences is not set. To remove it from the defaults,
+// set the GODEBUG environment variable such that it includes "tlsmlkem=0".
 package tls
 
 // BUG(agl): The crypto/tls package only implements some countermeasures
@@ -639,7 +639,7 @@ func TestWarningAlertFlood(t *testing.T) {
 }
 
 func TestCloneFuncFields(t *testing.T) {
-	const expectedCount = 5
+	const expectedCount = 7
 	called := 0
 
 	c1 := Config{
@@ -663,6 +663,14 @@ func TestCloneFuncFields(t *testing.T) {
 			called |= 1 << 4
 			return nil
 		},
+		UnwrapSession: func(identity []byte, cs ConnectionState) (*SessionState, error) {
+			called |= 1 << 5
+			return nil, nil
+		},
+		WrapSession: func(cs ConnectionState, ss *SessionState) ([]byte, error) {
+			called |= 1 << 6
+			return nil, nil
+		},
 	}
 
 	c2 := c1.Clone()
@@ -672,6 +680,8 @@ func TestCloneFuncFields(t *testing.T) {
 	c2.GetClientCertificate(nil)
 	c2.GetConfigForClient(nil)
 	c2.VerifyPeerCertificate(nil, nil)
+	c2.UnwrapSession(nil, ConnectionState{})
+	c2.WrapSession(ConnectionState{}, nil)
 
 	if called != (1<<expectedCount)-1 {
 		t.Fatalf("expected %d calls but saw calls %b", expectedCount, called)
@@ -695,7 +705,7 @@ func TestCloneNonFuncFields(t *testing.T) {
 		switch fn := typ.Field(i).Name; fn {
 		case "Rand":
 			f.Set(reflect.ValueOf(io.Reader(os.Stdin)))
-		case "Time", "GetCertificate", "GetConfigForClient", "VerifyPeerCertificate", "GetClientCertificate":
+		case "Time", "GetCertificate", "GetConfigForClient", "VerifyPeerCertificate", "GetClientCertificate", "UnwrapSession", "WrapSession":
 			// DeepEqual can't compare functions. If you add a
 			// function field to this list, you must also change
 			// TestCloneFuncFields to ensure that the func field is
@@ -730,6 +740,12 @@ func TestCloneNonFuncFields(t *testing.T) {
 			f.Set(reflect.ValueOf([]CurveID{CurveP256}))
 		case "Renegotiation":
 			f.Set(reflect.ValueOf(RenegotiateOnceAsClient))
+		case "EncryptedClientHelloConfigList":
+			f.Set(reflect.ValueOf([]byte{'x'}))
+		case "EncryptedClientHelloKeys":
+			f.Set(reflect.ValueOf([]EncryptedClientHelloKey{
+				{Config: []byte{1}, PrivateKey: []byte{1}},
+			}))
 		default:
 			t.Errorf("all fields must be accounted for, but saw unknown field %q", fn)
 		}
@@ -1118,6 +1134,82 @@ func TestTLS13Switch(t *testing.T) {
 	expectVersion(t, tls12Config, tls12Config, VersionTLS12)
 }
 
+func TestX25519MLKEM768(t *testing.T) {
+	clientConfig := testConfig.Clone()
+	serverConfig := testConfig.Clone()
+	serverConfig.CurvePreferences = []CurveID{X25519MLKEM768}
+
+	// The default client sends a hybrid key share.
+	clientConfig.CurvePreferences = nil
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err != nil {
+		t.Fatalf("default client: %v", err)
+	}
+
+	// A server that doesn't support the hybrid picks the X25519 share sent
+	// alongside it.
+	clientConfig.CurvePreferences = []CurveID{X25519MLKEM768, X25519}
+	serverConfig.CurvePreferences = []CurveID{X25519}
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err != nil {
+		t.Fatalf("X25519 fallback: %v", err)
+	}
+
+	// HelloRetryRequest from X25519 to the hybrid, and back.
+	clientConfig.CurvePreferences = []CurveID{X25519, X25519MLKEM768}
+	serverConfig.CurvePreferences = []CurveID{X25519MLKEM768}
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err != nil {
+		t.Fatalf("HelloRetryRequest to X25519MLKEM768: %v", err)
+	}
+	clientConfig.CurvePreferences = []CurveID{X25519MLKEM768, CurveP256}
+	serverConfig.CurvePreferences = []CurveID{CurveP256}
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err != nil {
+		t.Fatalf("HelloRetryRequest from X25519MLKEM768: %v", err)
+	}
+
+	// The hybrid is never negotiated in TLS 1.2.
+	clientConfig.CurvePreferences = []CurveID{X25519MLKEM768}
+	serverConfig.CurvePreferences = []CurveID{X25519MLKEM768}
+	serverConfig.MaxVersion = VersionTLS12
+	serverConfig.CipherSuites = []uint16{TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err == nil {
+		t.Fatal("X25519MLKEM768 negotiated in TLS 1.2")
+	}
+	serverConfig.CurvePreferences = nil
+	clientConfig.CurvePreferences = nil
+	expectVersion(t, clientConfig, serverConfig, VersionTLS12)
+}
+
+// TestMLKEMSwitch checks the behavior of GODEBUG=tlsmlkem=[0|1].
+func TestMLKEMSwitch(t *testing.T) {
+	defer func(savedGODEBUG string) {
+		os.Setenv("GODEBUG", savedGODEBUG)
+		mlkemSupport.Once = sync.Once{} // reset the cache
+	}(os.Getenv("GODEBUG"))
+
+	clientConfig := testConfig.Clone()
+	clientConfig.CurvePreferences = nil
+	serverConfig := testConfig.Clone()
+	serverConfig.CurvePreferences = []CurveID{X25519MLKEM768}
+
+	os.Setenv("GODEBUG", "tlsmlkem=0")
+	mlkemSupport.Once = sync.Once{} // reset the cache
+
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err == nil {
+		t.Error("X25519MLKEM768 negotiated with GODEBUG=tlsmlkem=0")
+	}
+	serverConfig.CurvePreferences = nil
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err != nil {
+		t.Errorf("default handshake with GODEBUG=tlsmlkem=0: %v", err)
+	}
+
+	os.Setenv("GODEBUG", "tlsmlkem=1")
+	mlkemSupport.Once = sync.Once{} // reset the cache
+
+	serverConfig.CurvePreferences = []CurveID{X25519MLKEM768}
+	if _, _, err := testHandshake(t, clientConfig, serverConfig); err != nil {
+		t.Errorf("X25519MLKEM768 not negotiated with GODEBUG=tlsmlkem=1: %v", err)
+	}
+}
+
 // Issue 28744: Ensure that we don't modify memory
 // that Config doesn't own such as Certificates.
 func TestBuildNameToCertificate_doesntModifyCertificates(t *testing.T) {
@@ -0,0 +1,334 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// BatchResult is the outcome of executing one set of arguments of
+// a batch. Exactly one of Result and Err is non-nil.
+type BatchResult struct {
+	Result Result
+	Err    error
+}
+
+// ExecBatchContext executes a query that returns no rows once for each
+// set of arguments in batch, and returns one BatchResult per set of
+// arguments, in order.
+//
+// If the driver implements driver.BatchExecerContext, the whole batch
+// is sent to the database at once. Otherwise the query is prepared once
+// and executed for each set of arguments in turn on the same connection.
+// In both cases, a failure to convert or execute one set of arguments
+// does not stop the rest of the batch, and is reported in the
+// corresponding BatchResult; a set of arguments that cannot be converted
+// to driver values is not sent to the database.
+//
+// The returned error reports a failure of the batch as a whole, such as
+// a canceled context or a lost connection. In that case the results hold
+// the outcome of the sets of arguments executed before the failure, if any.
+func (db *DB) ExecBatchContext(ctx context.Context, query string, batch [][]interface{}) ([]BatchResult, error) {
+	var res []BatchResult
+	var err error
+	for i := 0; i < maxBadConnRetries; i++ {
+		res, err = db.execBatch(ctx, query, batch, cachedOrNewConn)
+		if err != driver.ErrBadConn || len(res) > 0 {
+			return res, err
+		}
+	}
+	return db.execBatch(ctx, query, batch, alwaysNewConn)
+}
+
+// ExecBatch executes a query that returns no rows once for each
+// set of arguments in batch. See ExecBatchContext for details.
+func (db *DB) ExecBatch(query string, batch [][]interface{}) ([]BatchResult, error) {
+	return db.ExecBatchContext(context.Background(), query, batch)
+}
+
+func (db *DB) execBatch(ctx context.Context, query string, batch [][]interface{}, strategy connReuseStrategy) ([]BatchResult, error) {
+	dc, err := db.conn(ctx, strategy)
+	if err != nil {
+		return nil, err
+	}
+	return db.execBatchDC(ctx, dc, dc.releaseConn, query, batch)
+}
+
+// ExecBatchContext executes a query that returns no rows once for each
+// set of arguments in batch. See DB.ExecBatchContext for details.
+func (c *Conn) ExecBatchContext(ctx context.Context, query string, batch [][]interface{}) ([]BatchResult, error) {
+	dc, release, err := c.grabConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.db.execBatchDC(ctx, dc, release, query, batch)
+}
+
+// ExecBatchContext executes a query that returns no rows once for each
+// set of arguments in batch. See DB.ExecBatchContext for details.
+func (tx *Tx) ExecBatchContext(ctx context.Context, query string, batch [][]interface{}) ([]BatchResult, error) {
+	dc, release, err := tx.grabConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.db.execBatchDC(ctx, dc, release, query, batch)
+}
+
+// ExecBatch executes a query that returns no rows once for each
+// set of arguments in batch. See DB.ExecBatchContext for details.
+func (tx *Tx) ExecBatch(query string, batch [][]interface{}) ([]BatchResult, error) {
+	return tx.ExecBatchContext(context.Background(), query, batch)
+}
+
+// execBatchDC executes a batch on dc. The whole batch is reported
+// to the Hook as a single OpExec event without arguments.
+func (db *DB) execBatchDC(ctx context.Context, dc *driverConn, release func(error), query string, batch [][]interface{}) (res []BatchResult, err error) {
+	done := db.startHook(ctx, OpExec, query, nil)
+	defer func() {
+		done(err)
+		release(err)
+	}()
+	if bec, ok := dc.ci.(driver.BatchExecerContext); ok {
+		// Argument sets that cannot be converted are left out of the
+		// batch sent to the driver, and fail on their own, as they do
+		// when the batch is executed one set at a time below.
+		res = make([]BatchResult, len(batch))
+		var (
+			nvbatch [][]driver.NamedValue
+			index   []int // index in batch of each element of nvbatch
+			resi    []driver.BatchResult
+		)
+		withLock(dc, func() {
+			for i, args := range batch {
+				nvdargs, cerr := driverArgsConnLocked(dc.ci, nil, args)
+				if cerr != nil {
+					res[i].Err = cerr
+					continue
+				}
+				nvbatch = append(nvbatch, nvdargs)
+				index = append(index, i)
+			}
+			if len(nvbatch) > 0 {
+				resi, err = bec.ExecBatchContext(ctx, query, nvbatch)
+			}
+		})
+		if err != driver.ErrSkip {
+			if err != nil {
+				return nil, err
+			}
+			if len(resi) != len(nvbatch) {
+				return nil, fmt.Errorf("sql: driver returned %d batch results for %d argument sets", len(resi), len(nvbatch))
+			}
+			for j, r := range resi {
+				i := index[j]
+				if r.Err != nil {
+					res[i].Err = r.Err
+				} else {
+					res[i].Result = driverResult{dc, r.Result}
+				}
+			}
+			return res, nil
+		}
+		err = nil
+	}
+
+	var si driver.Stmt
+	withLock(dc, func() {
+		si, err = ctxDriverPrepare(ctx, dc.ci, query)
+	})
+	if err != nil {
+		return nil, err
+	}
+	ds := &driverStmt{Locker: dc, si: si}
+	defer ds.Close()
+	res = make([]BatchResult, 0, len(batch))
+	for _, args := range batch {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		r, rerr := resultFromStatement(ctx, dc.ci, ds, args...)
+		if rerr == driver.ErrBadConn {
+			return res, rerr
+		}
+		res = append(res, BatchResult{Result: r, Err: rerr})
+	}
+	return res, nil
+}
+
+// A CopySource is an iterator over the rows copied by CopyFrom.
+type CopySource interface {
+	// Next advances to the next row, returning false when there
+	// are no more rows or an error occurred.
+	Next() bool
+
+	// Values returns the values of the current row, one per
+	// copied column.
+	Values() ([]interface{}, error)
+
+	// Err returns the error, if any, that stopped the iteration.
+	Err() error
+}
+
+// CopyFromRows returns a CopySource that iterates over rows.
+func CopyFromRows(rows [][]interface{}) CopySource {
+	return &rowsCopySource{rows: rows, i: -1}
+}
+
+type rowsCopySource struct {
+	rows [][]interface{}
+	i    int
+}
+
+func (s *rowsCopySource) Next() bool {
+	s.i++
+	return s.i < len(s.rows)
+}
+
+func (s *rowsCopySource) Values() ([]interface{}, error) {
+	return s.rows[s.i], nil
+}
+
+func (s *rowsCopySource) Err() error {
+	return nil
+}
+
+// ErrCopyNotSupported is returned by CopyFrom when the driver does not
+// implement driver.Copier. Callers may fall back to ExecBatch with an
+// INSERT statement written for their database.
+var ErrCopyNotSupported = errors.New("sql: driver does not support CopyFrom")
+
+// CopyFromContext copies the rows read from src into the named columns
+// of table using the database's bulk-copy protocol, and returns the
+// number of rows copied. The table and column names are passed to the
+// driver as given.
+//
+// If the driver does not implement driver.Copier, CopyFromContext
+// returns ErrCopyNotSupported without reading from src.
+func (db *DB) CopyFromContext(ctx context.Context, table string, columns []string, src CopySource) (int64, error) {
+	cs := &copySource{src: src}
+	var n int64
+	var err error
+	for i := 0; i < maxBadConnRetries; i++ {
+		n, err = db.copyFrom(ctx, table, columns, cs, cachedOrNewConn)
+		if err != driver.ErrBadConn || cs.started {
+			return n, err
+		}
+	}
+	return db.copyFrom(ctx, table, columns, cs, alwaysNewConn)
+}
+
+// CopyFrom copies the rows read from src into the named columns
+// of table. See CopyFromContext for details.
+func (db *DB) CopyFrom(table string, columns []string, src CopySource) (int64, error) {
+	return db.CopyFromContext(context.Background(), table, columns, src)
+}
+
+func (db *DB) copyFrom(ctx context.Context, table string, columns []string, cs *copySource, strategy connReuseStrategy) (int64, error) {
+	dc, err := db.conn(ctx, strategy)
+	if err != nil {
+		return 0, err
+	}
+	return db.copyFromDC(ctx, dc, dc.releaseConn, table, columns, cs)
+}
+
+// CopyFromContext copies the rows read from src into the named columns
+// of table. See DB.CopyFromContext for details.
+func (c *Conn) CopyFromContext(ctx context.Context, table string, columns []string, src CopySource) (int64, error) {
+	dc, release, err := c.grabConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return c.db.copyFromDC(ctx, dc, release, table, columns, &copySource{src: src})
+}
+
+// CopyFromContext copies the rows read from src into the named columns
+// of table. See DB.CopyFromContext for details.
+func (tx *Tx) CopyFromContext(ctx context.Context, table string, columns []string, src CopySource) (int64, error) {
+	dc, release, err := tx.grabConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return tx.db.copyFromDC(ctx, dc, release, table, columns, &copySource{src: src})
+}
+
+// CopyFrom copies the rows read from src into the named columns
+// of table. See DB.CopyFromContext for details.
+func (tx *Tx) CopyFrom(table string, columns []string, src CopySource) (int64, error) {
+	return tx.CopyFromContext(context.Background(), table, columns, src)
+}
+
+var errNoCopyColumns = errors.New("sql: CopyFrom requires at least one column")
+
+// copyFromDC copies the rows of cs into table on dc. The copy is
+// reported to the Hook as a single OpCopy event.
+func (db *DB) copyFromDC(ctx context.Context, dc *driverConn, release func(error), table string, columns []string, cs *copySource) (n int64, err error) {
+	defer func() {
+		release(err)
+	}()
+	if len(columns) == 0 {
+		return 0, errNoCopyColumns
+	}
+	done := db.startHook(ctx, OpCopy, table, nil)
+	defer func() {
+		done(err)
+	}()
+	copier, ok := dc.ci.(driver.Copier)
+	if !ok {
+		return 0, ErrCopyNotSupported
+	}
+	withLock(dc, func() {
+		cs.ci = dc.ci
+		n, err = copier.CopyFrom(ctx, table, columns, cs)
+	})
+	if err == driver.ErrSkip {
+		if cs.started {
+			return n, errors.New("sql: driver returned ErrSkip from CopyFrom after reading rows")
+		}
+		return 0, ErrCopyNotSupported
+	}
+	return n, err
+}
+
+// copySource adapts a CopySource to a driver.CopySource,
+// converting the values of each row for the driver.
+type copySource struct {
+	src     CopySource
+	ci      driver.Conn // connection the values are converted for
+	started bool        // whether src.Next has been called
+}
+
+// Next implements driver.CopySource.
+// It is called by the driver with the connection locked.
+func (cs *copySource) Next(dest []driver.Value) error {
+	cs.started = true
+	if !cs.src.Next() {
+		if err := cs.src.Err(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	args, err := cs.src.Values()
+	if err != nil {
+		return err
+	}
+	if len(args) != len(dest) {
+		return fmt.Errorf("sql: copy source returned %d values for %d columns", len(args), len(dest))
+	}
+	nvdargs, err := driverArgsConnLocked(cs.ci, nil, args)
+	if err != nil {
+		return err
+	}
+	if len(nvdargs) != len(dest) {
+		return fmt.Errorf("sql: copy source returned %d values for %d columns", len(nvdargs), len(dest))
+	}
+	for i, nv := range nvdargs {
+		dest[i] = nv.Value
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// batchConnector is a fakeConnector whose connections implement
+// driver.BatchExecerContext and driver.Copier.
+type batchConnector struct {
+	fakeConnector
+
+	batches int // calls to ExecBatchContext
+	copies  int // calls to CopyFrom
+}
+
+func (c *batchConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.fakeConnector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &batchConn{fakeConn: conn.(*fakeConn), bc: c}, nil
+}
+
+type batchConn struct {
+	*fakeConn
+	bc *batchConnector
+}
+
+func (c *batchConn) ExecBatchContext(ctx context.Context, query string, batch [][]driver.NamedValue) ([]driver.BatchResult, error) {
+	c.bc.batches++
+	return c.execBatch(ctx, query, batch)
+}
+
+func (c *batchConn) execBatch(ctx context.Context, query string, batch [][]driver.NamedValue) ([]driver.BatchResult, error) {
+	si, err := c.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer si.Close()
+	res := make([]driver.BatchResult, len(batch))
+	for i, args := range batch {
+		res[i].Result, res[i].Err = si.(driver.StmtExecContext).ExecContext(ctx, args)
+	}
+	return res, nil
+}
+
+func (c *batchConn) CopyFrom(ctx context.Context, table string, columns []string, src driver.CopySource) (int64, error) {
+	c.bc.copies++
+	var batch [][]driver.NamedValue
+	for {
+		row := make([]driver.Value, len(columns))
+		err := src.Next(row)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		args := make([]driver.NamedValue, len(row))
+		for i, v := range row {
+			args[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+		}
+		batch = append(batch, args)
+	}
+	cols := make([]string, len(columns))
+	for i, col := range columns {
+		cols[i] = col + "=?"
+	}
+	res, err := c.execBatch(ctx, "INSERT|"+table+"|"+strings.Join(cols, ","), batch)
+	if err != nil {
+		return 0, err
+	}
+	for i, r := range res {
+		if r.Err != nil {
+			return int64(i), r.Err
+		}
+	}
+	return int64(len(res)), nil
+}
+
+func peopleNames(t *testing.T, db *DB) []string {
+	t.Helper()
+	rows, err := db.Query("SELECT|people|name|")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+// testExecBatchConversion checks that a set of arguments that cannot be
+// converted fails on its own, without stopping the rest of the batch.
+func testExecBatchConversion(t *testing.T, conn *Conn) {
+	t.Helper()
+	res, err := conn.ExecBatchContext(context.Background(), "INSERT|people|name=?,age=?", [][]interface{}{
+		{"Xavier", 10},
+		{"Yuri", complex(1, 2)},
+		{"Zoe", 11},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 3 {
+		t.Fatalf("got %d results; want 3", len(res))
+	}
+	for i, r := range res {
+		if (r.Err != nil) != (i == 1) {
+			t.Errorf("result %d: unexpected error %v", i, r.Err)
+		}
+		if (r.Result == nil) != (r.Err != nil) {
+			t.Errorf("result %d: Result %v, Err %v", i, r.Result, r.Err)
+		}
+	}
+}
+
+func TestExecBatch(t *testing.T) {
+	db := newTestDB(t, "people")
+	defer closeDB(t, db)
+
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.dc.ci.(*fakeConn).skipDirtySession = true
+
+	res, err := conn.ExecBatchContext(ctx, "INSERT|people|name=?,age=?", [][]interface{}{
+		{"Dave", 4},
+		{"Eve", "not a number"},
+		{"Frank", 6},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 3 {
+		t.Fatalf("got %d results; want 3", len(res))
+	}
+	for i, r := range res {
+		if (r.Err != nil) != (i == 1) {
+			t.Errorf("result %d: unexpected error %v", i, r.Err)
+		}
+		if (r.Result == nil) != (r.Err != nil) {
+			t.Errorf("result %d: Result %v, Err %v", i, r.Result, r.Err)
+		}
+	}
+	if n, err := res[0].Result.RowsAffected(); n != 1 || err != nil {
+		t.Errorf("RowsAffected = %d, %v; want 1, nil", n, err)
+	}
+	testExecBatchConversion(t, conn)
+	res, err = db.ExecBatch("INSERT|people|name=?,age=?", [][]interface{}{{"Grace", 7}})
+	if err != nil || len(res) != 1 || res[0].Err != nil {
+		t.Fatalf("ExecBatch = %v, %v", res, err)
+	}
+	want := []string{"Alice", "Bob", "Chris", "Dave", "Frank", "Xavier", "Zoe", "Grace"}
+	if got := peopleNames(t, db); !reflect.DeepEqual(got, want) {
+		t.Errorf("people = %q; want %q", got, want)
+	}
+
+	// A failure to prepare the query fails the whole batch.
+	if _, err := db.ExecBatch("INSERT|nosuchtable|name=?", [][]interface{}{{"x"}}); err == nil {
+		t.Error("ExecBatch on missing table succeeded")
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.ExecBatch("INSERT|people|name=?,age=?", [][]interface{}{{"Heidi", 8}, {"Ivan", 9}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	want = append(want, "Heidi", "Ivan")
+	if got := peopleNames(t, db); !reflect.DeepEqual(got, want) {
+		t.Errorf("after commit, people = %q; want %q", got, want)
+	}
+}
+
+func TestCopyFromNotSupported(t *testing.T) {
+	db := newTestDB(t, "people")
+	defer closeDB(t, db)
+
+	src := &errCopySource{n: 1}
+	n, err := db.CopyFrom("people", []string{"name", "age"}, src)
+	if n != 0 || err != ErrCopyNotSupported {
+		t.Fatalf("CopyFrom = %d, %v; want 0, %v", n, err, ErrCopyNotSupported)
+	}
+	if src.n != 1 {
+		t.Errorf("CopyFrom read from the source")
+	}
+}
+
+type errCopySource struct {
+	n   int
+	err error
+}
+
+func (s *errCopySource) Next() bool {
+	s.n--
+	return s.n >= 0
+}
+
+func (s *errCopySource) Values() ([]interface{}, error) {
+	return []interface{}{"Zed", s.n}, nil
+}
+
+func (s *errCopySource) Err() error {
+	return s.err
+}
+
+func TestBatchDriver(t *testing.T) {
+	bc := &batchConnector{fakeConnector: fakeConnector{name: fakeDBName}}
+	db := OpenDB(bc)
+	defer closeDB(t, db)
+	exec(t, db, "WIPE")
+	exec(t, db, "CREATE|people|name=string,age=int32")
+
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.dc.ci.(*batchConn).skipDirtySession = true
+
+	res, err := conn.ExecBatchContext(ctx, "INSERT|people|name=?,age=?", [][]interface{}{
+		{"Alice", 1},
+		{"Bob", 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || res[0].Err != nil || res[1].Err != nil {
+		t.Fatalf("ExecBatchContext = %v", res)
+	}
+
+	testExecBatchConversion(t, conn)
+
+	n, err := conn.CopyFromContext(ctx, "people", []string{"name", "age"}, CopyFromRows([][]interface{}{
+		{"Chris", 3},
+		{"Dave", 4},
+	}))
+	if n != 2 || err != nil {
+		t.Fatalf("CopyFromContext = %d, %v; want 2, nil", n, err)
+	}
+	n, err = db.CopyFrom("people", []string{"name", "age"}, CopyFromRows([][]interface{}{
+		{"Eve", 5},
+	}))
+	if n != 1 || err != nil {
+		t.Fatalf("CopyFrom = %d, %v; want 1, nil", n, err)
+	}
+
+	// Errors from the source, and values that cannot be converted,
+	// are returned by the driver.
+	errSource := errors.New("source failed")
+	n, err = conn.CopyFromContext(ctx, "people", []string{"name", "age"}, &errCopySource{n: 1, err: errSource})
+	if n != 0 || err != errSource {
+		t.Fatalf("CopyFromContext = %d, %v; want 0, %v", n, err, errSource)
+	}
+	n, err = conn.CopyFromContext(ctx, "people", []string{"name", "age"}, CopyFromRows([][]interface{}{
+		{"Frank", 6},
+		{"Grace", complex(1, 2)},
+	}))
+	if n != 0 || err == nil {
+		t.Fatalf("CopyFromContext = %d, %v; want 0, error", n, err)
+	}
+	n, err = conn.CopyFromContext(ctx, "people", []string{"name", "age"}, CopyFromRows([][]interface{}{{"Heidi"}}))
+	if n != 0 || err == nil || !strings.Contains(err.Error(), "1 values for 2 columns") {
+		t.Fatalf("CopyFromContext = %d, %v; want 0, error about the number of values", n, err)
+	}
+	if _, err := conn.CopyFromContext(ctx, "people", nil, CopyFromRows(nil)); err != errNoCopyColumns {
+		t.Fatalf("CopyFromContext with no columns: %v; want %v", err, errNoCopyColumns)
+	}
+
+	if bc.batches != 2 || bc.copies != 5 {
+		t.Errorf("driver saw %d batches and %d copies; want 2 and 5", bc.batches, bc.copies)
+	}
+	want := []string{"Alice", "Bob", "Xavier", "Zoe", "Chris", "Dave", "Eve"}
+	if got := peopleNames(t, db); !reflect.DeepEqual(got, want) {
+		t.Errorf("people = %q; want %q", got, want)
+	}
+}
@@ -264,6 +264,70 @@ type SessionResetter interface {
 	ResetSession(ctx context.Context) error
 }
 
+// Validator may be implemented by Conn to allow drivers to signal
+// whether a pooled connection is still usable, for instance because
+// the server or a load balancer in front of it has since closed it.
+type Validator interface {
+	// IsValid is called before a connection from the connection
+	// pool is handed out for reuse, after any call to ResetSession.
+	// The connection is closed and discarded if false is returned.
+	IsValid() bool
+}
+
+// BatchResult is the outcome of executing one set of arguments
+// of a batch. Exactly one of Result and Err should be non-nil.
+type BatchResult struct {
+	Result Result
+	Err    error
+}
+
+// BatchExecerContext is an optional interface that may be implemented by a Conn.
+//
+// ExecBatchContext executes query once for each element of batch, ideally
+// in a single round trip to the database, and returns one BatchResult per
+// element of batch, in order. An error executing one set of arguments is
+// reported in the corresponding BatchResult; the returned error is reserved
+// for failures of the batch as a whole, such as a lost connection.
+//
+// If a Conn does not implement BatchExecerContext, the sql package's
+// DB.ExecBatch will prepare the query once and execute the statement
+// for each set of arguments in turn.
+//
+// ExecBatchContext may return ErrSkip.
+//
+// ExecBatchContext must honor the context timeout and return when the context is canceled.
+type BatchExecerContext interface {
+	ExecBatchContext(ctx context.Context, query string, batch [][]NamedValue) ([]BatchResult, error)
+}
+
+// CopySource is an iterator over the rows to be copied by a Copier.
+type CopySource interface {
+	// Next is called to populate the next row to copy into the
+	// provided slice, which has one element per copied column.
+	//
+	// Next returns io.EOF when there are no more rows. Any other
+	// error must be returned by CopyFrom.
+	Next(dest []Value) error
+}
+
+// Copier is an optional interface that may be implemented by a Conn
+// to load rows into a table using the database's bulk-copy protocol.
+//
+// CopyFrom copies the rows read from src into the named columns of
+// table, and returns the number of rows copied. The table and column
+// names are passed exactly as given to DB.CopyFrom.
+//
+// If a Conn does not implement Copier, the sql package's DB.CopyFrom
+// returns sql.ErrCopyNotSupported.
+//
+// CopyFrom may return ErrSkip, but only before calling src.Next;
+// DB.CopyFrom then returns sql.ErrCopyNotSupported.
+//
+// CopyFrom must honor the context timeout and return when the context is canceled.
+type Copier interface {
+	CopyFrom(ctx context.Context, table string, columns []string, src CopySource) (int64, error)
+}
+
 // Result is the result of a query execution.
 type Result interface {
 	// LastInsertId returns the database's auto-generated ID
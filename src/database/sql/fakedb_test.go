@@ -134,6 +134,8 @@ type fakeConn struct {
 	bad       bool
 	stickyBad bool
 
+	invalid bool // IsValid reports false
+
 	skipDirtySession bool // tests that use Conn should set this to true.
 
 	// dirtySession tests ResetSession, true if a query has executed
@@ -399,6 +401,12 @@ func (c *fakeConn) ResetSession(ctx context.Context) error {
 	return nil
 }
 
+var _ driver.Validator = (*fakeConn)(nil)
+
+func (c *fakeConn) IsValid() bool {
+	return !c.invalid
+}
+
 func (c *fakeConn) Close() (err error) {
 	drv := fdriver.(*fakeDriver)
 	defer func() {
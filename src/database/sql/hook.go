@@ -0,0 +1,131 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// An Op identifies the kind of operation reported to a Hook.
+type Op int
+
+const (
+	OpConn     Op = iota + 1 // acquire a connection from the pool or the driver
+	OpPrepare                // prepare a statement on a connection
+	OpExec                   // execute a statement that returns no rows
+	OpQuery                  // execute a query that returns rows
+	OpNext                   // advance Rows to the next row
+	OpBegin                  // begin a transaction
+	OpCommit                 // commit a transaction
+	OpRollback               // roll back a transaction
+	OpCopy                   // copy rows into a table with CopyFrom
+)
+
+var opNames = [...]string{
+	OpConn:     "Conn",
+	OpPrepare:  "Prepare",
+	OpExec:     "Exec",
+	OpQuery:    "Query",
+	OpNext:     "Next",
+	OpBegin:    "Begin",
+	OpCommit:   "Commit",
+	OpRollback: "Rollback",
+	OpCopy:     "Copy",
+}
+
+// String returns the name of the operation.
+func (op Op) String() string {
+	if op > 0 && int(op) < len(opNames) {
+		return opNames[op]
+	}
+	return "Op(" + strconv.Itoa(int(op)) + ")"
+}
+
+// A HookEvent describes one operation performed by a DB or by
+// one of the Conns, Txs, Stmts or Rows obtained from it.
+//
+// The same HookEvent is passed to a Hook's Before and After methods.
+// The fields describing the operation are set before Before is called;
+// Duration and Err are set before After is called.
+type HookEvent struct {
+	Op    Op
+	Query string        // SQL text, for OpPrepare, OpExec, OpQuery and OpNext; table name, for OpCopy
+	Args  []interface{} // arguments, for OpExec and OpQuery
+	Start time.Time     // when the operation started
+
+	Duration time.Duration // how long the operation took
+	Err      error         // error returned by the operation, if any
+}
+
+// A Hook observes the operations performed by a DB, for instance
+// to trace or log individual queries. See DB.SetHook.
+//
+// A statement executed through the DB, a Conn or a Tx is reported as an
+// OpExec or OpQuery event, possibly preceded by an OpConn event for
+// acquiring the connection. A statement prepared with Prepare is
+// reported as an OpPrepare event each time it is prepared on a
+// connection, and each execution as an OpExec or OpQuery event.
+// A batch executed with ExecBatch is reported as a single OpExec event
+// without arguments, and a copy made with CopyFrom as a single OpCopy
+// event.
+// Each call to Rows.Next that reads from the driver is reported as an
+// OpNext event, including the one that reaches the end of the rows,
+// which is not an error; calls after the Rows are closed are not.
+// Operations retried because the driver reported a bad connection are
+// reported once per attempt.
+//
+// Hook methods are called synchronously by the goroutine performing
+// the operation, and may be called concurrently for different
+// operations. They must not call back into the DB.
+type Hook interface {
+	// Before is called before the operation starts.
+	// The returned context is passed to After;
+	// it is not used by the operation itself.
+	Before(ctx context.Context, ev *HookEvent) context.Context
+
+	// After is called after the operation completes.
+	After(ctx context.Context, ev *HookEvent)
+}
+
+// hookHolder is the type stored in DB.hook, since an atomic.Value
+// must always hold values of the same concrete type.
+type hookHolder struct {
+	h Hook
+}
+
+// SetHook sets the Hook that observes the operations performed by db
+// and by the Conns, Txs, Stmts and Rows obtained from it.
+// A nil Hook removes the current one.
+//
+// Operations already in progress may continue to report to
+// the previous Hook.
+func (db *DB) SetHook(h Hook) {
+	db.hook.Store(hookHolder{h})
+}
+
+func noopHookDone(error) {}
+
+// startHook reports the start of an operation to db's Hook,
+// if any, and returns the function to call with the operation's
+// error when it completes.
+func (db *DB) startHook(ctx context.Context, op Op, query string, args []interface{}) func(error) {
+	hh, _ := db.hook.Load().(hookHolder)
+	h := hh.h
+	if h == nil {
+		return noopHookDone
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ev := &HookEvent{Op: op, Query: query, Args: args, Start: nowFunc()}
+	ctx = h.Before(ctx, ev)
+	return func(err error) {
+		ev.Duration = nowFunc().Sub(ev.Start)
+		ev.Err = err
+		h.After(ctx, ev)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type hookCtxKey struct{}
+
+// recordingHook records a line per completed operation.
+type recordingHook struct {
+	t *testing.T
+
+	mu     sync.Mutex
+	events []string
+	errs   []error
+}
+
+func (h *recordingHook) Before(ctx context.Context, ev *HookEvent) context.Context {
+	if ev.Start.IsZero() {
+		h.t.Errorf("%v: zero Start time", ev.Op)
+	}
+	return context.WithValue(ctx, hookCtxKey{}, ev.Op)
+}
+
+func (h *recordingHook) After(ctx context.Context, ev *HookEvent) {
+	if op, _ := ctx.Value(hookCtxKey{}).(Op); op != ev.Op {
+		h.t.Errorf("%v: After called with context from Before of %v", ev.Op, op)
+	}
+	if ev.Duration < 0 {
+		h.t.Errorf("%v: negative Duration %v", ev.Op, ev.Duration)
+	}
+	s := ev.Op.String()
+	if ev.Query != "" {
+		s += " " + ev.Query
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, s)
+	if ev.Err != nil {
+		h.errs = append(h.errs, ev.Err)
+	}
+}
+
+func (h *recordingHook) take() (events []string, errs []error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	events, errs = h.events, h.errs
+	h.events, h.errs = nil, nil
+	return events, errs
+}
+
+func TestHook(t *testing.T) {
+	db := newTestDB(t, "people")
+	defer closeDB(t, db)
+
+	h := &recordingHook{t: t}
+	db.SetHook(h)
+
+	check := func(name string, want ...string) {
+		t.Helper()
+		got, errs := h.take()
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: events:\nhave %q\nwant %q", name, got, want)
+		}
+		for _, err := range errs {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+	}
+
+	const sel = "SELECT|people|name|"
+	rows, err := db.Query(sel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for rows.Next() {
+		n++
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Fatalf("got %d rows; want 3", n)
+	}
+	check("Query",
+		"Conn", "Query "+sel,
+		"Next "+sel, "Next "+sel, "Next "+sel, "Next "+sel)
+
+	// Next on closed Rows reads nothing and is not reported.
+	if rows.Next() {
+		t.Error("Next after the end of the rows returned true")
+	}
+	check("Next after end")
+	rows, err = db.Query(sel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rows.Next() {
+		t.Fatal("Next returned false")
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if rows.Next() {
+		t.Error("Next after Close returned true")
+	}
+	check("Next after Close", "Conn", "Query "+sel, "Next "+sel)
+
+	const ins = "INSERT|people|name=?,age=?"
+	if _, err := db.Exec(ins, "Dave", 4); err != nil {
+		t.Fatal(err)
+	}
+	check("Exec", "Conn", "Exec "+ins)
+
+	stmt, err := db.Prepare(ins)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("Prepare", "Conn", "Prepare "+ins)
+	if _, err := stmt.Exec("Eve", 5); err != nil {
+		t.Fatal(err)
+	}
+	stmt.Close()
+	check("Stmt.Exec", "Conn", "Exec "+ins)
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(ins, "Frank", 6); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	check("Commit", "Conn", "Begin", "Exec "+ins, "Commit")
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	check("Rollback", "Conn", "Begin", "Rollback")
+
+	if _, err := db.ExecBatch(ins, [][]interface{}{{"Gina", 7}, {"Hank", 8}}); err != nil {
+		t.Fatal(err)
+	}
+	check("ExecBatch", "Conn", "Exec "+ins)
+	if _, err := db.CopyFrom("people", []string{"name", "age"}, CopyFromRows([][]interface{}{{"Ida", 9}})); err != ErrCopyNotSupported {
+		t.Fatalf("CopyFrom: %v; want %v", err, ErrCopyNotSupported)
+	}
+	got, errs := h.take()
+	if want := []string{"Conn", "Copy people"}; !reflect.DeepEqual(got, want) || len(errs) != 1 || errs[0] != ErrCopyNotSupported {
+		t.Errorf("CopyFrom: events %q, errors %v; want %q, %v", got, errs, want, ErrCopyNotSupported)
+	}
+
+	// Errors are reported to the hook.
+	if _, err := db.Exec("INSERT|nosuchtable|name=?", "x"); err == nil {
+		t.Fatal("Exec on missing table succeeded")
+	}
+	got, errs = h.take()
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "nosuchtable") {
+		t.Errorf("events %q: errors %v; want one error mentioning nosuchtable", got, errs)
+	}
+
+	// A nil Hook removes the current one.
+	db.SetHook(nil)
+	if _, err := db.Exec(ins, "Grace", 7); err != nil {
+		t.Fatal(err)
+	}
+	check("nil Hook")
+}
+
+func TestOpString(t *testing.T) {
+	for op, want := range map[Op]string{
+		OpConn:     "Conn",
+		OpRollback: "Rollback",
+		0:          "Op(0)",
+		100:        "Op(100)",
+	} {
+		if got := op.String(); got != want {
+			t.Errorf("Op(%d).String() = %q; want %q", int(op), got, want)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ScanStruct copies the columns in the current row into the fields of
+// the struct pointed at by dest.
+//
+// Each column is stored in the field whose name, or whose "sql" key in
+// the field's tag, matches the column name, ignoring case. Fields with
+// the tag `sql:"-"` and unexported fields are ignored. The fields of
+// embedded structs are matched as if they were fields of the outer
+// struct, following the usual Go rules for promoted fields; embedded
+// pointers to structs are allocated as needed. An embedded struct with
+// a tag, or whose pointer implements Scanner, is matched as a single
+// field.
+//
+// It is an error for a column to match no field, or to match more than
+// one field at the same depth. Fields that match no column are left
+// unchanged. The values are converted as described for Scan.
+func (rs *Rows) ScanStruct(dest interface{}) error {
+	return rs.scanStruct(dest, true)
+}
+
+// ScanStruct copies the columns from the matched row into the fields
+// of the struct pointed at by dest, as described for Rows.ScanStruct.
+// If more than one row matches the query, ScanStruct uses the first
+// row and discards the rest. If no row matches the query, ScanStruct
+// returns ErrNoRows.
+func (r *Row) ScanStruct(dest interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	// See the comment in Row.Scan about RawBytes.
+	defer r.rows.Close()
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return err
+		}
+		return ErrNoRows
+	}
+	if err := r.rows.scanStruct(dest, false); err != nil {
+		return err
+	}
+	// Make sure the query can be processed to completion with no errors.
+	return r.rows.Close()
+}
+
+var rawBytesType = reflect.TypeOf(RawBytes(nil))
+
+func (rs *Rows) scanStruct(dest interface{}, allowRawBytes bool) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("sql: ScanStruct destination must be a non-nil pointer to a struct, not %T", dest)
+	}
+	sv := rv.Elem()
+	cols, err := rs.Columns()
+	if err != nil {
+		return err
+	}
+
+	fields := cachedStructFields(sv.Type())
+	index := make([][]int, len(cols))
+	seen := make(map[string]bool, len(cols))
+	for i, col := range cols {
+		key := strings.ToLower(col)
+		if seen[key] {
+			return fmt.Errorf("sql: ScanStruct: column %q appears more than once", col)
+		}
+		seen[key] = true
+		f, ok := fields[key]
+		if !ok {
+			return fmt.Errorf("sql: ScanStruct: column %q matches no field of %v", col, sv.Type())
+		}
+		if f.ambiguous {
+			return fmt.Errorf("sql: ScanStruct: column %q matches more than one field of %v", col, sv.Type())
+		}
+		index[i] = f.index
+	}
+
+	args := make([]interface{}, len(cols))
+	for i := range cols {
+		fv := fieldByIndexAlloc(sv, index[i])
+		if !allowRawBytes && fv.Type() == rawBytesType {
+			return errors.New("sql: RawBytes isn't allowed on Row.ScanStruct")
+		}
+		args[i] = fv.Addr().Interface()
+	}
+	return rs.Scan(args...)
+}
+
+// fieldByIndexAlloc returns the nested field of v with the given index,
+// allocating the embedded struct pointers along the way.
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// A structField is the field that a column name resolves to.
+type structField struct {
+	index     []int
+	ambiguous bool
+}
+
+var structFieldsCache sync.Map // map[reflect.Type]map[string]structField
+
+// cachedStructFields is like typeStructFields but uses a cache
+// to avoid repeated work.
+func cachedStructFields(t reflect.Type) map[string]structField {
+	if f, ok := structFieldsCache.Load(t); ok {
+		return f.(map[string]structField)
+	}
+	f, _ := structFieldsCache.LoadOrStore(t, typeStructFields(t))
+	return f.(map[string]structField)
+}
+
+var scannerType = reflect.TypeOf((*Scanner)(nil)).Elem()
+
+// typeStructFields returns the fields of struct type t that columns
+// can be scanned into, keyed by lower-case column name.
+func typeStructFields(t reflect.Type) map[string]structField {
+	type candidate struct {
+		index  []int
+		tagged bool
+	}
+	byName := make(map[string][]candidate)
+
+	visiting := make(map[reflect.Type]bool)
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		if visiting[t] {
+			return
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("sql")
+			if tag == "-" {
+				continue
+			}
+			idx := make([]int, len(index)+1)
+			copy(idx, index)
+			idx[len(index)] = i
+
+			if sf.Anonymous && tag == "" {
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct && !reflect.PtrTo(ft).Implements(scannerType) {
+					// Embedded pointers to unexported types
+					// cannot be allocated.
+					if sf.PkgPath != "" && sf.Type.Kind() == reflect.Ptr {
+						continue
+					}
+					walk(ft, idx)
+					continue
+				}
+			}
+			if sf.PkgPath != "" {
+				continue // unexported
+			}
+			name := tag
+			if name == "" {
+				name = sf.Name
+			}
+			key := strings.ToLower(name)
+			byName[key] = append(byName[key], candidate{idx, tag != ""})
+		}
+	}
+	walk(t, nil)
+
+	fields := make(map[string]structField, len(byName))
+	for key, cands := range byName {
+		// Keep the shallowest fields, preferring a single tagged one.
+		depth := len(cands[0].index)
+		for _, c := range cands[1:] {
+			if len(c.index) < depth {
+				depth = len(c.index)
+			}
+		}
+		var best []candidate
+		var tagged []candidate
+		for _, c := range cands {
+			if len(c.index) == depth {
+				best = append(best, c)
+				if c.tagged {
+					tagged = append(tagged, c)
+				}
+			}
+		}
+		if len(best) > 1 && len(tagged) == 1 {
+			best = tagged
+		}
+		fields[key] = structField{index: best[0].index, ambiguous: len(best) > 1}
+	}
+	return fields
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type Audit struct {
+	Born *time.Time `sql:"bdate"`
+}
+
+type scanPerson struct {
+	Name   string
+	Years  int64  `sql:"age"`
+	Ignore string `sql:"-"`
+	*Audit
+	photo []byte
+}
+
+func TestScanStruct(t *testing.T) {
+	db := newTestDB(t, "people")
+	defer closeDB(t, db)
+
+	rows, err := db.Query("SELECT|people|name,age,bdate|")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var got []scanPerson
+	for rows.Next() {
+		p := scanPerson{Ignore: "kept"}
+		if err := rows.ScanStruct(&p); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, p)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d rows; want 3", len(got))
+	}
+	for i, name := range []string{"Alice", "Bob", "Chris"} {
+		p := got[i]
+		if p.Name != name || p.Years != int64(i+1) || p.Ignore != "kept" || p.Audit == nil {
+			t.Errorf("row %d = %+v", i, p)
+		}
+	}
+	if got[0].Born != nil || got[2].Born == nil || !got[2].Born.Equal(chrisBirthday) {
+		t.Errorf("born %v, %v; want nil, %v", got[0].Born, got[2].Born, chrisBirthday)
+	}
+
+	var p scanPerson
+	if err := db.QueryRow("SELECT|people|name|age=?", 2).ScanStruct(&p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Bob" || p.Audit != nil {
+		t.Errorf("QueryRow.ScanStruct = %+v; want Bob without audit", p)
+	}
+	var upper struct{ NAME string }
+	if err := db.QueryRow("SELECT|people|name|age=?", 2).ScanStruct(&upper); err != nil || upper.NAME != "Bob" {
+		t.Errorf("QueryRow.ScanStruct = %+v, %v; want Bob", upper, err)
+	}
+	if err := db.QueryRow("SELECT|people|name|age=?", 99).ScanStruct(&p); err != ErrNoRows {
+		t.Errorf("QueryRow.ScanStruct with no rows: %v; want ErrNoRows", err)
+	}
+}
+
+func TestScanStructErrors(t *testing.T) {
+	db := newTestDB(t, "people")
+	defer closeDB(t, db)
+
+	type ambiguousA struct{ Name string }
+	type ambiguousB struct{ Name string }
+	type ambiguous struct {
+		ambiguousA
+		ambiguousB
+	}
+	type rawBytes struct{ Name RawBytes }
+
+	for _, tt := range []struct {
+		query string
+		dest  interface{}
+		err   string
+	}{
+		{"SELECT|people|name,photo|", &scanPerson{}, `column "photo" matches no field of sql.scanPerson`},
+		{"SELECT|people|name,name|", &scanPerson{}, `column "name" appears more than once`},
+		{"SELECT|people|name|", &ambiguous{}, `column "name" matches more than one field`},
+		{"SELECT|people|name|", scanPerson{}, "must be a non-nil pointer to a struct, not sql.scanPerson"},
+		{"SELECT|people|name|", (*scanPerson)(nil), "must be a non-nil pointer to a struct"},
+		{"SELECT|people|name|", new(string), "must be a non-nil pointer to a struct, not *string"},
+		{"SELECT|people|name|", &rawBytes{}, "RawBytes isn't allowed on Row.ScanStruct"},
+		{"SELECT|people|name,age|", &struct{ Name, Age bool }{}, `Scan error on column index 0, name "name"`},
+	} {
+		err := db.QueryRow(tt.query).ScanStruct(tt.dest)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s into %T: %v; want error containing %q", tt.query, tt.dest, err, tt.err)
+		}
+	}
+}
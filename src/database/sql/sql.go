@@ -286,6 +286,51 @@ func (n NullBool) Value() (driver.Value, error) {
 	return n.Bool, nil
 }
 
+// Null represents a value of any type that may be null.
+// V holds a pointer to the value, which may be of any type
+// that Scan accepts as a destination, including time.Time and
+// types implementing Scanner and driver.Valuer:
+//
+//  var t time.Time
+//  n := sql.Null{V: &t}
+//  err := db.QueryRow("SELECT deleted FROM foo WHERE id=?", id).Scan(&n)
+//  ...
+//  if n.Valid {
+//     // use t
+//  } else {
+//     // NULL value
+//  }
+//
+// When scanning a NULL value, the value V points to is set
+// to its zero value.
+type Null struct {
+	V     interface{} // pointer to the value
+	Valid bool        // Valid is true if the value is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (n *Null) Scan(value interface{}) error {
+	rv := reflect.ValueOf(n.V)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("sql: Null.V must be a non-nil pointer, not %T", n.V)
+	}
+	if value == nil {
+		rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+		n.Valid = false
+		return nil
+	}
+	n.Valid = true
+	return convertAssign(n.V, value)
+}
+
+// Value implements the driver Valuer interface.
+func (n Null) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
 // Scanner is an interface used by Scan.
 type Scanner interface {
 	// Scan assigns a value from a database driver.
@@ -376,12 +421,17 @@ type DB struct {
 	maxIdle           int                    // zero means defaultMaxIdleConns; negative means 0
 	maxOpen           int                    // <= 0 means unlimited
 	maxLifetime       time.Duration          // maximum amount of time a connection may be reused
+	maxIdleTime       time.Duration          // maximum amount of time a connection may be idle before being closed
 	cleanerCh         chan struct{}
 	waitCount         int64 // Total number of connections waited for.
-	maxIdleClosed     int64 // Total number of connections closed due to idle.
-	maxLifetimeClosed int64 // Total number of connections closed due to max free limit.
+	maxIdleClosed     int64 // Total number of connections closed due to idle count.
+	maxIdleTimeClosed int64 // Total number of connections closed due to idle time.
+	maxLifetimeClosed int64 // Total number of connections closed due to max connection lifetime limit.
+	validationClosed  int64 // Total number of connections closed due to failed validation.
 
 	stop func() // stop cancels the connection opener and the session resetter.
+
+	hook atomic.Value // of hookHolder; see SetHook
 }
 
 // connReuseStrategy determines how (*DB).conn returns database connections.
@@ -413,8 +463,9 @@ type driverConn struct {
 
 	// guarded by db.mu
 	inUse      bool
-	onPut      []func() // code (with db.mu held) run when conn is next returned
-	dbmuClosed bool     // same as closed, but guarded by db.mu, for removeClosedStmtLocked
+	returnedAt time.Time // time the connection was last put in the idle pool
+	onPut      []func()  // code (with db.mu held) run when conn is next returned
+	dbmuClosed bool      // same as closed, but guarded by db.mu, for removeClosedStmtLocked
 }
 
 func (dc *driverConn) releaseConn(err error) {
@@ -434,10 +485,52 @@ func (dc *driverConn) expired(timeout time.Duration) bool {
 	return dc.createdAt.Add(timeout).Before(nowFunc())
 }
 
+// validateReused reports whether dc may be handed out again. If not,
+// it closes dc. If dc was taken from the idle pool, idle is set and
+// returnedAt is the time dc was put there; dc is then also checked
+// against maxIdleTime and, if the driver implements it, driver.Validator.
+func (db *DB) validateReused(dc *driverConn, lifetime time.Duration, idle bool, returnedAt time.Time, maxIdleTime time.Duration) bool {
+	if dc.expired(lifetime) {
+		// maxLifetimeClosed counts only the connections
+		// closed by connectionCleaner.
+		dc.Close()
+		return false
+	}
+	if idle && maxIdleTime > 0 && returnedAt.Add(maxIdleTime).Before(nowFunc()) {
+		db.mu.Lock()
+		db.maxIdleTimeClosed++
+		db.mu.Unlock()
+		dc.Close()
+		return false
+	}
+	// Lock around reading lastErr to ensure the session resetter finished.
+	dc.Lock()
+	err := dc.lastErr
+	valid := true
+	if v, ok := dc.ci.(driver.Validator); ok && idle && err != driver.ErrBadConn {
+		valid = v.IsValid()
+	}
+	dc.Unlock()
+	if err == driver.ErrBadConn {
+		dc.Close()
+		return false
+	}
+	if !valid {
+		db.mu.Lock()
+		db.validationClosed++
+		db.mu.Unlock()
+		dc.Close()
+		return false
+	}
+	return true
+}
+
 // prepareLocked prepares the query on dc. When cg == nil the dc must keep track of
 // the prepared statements in a pool.
 func (dc *driverConn) prepareLocked(ctx context.Context, cg stmtConnGrabber, query string) (*driverStmt, error) {
+	done := dc.db.startHook(ctx, OpPrepare, query, nil)
 	si, err := ctxDriverPrepare(ctx, dc.ci, query)
+	done(err)
 	if err != nil {
 		return nil, err
 	}
@@ -867,7 +960,7 @@ func (db *DB) SetConnMaxLifetime(d time.Duration) {
 	}
 	db.mu.Lock()
 	// wake cleaner up when lifetime is shortened.
-	if d > 0 && d < db.maxLifetime && db.cleanerCh != nil {
+	if d > 0 && d < db.shortestIdleTimeLocked() && db.cleanerCh != nil {
 		select {
 		case db.cleanerCh <- struct{}{}:
 		default:
@@ -878,11 +971,47 @@ func (db *DB) SetConnMaxLifetime(d time.Duration) {
 	db.mu.Unlock()
 }
 
+// SetConnMaxIdleTime sets the maximum amount of time a connection may
+// sit idle in the connection pool.
+//
+// Expired connections may be closed lazily before reuse.
+//
+// If d <= 0, connections are not closed due to their idle time.
+func (db *DB) SetConnMaxIdleTime(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	db.mu.Lock()
+	// wake cleaner up when idle time is shortened.
+	if d > 0 && d < db.shortestIdleTimeLocked() && db.cleanerCh != nil {
+		select {
+		case db.cleanerCh <- struct{}{}:
+		default:
+		}
+	}
+	db.maxIdleTime = d
+	db.startCleanerLocked()
+	db.mu.Unlock()
+}
+
+// shortestIdleTimeLocked returns the interval at which connectionCleaner
+// must run: the shorter of maxIdleTime and maxLifetime, ignoring
+// zero values. It returns zero if neither is set.
+func (db *DB) shortestIdleTimeLocked() time.Duration {
+	if db.maxIdleTime <= 0 {
+		return db.maxLifetime
+	}
+	if db.maxLifetime <= 0 || db.maxIdleTime < db.maxLifetime {
+		return db.maxIdleTime
+	}
+	return db.maxLifetime
+}
+
 // startCleanerLocked starts connectionCleaner if needed.
 func (db *DB) startCleanerLocked() {
-	if db.maxLifetime > 0 && db.numOpen > 0 && db.cleanerCh == nil {
+	if d := db.shortestIdleTimeLocked(); d > 0 && db.numOpen > 0 && db.cleanerCh == nil {
 		db.cleanerCh = make(chan struct{}, 1)
-		go db.connectionCleaner(db.maxLifetime)
+		go db.connectionCleaner(d)
 	}
 }
 
@@ -897,31 +1026,18 @@ func (db *DB) connectionCleaner(d time.Duration) {
 	for {
 		select {
 		case <-t.C:
-		case <-db.cleanerCh: // maxLifetime was changed or db was closed.
+		case <-db.cleanerCh: // maxLifetime or maxIdleTime was changed or db was closed.
 		}
 
 		db.mu.Lock()
-		d = db.maxLifetime
+		d = db.shortestIdleTimeLocked()
 		if db.closed || db.numOpen == 0 || d <= 0 {
 			db.cleanerCh = nil
 			db.mu.Unlock()
 			return
 		}
 
-		expiredSince := nowFunc().Add(-d)
-		var closing []*driverConn
-		for i := 0; i < len(db.freeConn); i++ {
-			c := db.freeConn[i]
-			if c.createdAt.Before(expiredSince) {
-				closing = append(closing, c)
-				last := len(db.freeConn) - 1
-				db.freeConn[i] = db.freeConn[last]
-				db.freeConn[last] = nil
-				db.freeConn = db.freeConn[:last]
-				i--
-			}
-		}
-		db.maxLifetimeClosed += int64(len(closing))
+		closing := db.connectionCleanerRunLocked()
 		db.mu.Unlock()
 
 		for _, c := range closing {
@@ -935,6 +1051,45 @@ func (db *DB) connectionCleaner(d time.Duration) {
 	}
 }
 
+// connectionCleanerRunLocked removes the idle connections that have
+// been idle for longer than maxIdleTime or that are older than
+// maxLifetime from the pool, and returns them to be closed.
+func (db *DB) connectionCleanerRunLocked() (closing []*driverConn) {
+	if db.maxIdleTime > 0 {
+		idleSince := nowFunc().Add(-db.maxIdleTime)
+		closing = db.removeFreeConnsLocked(closing, func(c *driverConn) bool {
+			return c.returnedAt.Before(idleSince)
+		})
+		db.maxIdleTimeClosed += int64(len(closing))
+	}
+	if db.maxLifetime > 0 {
+		expiredSince := nowFunc().Add(-db.maxLifetime)
+		n := len(closing)
+		closing = db.removeFreeConnsLocked(closing, func(c *driverConn) bool {
+			return c.createdAt.Before(expiredSince)
+		})
+		db.maxLifetimeClosed += int64(len(closing) - n)
+	}
+	return closing
+}
+
+// removeFreeConnsLocked removes the idle connections for which expired
+// returns true from the pool, and appends them to closing.
+func (db *DB) removeFreeConnsLocked(closing []*driverConn, expired func(*driverConn) bool) []*driverConn {
+	for i := 0; i < len(db.freeConn); i++ {
+		c := db.freeConn[i]
+		if expired(c) {
+			closing = append(closing, c)
+			last := len(db.freeConn) - 1
+			db.freeConn[i] = db.freeConn[last]
+			db.freeConn[last] = nil
+			db.freeConn = db.freeConn[:last]
+			i--
+		}
+	}
+	return closing
+}
+
 // DBStats contains database statistics.
 type DBStats struct {
 	MaxOpenConnections int // Maximum number of open connections to the database.
@@ -948,7 +1103,9 @@ type DBStats struct {
 	WaitCount         int64         // The total number of connections waited for.
 	WaitDuration      time.Duration // The total time blocked waiting for a new connection.
 	MaxIdleClosed     int64         // The total number of connections closed due to SetMaxIdleConns.
+	MaxIdleTimeClosed int64         // The total number of connections closed due to SetConnMaxIdleTime.
 	MaxLifetimeClosed int64         // The total number of connections closed due to SetConnMaxLifetime.
+	ValidationClosed  int64         // The total number of connections closed because driver.Validator reported them invalid.
 }
 
 // Stats returns database statistics.
@@ -968,7 +1125,9 @@ func (db *DB) Stats() DBStats {
 		WaitCount:         db.waitCount,
 		WaitDuration:      time.Duration(wait),
 		MaxIdleClosed:     db.maxIdleClosed,
+		MaxIdleTimeClosed: db.maxIdleTimeClosed,
 		MaxLifetimeClosed: db.maxLifetimeClosed,
+		ValidationClosed:  db.validationClosed,
 	}
 	return stats
 }
@@ -1077,6 +1236,14 @@ func (db *DB) nextRequestKeyLocked() uint64 {
 
 // conn returns a newly-opened or cached *driverConn.
 func (db *DB) conn(ctx context.Context, strategy connReuseStrategy) (*driverConn, error) {
+	done := db.startHook(ctx, OpConn, "", nil)
+	dc, err := db.acquireConn(ctx, strategy)
+	done(err)
+	return dc, err
+}
+
+// acquireConn implements conn, without reporting to the Hook.
+func (db *DB) acquireConn(ctx context.Context, strategy connReuseStrategy) (*driverConn, error) {
 	db.mu.Lock()
 	if db.closed {
 		db.mu.Unlock()
@@ -1098,17 +1265,9 @@ func (db *DB) conn(ctx context.Context, strategy connReuseStrategy) (*driverConn
 		copy(db.freeConn, db.freeConn[1:])
 		db.freeConn = db.freeConn[:numFree-1]
 		conn.inUse = true
+		returnedAt, idleTime := conn.returnedAt, db.maxIdleTime
 		db.mu.Unlock()
-		if conn.expired(lifetime) {
-			conn.Close()
-			return nil, driver.ErrBadConn
-		}
-		// Lock around reading lastErr to ensure the session resetter finished.
-		conn.Lock()
-		err := conn.lastErr
-		conn.Unlock()
-		if err == driver.ErrBadConn {
-			conn.Close()
+		if !db.validateReused(conn, lifetime, true, returnedAt, idleTime) {
 			return nil, driver.ErrBadConn
 		}
 		return conn, nil
@@ -1152,19 +1311,10 @@ func (db *DB) conn(ctx context.Context, strategy connReuseStrategy) (*driverConn
 			if !ok {
 				return nil, errDBClosed
 			}
-			if ret.err == nil && ret.conn.expired(lifetime) {
-				ret.conn.Close()
-				return nil, driver.ErrBadConn
-			}
 			if ret.conn == nil {
 				return nil, ret.err
 			}
-			// Lock around reading lastErr to ensure the session resetter finished.
-			ret.conn.Lock()
-			err := ret.conn.lastErr
-			ret.conn.Unlock()
-			if err == driver.ErrBadConn {
-				ret.conn.Close()
+			if !db.validateReused(ret.conn, lifetime, false, time.Time{}, 0) {
 				return nil, driver.ErrBadConn
 			}
 			return ret.conn, ret.err
@@ -1323,6 +1473,7 @@ func (db *DB) putConnDBLocked(dc *driverConn, err error) bool {
 		return true
 	} else if err == nil && !db.closed {
 		if db.maxIdleConnsLocked() > len(db.freeConn) {
+			dc.returnedAt = nowFunc()
 			db.freeConn = append(db.freeConn, dc)
 			db.startCleanerLocked()
 			return true
@@ -1448,7 +1599,9 @@ func (db *DB) exec(ctx context.Context, query string, args []interface{}, strate
 }
 
 func (db *DB) execDC(ctx context.Context, dc *driverConn, release func(error), query string, args []interface{}) (res Result, err error) {
+	done := db.startHook(ctx, OpExec, query, args)
 	defer func() {
+		done(err)
 		release(err)
 	}()
 	execerCtx, ok := dc.ci.(driver.ExecerContext)
@@ -1522,7 +1675,11 @@ func (db *DB) query(ctx context.Context, query string, args []interface{}, strat
 // The connection gets released by the releaseConn function.
 // The ctx context is from a query method and the txctx context is from an
 // optional transaction context.
-func (db *DB) queryDC(ctx, txctx context.Context, dc *driverConn, releaseConn func(error), query string, args []interface{}) (*Rows, error) {
+func (db *DB) queryDC(ctx, txctx context.Context, dc *driverConn, releaseConn func(error), query string, args []interface{}) (_ *Rows, err error) {
+	done := db.startHook(ctx, OpQuery, query, args)
+	defer func() {
+		done(err)
+	}()
 	queryerCtx, ok := dc.ci.(driver.QueryerContext)
 	var queryer driver.Queryer
 	if !ok {
@@ -1531,7 +1688,6 @@ func (db *DB) queryDC(ctx, txctx context.Context, dc *driverConn, releaseConn fu
 	if ok {
 		var nvdargs []driver.NamedValue
 		var rowsi driver.Rows
-		var err error
 		withLock(dc, func() {
 			nvdargs, err = driverArgsConnLocked(dc.ci, nil, args)
 			if err != nil {
@@ -1550,6 +1706,8 @@ func (db *DB) queryDC(ctx, txctx context.Context, dc *driverConn, releaseConn fu
 				dc:          dc,
 				releaseConn: releaseConn,
 				rowsi:       rowsi,
+				ctx:         ctx,
+				query:       query,
 			}
 			rows.initContextClose(ctx, txctx)
 			return rows, nil
@@ -1557,7 +1715,6 @@ func (db *DB) queryDC(ctx, txctx context.Context, dc *driverConn, releaseConn fu
 	}
 
 	var si driver.Stmt
-	var err error
 	withLock(dc, func() {
 		si, err = ctxDriverPrepare(ctx, dc.ci, query)
 	})
@@ -1581,6 +1738,8 @@ func (db *DB) queryDC(ctx, txctx context.Context, dc *driverConn, releaseConn fu
 		releaseConn: releaseConn,
 		rowsi:       rowsi,
 		closeStmt:   ds,
+		ctx:         ctx,
+		query:       query,
 	}
 	rows.initContextClose(ctx, txctx)
 	return rows, nil
@@ -1648,6 +1807,10 @@ func (db *DB) begin(ctx context.Context, opts *TxOptions, strategy connReuseStra
 
 // beginDC starts a transaction. The provided dc must be valid and ready to use.
 func (db *DB) beginDC(ctx context.Context, dc *driverConn, release func(error), opts *TxOptions) (tx *Tx, err error) {
+	done := db.startHook(ctx, OpBegin, "", nil)
+	defer func() {
+		done(err)
+	}()
 	var txi driver.Tx
 	withLock(dc, func() {
 		txi, err = ctxDriverBegin(ctx, opts, dc.ci)
@@ -2004,9 +2167,11 @@ func (tx *Tx) Commit() error {
 		return ErrTxDone
 	}
 	var err error
+	done := tx.db.startHook(tx.ctx, OpCommit, "", nil)
 	withLock(tx.dc, func() {
 		err = tx.txi.Commit()
 	})
+	done(err)
 	if err != driver.ErrBadConn {
 		tx.closePrepared()
 	}
@@ -2021,9 +2186,11 @@ func (tx *Tx) rollback(discardConn bool) error {
 		return ErrTxDone
 	}
 	var err error
+	done := tx.db.startHook(tx.ctx, OpRollback, "", nil)
 	withLock(tx.dc, func() {
 		err = tx.txi.Rollback()
 	})
+	done(err)
 	if err != driver.ErrBadConn {
 		tx.closePrepared()
 	}
@@ -2321,7 +2488,9 @@ func (s *Stmt) ExecContext(ctx context.Context, args ...interface{}) (Result, er
 			return nil, err
 		}
 
+		done := s.db.startHook(ctx, OpExec, s.query, args)
 		res, err = resultFromStatement(ctx, dc.ci, ds, args...)
+		done(err)
 		releaseConn(err)
 		if err != driver.ErrBadConn {
 			return res, err
@@ -2466,13 +2635,17 @@ func (s *Stmt) QueryContext(ctx context.Context, args ...interface{}) (*Rows, er
 			return nil, err
 		}
 
+		done := s.db.startHook(ctx, OpQuery, s.query, args)
 		rowsi, err = rowsiFromStatement(ctx, dc.ci, ds, args...)
+		done(err)
 		if err == nil {
 			// Note: ownership of ci passes to the *Rows, to be freed
 			// with releaseConn.
 			rows := &Rows{
 				dc:    dc,
 				rowsi: rowsi,
+				ctx:   ctx,
+				query: s.query,
 				// releaseConn set below
 			}
 			// addDep must be added before initContextClose or it could attempt
@@ -2599,6 +2772,10 @@ type Rows struct {
 	cancel      func()      // called when Rows is closed, may be nil.
 	closeStmt   *driverStmt // if non-nil, statement to Close on close
 
+	// ctx and query are reported to the DB's Hook by Next.
+	ctx   context.Context
+	query string
+
 	// closemu prevents Rows from closing while there
 	// is an active streaming result. It is held for read during non-close operations
 	// and exclusively during close.
@@ -2655,7 +2832,20 @@ func (rs *Rows) awaitDone(ctx, txctx context.Context) {
 func (rs *Rows) Next() bool {
 	var doClose, ok bool
 	withLock(rs.closemu.RLocker(), func() {
+		if rs.closed {
+			// Nothing is read, so there is nothing to report.
+			return
+		}
+		done := noopHookDone
+		if rs.dc != nil {
+			done = rs.dc.db.startHook(rs.ctx, OpNext, rs.query, nil)
+		}
 		doClose, ok = rs.nextLocked()
+		var err error
+		if rs.lasterr != io.EOF {
+			err = rs.lasterr
+		}
+		done(err)
 	})
 	if doClose {
 		rs.Close()
@@ -626,12 +626,13 @@ func TestPoolExhaustOnCancel(t *testing.T) {
 	state = 1
 	for i := 0; i < max; i++ {
 		go func() {
+			defer saturateDone.Done()
 			rows, err := db.Query("SELECT|people|name,photo|")
 			if err != nil {
-				t.Fatalf("Query: %v", err)
+				t.Errorf("Query: %v", err)
+				return
 			}
 			rows.Close()
-			saturateDone.Done()
 		}()
 	}
 
@@ -1695,6 +1696,43 @@ func TestNullBoolParam(t *testing.T) {
 	nullTestRun(t, spec)
 }
 
+func TestNull(t *testing.T) {
+	db := newTestDB(t, "")
+	defer closeDB(t, db)
+	exec(t, db, "CREATE|t|id=int32,nullt=datetime,nulls=nullstring")
+
+	when := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	exec(t, db, "INSERT|t|id=?,nullt=?,nulls=?", 1, Null{V: &when, Valid: true}, Null{V: &NullString{"x", true}, Valid: true})
+	exec(t, db, "INSERT|t|id=?,nullt=?,nulls=?", 2, Null{V: &when}, Null{})
+
+	for _, tt := range []struct {
+		id    int
+		valid bool
+		want  time.Time
+	}{
+		{1, true, when},
+		{2, false, time.Time{}},
+	} {
+		got := time.Now() // overwritten by the zero value if NULL
+		var s string
+		nt, ns := Null{V: &got}, Null{V: &s}
+		if err := db.QueryRow("SELECT|t|nullt,nulls|id=?", tt.id).Scan(&nt, &ns); err != nil {
+			t.Fatalf("id=%d Scan: %v", tt.id, err)
+		}
+		if nt.Valid != tt.valid || !got.Equal(tt.want) {
+			t.Errorf("id=%d got %v (valid %v), want %v (valid %v)", tt.id, got, nt.Valid, tt.want, tt.valid)
+		}
+		if ns.Valid != tt.valid || (tt.valid && s != "x") {
+			t.Errorf("id=%d got string %q (valid %v)", tt.id, s, ns.Valid)
+		}
+	}
+
+	var n Null
+	if err := n.Scan("x"); err == nil {
+		t.Error("Scan into Null with nil V succeeded")
+	}
+}
+
 func nullTestRun(t *testing.T, spec nullTestSpec) {
 	db := newTestDB(t, "")
 	defer closeDB(t, db)
@@ -2141,6 +2179,162 @@ func TestStats(t *testing.T) {
 	}
 }
 
+func TestConnMaxIdleTime(t *testing.T) {
+	t0 := time.Unix(1000000, 0)
+	offset := time.Duration(0)
+
+	nowFunc = func() time.Time { return t0.Add(offset) }
+	defer func() { nowFunc = time.Now }()
+
+	db := newTestDB(t, "magicquery")
+	defer closeDB(t, db)
+
+	driver := db.Driver().(*fakeDriver)
+
+	// Force the number of open connections to 0 so we can get an accurate
+	// count for the test
+	db.clearAllConns(t)
+
+	driver.mu.Lock()
+	opens0 := driver.openCount
+	closes0 := driver.closeCount
+	driver.mu.Unlock()
+
+	db.SetMaxIdleConns(10)
+	db.SetMaxOpenConns(10)
+	db.SetConnMaxIdleTime(10 * time.Second)
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+	offset = 5 * time.Second
+	tx2.Commit()
+
+	// Only the first conn has been idle for more than 10s.
+	offset = 11 * time.Second
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+
+	driver.mu.Lock()
+	opens := driver.openCount - opens0
+	closes := driver.closeCount - closes0
+	driver.mu.Unlock()
+
+	if opens != 2 {
+		t.Errorf("opens = %d; want 2", opens)
+	}
+	if closes != 1 {
+		t.Errorf("closes = %d; want 1", closes)
+	}
+	if g, w := db.numFreeConns(), 1; g != w {
+		t.Errorf("free conns = %d; want %d", g, w)
+	}
+	if g := db.Stats().MaxIdleTimeClosed; g != 1 {
+		t.Errorf("MaxIdleTimeClosed = %d; want 1", g)
+	}
+
+	// The cleaner closes idle conns as well.
+	offset = 30 * time.Second
+	db.mu.Lock()
+	closing := db.connectionCleanerRunLocked()
+	db.mu.Unlock()
+	if len(closing) != 1 {
+		t.Errorf("cleaner closed %d conns; want 1", len(closing))
+	}
+	for _, c := range closing {
+		c.Close()
+	}
+	if g := db.Stats().MaxIdleTimeClosed; g != 2 {
+		t.Errorf("MaxIdleTimeClosed = %d; want 2", g)
+	}
+}
+
+func TestConnValidator(t *testing.T) {
+	db := newTestDB(t, "people")
+	defer closeDB(t, db)
+
+	driver := db.Driver().(*fakeDriver)
+	db.clearAllConns(t)
+	db.SetMaxIdleConns(1)
+
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.dc.ci.(*fakeConn).invalid = true
+	conn.Close()
+	if g, w := db.numFreeConns(), 1; g != w {
+		t.Fatalf("free conns = %d; want %d", g, w)
+	}
+
+	driver.mu.Lock()
+	opens0 := driver.openCount
+	driver.mu.Unlock()
+
+	// The invalid conn is discarded and a new one opened.
+	if err := db.PingContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	driver.mu.Lock()
+	opens := driver.openCount - opens0
+	driver.mu.Unlock()
+	if opens != 1 {
+		t.Errorf("opens = %d; want 1", opens)
+	}
+	if g := db.Stats().ValidationClosed; g != 1 {
+		t.Errorf("ValidationClosed = %d; want 1", g)
+	}
+
+	// A conn handed over to a waiting request without
+	// going through the idle pool is not validated.
+	db.SetMaxOpenConns(1)
+	conn, err = db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dc := conn.dc
+	dc.ci.(*fakeConn).invalid = true
+	got := make(chan *Conn)
+	go func() {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		got <- conn
+	}()
+	if !waitCondition(5*time.Second, 5*time.Millisecond, func() bool {
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		return len(db.connRequests) == 1
+	}) {
+		t.Fatal("timeout waiting for a conn request")
+	}
+	conn.Close()
+	conn = <-got
+	if conn == nil {
+		t.FailNow()
+	}
+	if conn.dc != dc {
+		t.Error("waiting request did not get the released conn")
+	}
+	if g := db.Stats().ValidationClosed; g != 1 {
+		t.Errorf("ValidationClosed = %d; want 1", g)
+	}
+	dc.ci.(*fakeConn).invalid = false
+	conn.Close()
+}
+
 func TestConnMaxLifetime(t *testing.T) {
 	t0 := time.Unix(1000000, 0)
 	offset := time.Duration(0)
@@ -2223,6 +2417,12 @@ func TestConnMaxLifetime(t *testing.T) {
 	if closes != 1 {
 		t.Errorf("closes = %d; want 1", closes)
 	}
+
+	// The expired conn was closed before reuse, not by the
+	// cleaner, so it does not count in MaxLifetimeClosed.
+	if g := db.Stats().MaxLifetimeClosed; g != 0 {
+		t.Errorf("MaxLifetimeClosed = %d; want 0", g)
+	}
 }
 
 // golang.org/issue/5323
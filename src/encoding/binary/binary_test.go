@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"unsafe"
 )
 
 type Struct struct {
@@ -141,6 +142,103 @@ func TestBigEndianRead(t *testing.T)     { testRead(t, BigEndian, big, s) }
 func TestBigEndianWrite(t *testing.T)    { testWrite(t, BigEndian, big, s) }
 func TestBigEndianPtrWrite(t *testing.T) { testWrite(t, BigEndian, big, &s) }
 
+func testDecode(t *testing.T, order ByteOrder, b []byte, s1 interface{}) {
+	var s2 Struct
+	n, err := Decode(b, order, &s2)
+	if err == nil && n != len(b) {
+		t.Errorf("Decode %v: consumed %d bytes; want %d", order, n, len(b))
+	}
+	checkResult(t, "Decode", order, err, s2, s1)
+}
+
+func testEncode(t *testing.T, order ByteOrder, b []byte, s1 interface{}) {
+	buf := make([]byte, len(b)+1)
+	n, err := Encode(buf, order, s1)
+	if err == nil && n != len(b) {
+		t.Errorf("Encode %v: wrote %d bytes; want %d", order, n, len(b))
+	}
+	checkResult(t, "Encode", order, err, buf[:n], b)
+}
+
+func testAppend(t *testing.T, order ByteOrder, b []byte, s1 interface{}) {
+	buf, err := Append([]byte("prefix"), order, s1)
+	checkResult(t, "Append", order, err, buf, append([]byte("prefix"), b...))
+}
+
+func TestLittleEndianDecode(t *testing.T)    { testDecode(t, LittleEndian, little, s) }
+func TestLittleEndianEncode(t *testing.T)    { testEncode(t, LittleEndian, little, s) }
+func TestLittleEndianPtrAppend(t *testing.T) { testAppend(t, LittleEndian, little, &s) }
+
+func TestBigEndianDecode(t *testing.T)    { testDecode(t, BigEndian, big, s) }
+func TestBigEndianEncode(t *testing.T)    { testEncode(t, BigEndian, big, s) }
+func TestBigEndianPtrAppend(t *testing.T) { testAppend(t, BigEndian, big, &s) }
+
+func TestEncodeSlice(t *testing.T) {
+	buf := make([]byte, len(src))
+	n, err := Encode(buf, BigEndian, res)
+	checkResult(t, "EncodeSlice", BigEndian, err, buf[:n], src)
+
+	b, err := Append(nil, BigEndian, res)
+	checkResult(t, "AppendSlice", BigEndian, err, b, src)
+
+	slice := make([]int32, 2)
+	n, err = Decode(src, BigEndian, slice)
+	checkResult(t, "DecodeSlice", BigEndian, err, slice, res)
+	if n != len(src) {
+		t.Errorf("DecodeSlice: consumed %d bytes; want %d", n, len(src))
+	}
+}
+
+func TestEncodeDecodeBufferTooSmall(t *testing.T) {
+	var u uint32
+	var st Struct
+	for _, data := range []interface{}{&u, &st, make([]int16, 5)} {
+		size := Size(data)
+		if _, err := Encode(make([]byte, size-1), LittleEndian, data); err != errBufferTooSmall {
+			t.Errorf("Encode of %T into short buffer: err = %v; want %v", data, err, errBufferTooSmall)
+		}
+		if _, err := Decode(make([]byte, size-1), LittleEndian, data); err != errBufferTooSmall {
+			t.Errorf("Decode of %T from short buffer: err = %v; want %v", data, err, errBufferTooSmall)
+		}
+	}
+	if _, err := Encode(nil, LittleEndian, T{}); err == nil || !strings.Contains(err.Error(), "binary.Encode: invalid type") {
+		t.Errorf("Encode of invalid type: err = %v", err)
+	}
+}
+
+func TestAppendByteOrder(t *testing.T) {
+	for _, order := range []ByteOrder{LittleEndian, BigEndian, NativeEndian} {
+		app := order.(AppendByteOrder)
+		buf := make([]byte, 8)
+		b := []byte("prefix")
+
+		order.PutUint16(buf, 0x0102)
+		if got := app.AppendUint16(b, 0x0102); string(got) != "prefix"+string(buf[:2]) {
+			t.Errorf("%v.AppendUint16 = %x; want %x", order, got, buf[:2])
+		}
+		order.PutUint32(buf, 0x01020304)
+		if got := app.AppendUint32(b, 0x01020304); string(got) != "prefix"+string(buf[:4]) {
+			t.Errorf("%v.AppendUint32 = %x; want %x", order, got, buf[:4])
+		}
+		order.PutUint64(buf, 0x0102030405060708)
+		if got := app.AppendUint64(b, 0x0102030405060708); string(got) != "prefix"+string(buf) {
+			t.Errorf("%v.AppendUint64 = %x; want %x", order, got, buf)
+		}
+	}
+}
+
+func TestNativeEndian(t *testing.T) {
+	const val = 0x1234
+	var b [2]byte
+	*(*uint16)(unsafe.Pointer(&b)) = val
+	if v := NativeEndian.Uint16(b[:]); v != val {
+		t.Errorf("NativeEndian.Uint16 = %#x; want %#x", v, val)
+	}
+	if s := NativeEndian.String(); s != "NativeEndian" {
+		t.Errorf("NativeEndian.String() = %q", s)
+	}
+}
+
 func TestReadSlice(t *testing.T) {
 	slice := make([]int32, 2)
 	err := Read(bytes.NewReader(src), BigEndian, slice)
@@ -436,6 +534,32 @@ func BenchmarkReadStruct(b *testing.B) {
 	}
 }
 
+func BenchmarkDecodeStruct(b *testing.B) {
+	buf, err := Append(nil, BigEndian, &s)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(buf)))
+	t := s
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Decode(buf, BigEndian, &t)
+	}
+	b.StopTimer()
+	if b.N > 0 && !reflect.DeepEqual(s, t) {
+		b.Fatalf("struct doesn't match:\ngot  %v;\nwant %v", t, s)
+	}
+}
+
+func BenchmarkAppendStruct(b *testing.B) {
+	buf := make([]byte, 0, Size(&s))
+	b.SetBytes(int64(cap(buf)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Append(buf, BigEndian, &s)
+	}
+}
+
 func BenchmarkReadInts(b *testing.B) {
 	var ls Struct
 	bsr := &byteSliceReader{}
@@ -542,3 +666,11 @@ func BenchmarkLittleEndianPutUint64(b *testing.B) {
 		LittleEndian.PutUint64(putbuf[:], uint64(i))
 	}
 }
+
+func BenchmarkAppendUint64(b *testing.B) {
+	buf := make([]byte, 0, 8)
+	b.SetBytes(8)
+	for i := 0; i < b.N; i++ {
+		buf = BigEndian.AppendUint64(buf[:0], uint64(i))
+	}
+}
@@ -49,6 +49,13 @@
 //
 //	{`Multi-line
 //	field`, `comma is ,`}
+//
+// Readers and Writers may be configured for other dialects of CSV,
+// using a different field delimiter, quote or escape character.
+//
+// Records may also be read into and written from structs, with the
+// first record of the file naming the fields; see Reader.ReadStruct,
+// Writer.WriteStruct, Marshal and Unmarshal.
 package csv
 
 import (
@@ -57,6 +64,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -88,10 +96,84 @@ var (
 	ErrFieldCount    = errors.New("wrong number of fields")
 )
 
-var errInvalidDelim = errors.New("csv: invalid field or comment delimiter")
+var (
+	errInvalidDelim = errors.New("csv: invalid field or comment delimiter")
+	errInvalidQuote = errors.New("csv: invalid quote or escape character")
+)
 
-func validDelim(r rune) bool {
-	return r != 0 && r != '"' && r != '\r' && r != '\n' && utf8.ValidRune(r) && r != utf8.RuneError
+func validRune(r rune) bool {
+	return r != 0 && r != '\r' && r != '\n' && utf8.ValidRune(r) && r != utf8.RuneError
+}
+
+// dialectSettings are the exported fields of a Reader or Writer
+// that a dialect is made from.
+type dialectSettings struct {
+	comma     rune
+	delimiter string
+	quote     rune
+	escape    rune
+	comment   rune
+}
+
+// A dialect holds the validated delimiters used by a Reader or Writer.
+type dialect struct {
+	settings dialectSettings
+
+	delim       []byte // field delimiter
+	delimString string
+	quote       rune
+	quoteBytes  []byte
+	escape      rune   // equal to quote if quotes are escaped by doubling
+	specials    string // characters that start or end a quoted sequence
+	needQuotes  string // characters that must be written in a quoted field
+	comment     rune
+}
+
+func newDialect(s dialectSettings) (*dialect, error) {
+	d := &dialect{settings: s, quote: s.quote, escape: s.escape, comment: s.comment}
+	delim := s.delimiter
+	if delim == "" {
+		if !validRune(s.comma) {
+			return nil, errInvalidDelim
+		}
+		delim = string(s.comma)
+	}
+	if d.quote == 0 {
+		d.quote = '"'
+	}
+	if d.escape == 0 {
+		d.escape = d.quote
+	}
+	if !validRune(d.quote) || !validRune(d.escape) {
+		return nil, errInvalidQuote
+	}
+	for _, r := range delim {
+		if !validRune(r) || r == d.quote || r == d.escape {
+			return nil, errInvalidDelim
+		}
+	}
+	if d.comment != 0 {
+		if !validRune(d.comment) || d.comment == d.quote || strings.HasPrefix(delim, string(d.comment)) {
+			return nil, errInvalidDelim
+		}
+	}
+	d.delim = []byte(delim)
+	d.delimString = delim
+	d.quoteBytes = []byte(string(d.quote))
+	d.specials = string(d.quote)
+	if d.escape != d.quote {
+		d.specials += string(d.escape)
+	}
+	d.needQuotes = d.specials + "\r\n"
+	return d, nil
+}
+
+// cachedDialect returns the dialect for s, reusing d if it matches.
+func cachedDialect(d *dialect, s dialectSettings) (*dialect, error) {
+	if d != nil && d.settings == s {
+		return d, nil
+	}
+	return newDialect(s)
 }
 
 // A Reader reads records from a CSV-encoded file.
@@ -107,16 +189,36 @@ type Reader struct {
 	// Comma is the field delimiter.
 	// It is set to comma (',') by NewReader.
 	// Comma must be a valid rune and must not be \r, \n,
-	// or the Unicode replacement character (0xFFFD).
+	// the quote or escape character, or the Unicode
+	// replacement character (0xFFFD).
 	Comma rune
 
+	// Delimiter, if not empty, is the field delimiter, used instead of
+	// Comma. It may be more than one character long, and is subject
+	// to the same restrictions as Comma.
+	Delimiter string
+
+	// Quote, if not 0, is the quote character, used instead of '"'.
+	// Quote must be a valid rune and must not be \r, \n,
+	// or the Unicode replacement character (0xFFFD).
+	Quote rune
+
+	// Escape, if not 0, is the escape character within quoted fields.
+	// An Escape character followed by any other character, including
+	// a newline, yields that character. If Escape is 0 or equal to the
+	// quote character, a quote character within a quoted field is
+	// escaped by doubling it, as described in RFC 4180.
+	// Escape is subject to the same restrictions as Quote.
+	Escape rune
+
 	// Comment, if not 0, is the comment character. Lines beginning with the
 	// Comment character without preceding whitespace are ignored.
 	// With leading whitespace the Comment character becomes part of the
 	// field, even if TrimLeadingSpace is true.
 	// Comment must be a valid rune and must not be \r, \n,
-	// or the Unicode replacement character (0xFFFD).
-	// It must also not be equal to Comma.
+	// the quote character, or the Unicode replacement character (0xFFFD).
+	// It must also not be equal to Comma or to the first character
+	// of Delimiter.
 	Comment rune
 
 	// FieldsPerRecord is the number of expected fields per record.
@@ -135,6 +237,10 @@ type Reader struct {
 	// This is done even if the field delimiter, Comma, is white space.
 	TrimLeadingSpace bool
 
+	// If PreserveCRLF is true, \r\n sequences within quoted fields are
+	// returned as is, rather than converted to \n.
+	PreserveCRLF bool
+
 	// ReuseRecord controls whether calls to Read may return a slice sharing
 	// the backing array of the previous call's returned slice for performance.
 	// By default, each call to Read returns newly allocated memory owned by the caller.
@@ -144,9 +250,15 @@ type Reader struct {
 
 	r *bufio.Reader
 
+	// d is the dialect used by the last call to readRecord.
+	d *dialect
+
 	// numLine is the current line being read in the CSV file.
 	numLine int
 
+	// crlf reports whether readLine converted a trailing \r\n to \n.
+	crlf bool
+
 	// rawBuffer is a line buffer only used by the readLine method.
 	rawBuffer []byte
 
@@ -160,8 +272,20 @@ type Reader struct {
 	// The i'th field ends at offset fieldIndexes[i] in recordBuffer.
 	fieldIndexes []int
 
+	// fieldPositions is an index of field positions for the
+	// last record returned by Read.
+	fieldPositions []position
+
 	// lastRecord is a record cache and only used when ReuseRecord == true.
 	lastRecord []string
+
+	// structState maps the header to struct fields for ReadStruct.
+	structState *readStructState
+}
+
+// position holds the position of a field in the input.
+type position struct {
+	line, col int
 }
 
 // NewReader returns a new Reader that reads from r.
@@ -190,6 +314,20 @@ func (r *Reader) Read() (record []string, err error) {
 	return record, err
 }
 
+// FieldPos returns the line and column corresponding to the start of
+// the field with the given index in the slice most recently returned
+// by Read. As for ParseError, lines are 1-indexed and columns are
+// 0-indexed rune offsets.
+//
+// If this is called with an out-of-bounds index, it panics.
+func (r *Reader) FieldPos(field int) (line, column int) {
+	if field < 0 || field >= len(r.fieldPositions) {
+		panic("out of range index passed to FieldPos")
+	}
+	p := &r.fieldPositions[field]
+	return p.line, p.col
+}
+
 // ReadAll reads all the remaining records from r.
 // Each record is a slice of fields.
 // A successful call returns err == nil, not err == io.EOF. Because ReadAll is
@@ -231,9 +369,11 @@ func (r *Reader) readLine() ([]byte, error) {
 	}
 	r.numLine++
 	// Normalize \r\n to \n on all input lines.
+	r.crlf = false
 	if n := len(line); n >= 2 && line[n-2] == '\r' && line[n-1] == '\n' {
 		line[n-2] = '\n'
 		line = line[:n-1]
+		r.crlf = true
 	}
 	return line, err
 }
@@ -253,16 +393,18 @@ func nextRune(b []byte) rune {
 }
 
 func (r *Reader) readRecord(dst []string) ([]string, error) {
-	if r.Comma == r.Comment || !validDelim(r.Comma) || (r.Comment != 0 && !validDelim(r.Comment)) {
-		return nil, errInvalidDelim
+	d, err := cachedDialect(r.d, dialectSettings{r.Comma, r.Delimiter, r.Quote, r.Escape, r.Comment})
+	if err != nil {
+		return nil, err
 	}
+	r.d = d
 
 	// Read line (automatically skipping past empty lines and any comments).
 	var line, fullLine []byte
 	var errRead error
 	for errRead == nil {
 		line, errRead = r.readLine()
-		if r.Comment != 0 && nextRune(line) == r.Comment {
+		if d.comment != 0 && nextRune(line) == d.comment {
 			line = nil
 			continue // Skip comment lines
 		}
@@ -278,20 +420,28 @@ func (r *Reader) readRecord(dst []string) ([]string, error) {
 	}
 
 	// Parse each field in the record.
-	var err error
-	const quoteLen = len(`"`)
-	commaLen := utf8.RuneLen(r.Comma)
+	quoteLen := len(d.quoteBytes)
+	delimLen := len(d.delim)
 	recLine := r.numLine // Starting line for record
 	r.recordBuffer = r.recordBuffer[:0]
 	r.fieldIndexes = r.fieldIndexes[:0]
+	r.fieldPositions = r.fieldPositions[:0]
+	// pos is the position of the first posOff bytes of fullLine.
+	pos := position{line: r.numLine}
+	posOff := 0
 parseField:
 	for {
 		if r.TrimLeadingSpace {
 			line = bytes.TrimLeftFunc(line, unicode.IsSpace)
 		}
-		if len(line) == 0 || line[0] != '"' {
+		off := len(fullLine) - len(line)
+		pos.col += utf8.RuneCount(fullLine[posOff:off])
+		posOff = off
+		r.fieldPositions = append(r.fieldPositions, pos)
+
+		if !bytes.HasPrefix(line, d.quoteBytes) {
 			// Non-quoted string field
-			i := bytes.IndexRune(line, r.Comma)
+			i := bytes.Index(line, d.delim)
 			field := line
 			if i >= 0 {
 				field = field[:i]
@@ -300,7 +450,7 @@ parseField:
 			}
 			// Check to make sure a quote does not appear in field.
 			if !r.LazyQuotes {
-				if j := bytes.IndexByte(field, '"'); j >= 0 {
+				if j := bytes.IndexRune(field, d.quote); j >= 0 {
 					col := utf8.RuneCount(fullLine[:len(fullLine)-len(line[j:])])
 					err = &ParseError{StartLine: recLine, Line: r.numLine, Column: col, Err: ErrBareQuote}
 					break parseField
@@ -309,7 +459,7 @@ parseField:
 			r.recordBuffer = append(r.recordBuffer, field...)
 			r.fieldIndexes = append(r.fieldIndexes, len(r.recordBuffer))
 			if i >= 0 {
-				line = line[i+commaLen:]
+				line = line[i+delimLen:]
 				continue parseField
 			}
 			break parseField
@@ -317,19 +467,46 @@ parseField:
 			// Quoted string field
 			line = line[quoteLen:]
 			for {
-				i := bytes.IndexByte(line, '"')
-				if i >= 0 {
+				var i int
+				if d.escape == d.quote {
+					i = bytes.IndexRune(line, d.quote)
+				} else {
+					i = bytes.IndexAny(line, d.specials)
+				}
+				if i >= 0 && d.escape != d.quote && nextRune(line[i:]) == d.escape {
+					// Escaped character.
+					r.recordBuffer = append(r.recordBuffer, line[:i]...)
+					line = line[i+utf8.RuneLen(d.escape):]
+					if len(line) == 0 {
+						continue // abrupt end of file
+					}
+					_, n := utf8.DecodeRune(line)
+					if line[0] == '\n' && r.PreserveCRLF && r.crlf {
+						r.recordBuffer = append(r.recordBuffer, '\r')
+					}
+					r.recordBuffer = append(r.recordBuffer, line[:n]...)
+					line = line[n:]
+					if len(line) == 0 && errRead == nil {
+						// The newline was escaped.
+						line, errRead = r.readLine()
+						if errRead == io.EOF {
+							errRead = nil
+						}
+						fullLine = line
+						pos, posOff = position{line: r.numLine}, 0
+					}
+				} else if i >= 0 {
 					// Hit next quote.
 					r.recordBuffer = append(r.recordBuffer, line[:i]...)
 					line = line[i+quoteLen:]
 					switch rn := nextRune(line); {
-					case rn == '"':
+					case rn == d.quote && d.escape == d.quote:
 						// `""` sequence (append quote).
-						r.recordBuffer = append(r.recordBuffer, '"')
+						r.recordBuffer = append(r.recordBuffer, d.quoteBytes...)
 						line = line[quoteLen:]
-					case rn == r.Comma:
+					case bytes.HasPrefix(line, d.delim):
 						// `",` sequence (end of field).
-						line = line[commaLen:]
+						line = line[delimLen:]
 						r.fieldIndexes = append(r.fieldIndexes, len(r.recordBuffer))
 						continue parseField
 					case lengthNL(line) == len(line):
@@ -338,7 +515,7 @@ parseField:
 						break parseField
 					case r.LazyQuotes:
 						// `"` sequence (bare quote).
-						r.recordBuffer = append(r.recordBuffer, '"')
+						r.recordBuffer = append(r.recordBuffer, d.quoteBytes...)
 					default:
 						// `"*` sequence (invalid non-escaped quote).
 						col := utf8.RuneCount(fullLine[:len(fullLine)-len(line)-quoteLen])
@@ -348,6 +525,10 @@ parseField:
 				} else if len(line) > 0 {
 					// Hit end of line (copy all data so far).
 					r.recordBuffer = append(r.recordBuffer, line...)
+					if r.PreserveCRLF && r.crlf {
+						n := len(r.recordBuffer)
+						r.recordBuffer = append(r.recordBuffer[:n-1], '\r', '\n')
+					}
 					if errRead != nil {
 						break parseField
 					}
@@ -356,6 +537,7 @@ parseField:
 						errRead = nil
 					}
 					fullLine = line
+					pos, posOff = position{line: r.numLine}, 0
 				} else {
 					// Abrupt end of file (EOF or error).
 					if !r.LazyQuotes && errRead == nil {
@@ -21,11 +21,15 @@ func TestRead(t *testing.T) {
 
 		// These fields are copied into the Reader
 		Comma              rune
+		Delimiter          string
+		Quote              rune
+		Escape             rune
 		Comment            rune
 		UseFieldsPerRecord bool // false (default) means FieldsPerRecord is -1
 		FieldsPerRecord    int
 		LazyQuotes         bool
 		TrimLeadingSpace   bool
+		PreserveCRLF       bool
 		ReuseRecord        bool
 	}{{
 		Name:   "Simple",
@@ -382,6 +386,83 @@ x,,,
 		Comma:   'X',
 		Comment: 'X',
 		Error:   errInvalidDelim,
+	}, {
+		Name:      "MultiCharDelimiter",
+		Input:     "a||b||c\n\"d||e\"||f\n",
+		Output:    [][]string{{"a", "b", "c"}, {"d||e", "f"}},
+		Delimiter: "||",
+	}, {
+		Name:      "MultiCharDelimiterPartial",
+		Input:     "a|b||c|\n",
+		Output:    [][]string{{"a|b", "c|"}},
+		Delimiter: "||",
+	}, {
+		Name:      "MultiCharDelimiterTrailing",
+		Input:     "a::\n",
+		Output:    [][]string{{"a", ""}},
+		Delimiter: "::",
+	}, {
+		Name:   "BackslashEscape",
+		Input:  `"a\"b","c\\d",e` + "\n",
+		Output: [][]string{{`a"b`, `c\d`, "e"}},
+		Escape: '\\',
+	}, {
+		Name:   "BackslashEscapeNewline",
+		Input:  "\"a\\\nb\"\n",
+		Output: [][]string{{"a\nb"}},
+		Escape: '\\',
+	}, {
+		Name:   "BackslashEscapeDoubledQuote",
+		Input:  `"a""b"` + "\n",
+		Error:  &ParseError{StartLine: 1, Line: 1, Column: 2, Err: ErrQuote},
+		Escape: '\\',
+	}, {
+		Name:   "CustomQuote",
+		Input:  "'a,b','c''d',\"e\"\n",
+		Output: [][]string{{"a,b", "c'd", `"e"`}},
+		Quote:  '\'',
+	}, {
+		Name:   "CustomQuoteAndEscape",
+		Input:  "|a|,|b\\|c|\n",
+		Output: [][]string{{"a", "b|c"}},
+		Quote:  '|',
+		Escape: '\\',
+	}, {
+		Name:         "PreserveCRLF",
+		Input:        "\"a\r\nb\",c\r\n\"d\ne\"\r\n",
+		Output:       [][]string{{"a\r\nb", "c"}, {"d\ne"}},
+		PreserveCRLF: true,
+	}, {
+		Name:   "NoPreserveCRLF",
+		Input:  "\"a\r\nb\",c\r\n",
+		Output: [][]string{{"a\nb", "c"}},
+	}, {
+		Name:  "BadQuote",
+		Quote: '\n',
+		Error: errInvalidQuote,
+	}, {
+		Name:   "BadEscape",
+		Escape: utf8.RuneError,
+		Error:  errInvalidQuote,
+	}, {
+		Name:      "BadDelimiterQuote",
+		Delimiter: `,"`,
+		Error:     errInvalidDelim,
+	}, {
+		Name:   "BadCommaEscape",
+		Comma:  '\\',
+		Escape: '\\',
+		Error:  errInvalidDelim,
+	}, {
+		Name:    "BadQuoteComment",
+		Quote:   '#',
+		Comment: '#',
+		Error:   errInvalidDelim,
+	}, {
+		Name:      "BadDelimiterComment",
+		Delimiter: "#;",
+		Comment:   '#',
+		Error:     errInvalidDelim,
 	}}
 
 	for _, tt := range tests {
@@ -391,6 +472,9 @@ x,,,
 			if tt.Comma != 0 {
 				r.Comma = tt.Comma
 			}
+			r.Delimiter = tt.Delimiter
+			r.Quote = tt.Quote
+			r.Escape = tt.Escape
 			r.Comment = tt.Comment
 			if tt.UseFieldsPerRecord {
 				r.FieldsPerRecord = tt.FieldsPerRecord
@@ -399,6 +483,7 @@ x,,,
 			}
 			r.LazyQuotes = tt.LazyQuotes
 			r.TrimLeadingSpace = tt.TrimLeadingSpace
+			r.PreserveCRLF = tt.PreserveCRLF
 			r.ReuseRecord = tt.ReuseRecord
 
 			out, err := r.ReadAll()
@@ -411,6 +496,37 @@ x,,,
 	}
 }
 
+func TestFieldPos(t *testing.T) {
+	r := NewReader(strings.NewReader("a,bb,\"c\nc\",d\n\n# comment\n\"\",λ,x\n"))
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	want := [][][2]int{
+		{{1, 0}, {1, 2}, {1, 5}, {2, 3}},
+		{{5, 0}, {5, 3}, {5, 5}},
+	}
+	for i, pos := range want {
+		rec, err := r.Read()
+		if err != nil {
+			t.Fatalf("Read #%d: %v", i, err)
+		}
+		if len(rec) != len(pos) {
+			t.Fatalf("Read #%d = %q; want %d fields", i, rec, len(pos))
+		}
+		for j, p := range pos {
+			if line, col := r.FieldPos(j); line != p[0] || col != p[1] {
+				t.Errorf("record %d: FieldPos(%d) = %d, %d; want %d, %d", i, j, line, col, p[0], p[1])
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("FieldPos with out of range index did not panic")
+		}
+	}()
+	r.FieldPos(3)
+}
+
 // nTimes is an io.Reader which yields the string s n times.
 type nTimes struct {
 	s   string
@@ -0,0 +1,508 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csv
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Marshal returns the CSV encoding of v, which must be a slice or array
+// of structs or of pointers to structs. The first record is a header
+// naming the fields, as described for Writer.WriteStruct, followed by
+// one record per element of v.
+func Marshal(v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("csv: Marshal of non-slice type %T", v)
+	}
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	t := rv.Type().Elem()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if _, err := w.structFields(t); err != nil {
+		return nil, err
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := w.WriteStruct(rv.Index(i).Interface()); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses the CSV-encoded data, whose first record is a
+// header, and appends one element per following record to the slice
+// pointed to by v. The slice elements must be structs or pointers to
+// structs; each record is decoded as described for Reader.ReadStruct.
+func Unmarshal(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("csv: Unmarshal destination must be a non-nil pointer to a slice, not %T", v)
+	}
+	slice := rv.Elem()
+	et := slice.Type().Elem()
+	ptr := et.Kind() == reflect.Ptr
+	if ptr {
+		et = et.Elem()
+	}
+	r := NewReader(bytes.NewReader(data))
+	for {
+		elem := reflect.New(et)
+		err := r.ReadStruct(elem.Interface())
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !ptr {
+			elem = elem.Elem()
+		}
+		slice.Set(reflect.Append(slice, elem))
+	}
+}
+
+// readStructState maps the columns of a header to the fields of a struct.
+type readStructState struct {
+	header []string
+	typ    reflect.Type
+	fields []*structField // per column; nil if the column is ignored
+}
+
+// ReadStruct reads the next record from r into the struct pointed to by v.
+//
+// The first call to ReadStruct reads a header record naming the columns
+// of the following records. Each column is stored in the field whose
+// name, or whose "csv" key in the field's tag, is equal to the column
+// name, or failing that, equal to it under Unicode case-folding. Fields
+// with the tag `csv:"-"` and unexported fields are ignored, as are
+// columns matching no field. The fields of embedded structs are treated
+// as fields of the outer struct, following the Go rules for promoted
+// fields; embedded pointers to structs are allocated as needed.
+//
+// Fields may be strings, booleans, integers, floating-point numbers,
+// types implementing encoding.TextUnmarshaler, or pointers to these.
+// An empty column leaves a pointer field nil. Errors converting a column
+// are reported as a *ParseError giving the position of the column.
+//
+// ReadStruct returns io.EOF when there are no more records.
+func (r *Reader) ReadStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("csv: ReadStruct destination must be a non-nil pointer to a struct, not %T", v)
+	}
+	sv := rv.Elem()
+
+	st := r.structState
+	if st == nil {
+		header, err := r.readRecord(nil)
+		if err != nil {
+			return err
+		}
+		st = &readStructState{header: header}
+		r.structState = st
+	}
+	if st.typ != sv.Type() {
+		fields, err := cachedStructFields(sv.Type())
+		if err != nil {
+			return err
+		}
+		st.fields, err = mapHeader(st.header, fields)
+		if err != nil {
+			return err
+		}
+		st.typ = sv.Type()
+	}
+
+	record, err := r.readRecord(r.lastRecord[:0])
+	r.lastRecord = record
+	if err != nil {
+		return err
+	}
+	for i, s := range record {
+		if i >= len(st.fields) || st.fields[i] == nil {
+			continue
+		}
+		f := st.fields[i]
+		fv, _ := fieldByIndex(sv, f.index, true)
+		if err := f.decode(fv, s); err != nil {
+			line, col := r.FieldPos(i)
+			return &ParseError{StartLine: line, Line: line, Column: col,
+				Err: fmt.Errorf("cannot decode %q into field %s of type %v: %v", s, f.goName, f.typ, err)}
+		}
+	}
+	return nil
+}
+
+// mapHeader returns the field for each column of header.
+func mapHeader(header []string, fields []*structField) ([]*structField, error) {
+	m := make([]*structField, len(header))
+	used := make(map[*structField]string)
+	for i, col := range header {
+		var f *structField
+		for _, cand := range fields {
+			if cand.name == col {
+				f = cand
+				break
+			}
+			if f == nil && strings.EqualFold(cand.name, col) {
+				f = cand
+			}
+		}
+		if f == nil {
+			continue
+		}
+		if prev, ok := used[f]; ok {
+			return nil, fmt.Errorf("csv: columns %q and %q both map to field %s", prev, col, f.goName)
+		}
+		used[f] = col
+		m[i] = f
+	}
+	return m, nil
+}
+
+// writeStructState holds the fields written by WriteStruct.
+type writeStructState struct {
+	typ    reflect.Type
+	fields []*structField
+	record []string
+}
+
+// WriteStruct writes the fields of v, which must be a struct or a
+// pointer to a struct, as a single record.
+//
+// The first call to WriteStruct first writes a header record naming the
+// fields of v, using the "csv" key in the field's tag if present.
+// The fields are chosen as described for Reader.ReadStruct, and are
+// written in the order in which they are declared. Later calls must pass
+// structs of the same type. A nil pointer, including an embedded one,
+// is written as an empty field.
+func (w *Writer) WriteStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("csv: WriteStruct of non-struct type %T", v)
+	}
+	st := w.structState
+	if st == nil {
+		if _, err := w.structFields(rv.Type()); err != nil {
+			return err
+		}
+		st = w.structState
+	}
+	if rv.Type() != st.typ {
+		return fmt.Errorf("csv: WriteStruct of %v after header for %v", rv.Type(), st.typ)
+	}
+
+	for i, f := range st.fields {
+		fv, ok := fieldByIndex(rv, f.index, false)
+		if !ok {
+			st.record[i] = ""
+			continue
+		}
+		s, err := f.encode(fv)
+		if err != nil {
+			return fmt.Errorf("csv: cannot encode field %s of type %v: %v", f.goName, f.typ, err)
+		}
+		st.record[i] = s
+	}
+	return w.Write(st.record)
+}
+
+// structFields writes the header for struct type t and
+// prepares w to write structs of that type.
+func (w *Writer) structFields(t reflect.Type) ([]*structField, error) {
+	if w.structState != nil {
+		return w.structState.fields, nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csv: cannot write non-struct type %v", t)
+	}
+	fields, err := cachedStructFields(t)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	w.structState = &writeStructState{typ: t, fields: fields, record: make([]string, len(fields))}
+	return fields, nil
+}
+
+// fieldByIndex returns the nested field of v with the given index.
+// If an embedded struct pointer along the way is nil, fieldByIndex
+// allocates the struct when alloc is set, as when reading a record
+// into v, and otherwise reports false.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// A structField is a struct field that a column is read into
+// or written from.
+type structField struct {
+	name   string // column name
+	goName string // Go field name, for errors
+	index  []int
+	typ    reflect.Type
+	tagged bool
+}
+
+var fieldCache sync.Map // map[reflect.Type][]*structField
+
+// cachedStructFields is like typeFields but uses a cache
+// to avoid repeated work.
+func cachedStructFields(t reflect.Type) ([]*structField, error) {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]*structField), nil
+	}
+	fields, err := typeFields(t)
+	if err != nil {
+		return nil, err
+	}
+	f, _ := fieldCache.LoadOrStore(t, fields)
+	return f.([]*structField), nil
+}
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// supportedType reports whether fields of type t can be
+// read and written.
+func supportedType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// typeFields returns the fields of struct type t that records
+// are read into and written from, in declaration order.
+func typeFields(t reflect.Type) ([]*structField, error) {
+	var all []*structField
+	visiting := make(map[reflect.Type]bool)
+	var walk func(t reflect.Type, index []int) error
+	walk = func(t reflect.Type, index []int) error {
+		if visiting[t] {
+			return nil
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("csv")
+			if tag == "-" {
+				continue
+			}
+			idx := make([]int, len(index)+1)
+			copy(idx, index)
+			idx[len(index)] = i
+
+			if sf.Anonymous && tag == "" {
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct && !reflect.PtrTo(ft).Implements(textUnmarshalerType) {
+					// Embedded pointers to unexported types
+					// cannot be allocated.
+					if sf.PkgPath != "" && sf.Type.Kind() == reflect.Ptr {
+						continue
+					}
+					if err := walk(ft, idx); err != nil {
+						return err
+					}
+					continue
+				}
+			}
+			if sf.PkgPath != "" {
+				continue // unexported
+			}
+			if !supportedType(sf.Type) {
+				return fmt.Errorf("csv: unsupported type %v for field %s", sf.Type, sf.Name)
+			}
+			name := tag
+			if name == "" {
+				name = sf.Name
+			}
+			all = append(all, &structField{name: name, goName: sf.Name, index: idx, typ: sf.Type, tagged: tag != ""})
+		}
+		return nil
+	}
+	if err := walk(t, nil); err != nil {
+		return nil, err
+	}
+
+	// Keep the shallowest field of each name, preferring a single
+	// tagged one, and drop names that remain ambiguous.
+	byName := make(map[string][]*structField)
+	for _, f := range all {
+		byName[f.name] = append(byName[f.name], f)
+	}
+	var fields []*structField
+	for _, f := range all {
+		cands := byName[f.name]
+		if dominantField(cands) == f {
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
+}
+
+// dominantField returns the field that wins among fields of the same
+// name, or nil if none does.
+func dominantField(fields []*structField) *structField {
+	depth := len(fields[0].index)
+	for _, f := range fields[1:] {
+		if len(f.index) < depth {
+			depth = len(f.index)
+		}
+	}
+	var best, tagged []*structField
+	for _, f := range fields {
+		if len(f.index) == depth {
+			best = append(best, f)
+			if f.tagged {
+				tagged = append(tagged, f)
+			}
+		}
+	}
+	if len(best) > 1 && len(tagged) == 1 {
+		best = tagged
+	}
+	if len(best) > 1 {
+		return nil
+	}
+	return best[0]
+}
+
+// decode parses s into v, which has type f.typ.
+func (f *structField) decode(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Ptr {
+		if s == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	}
+	if s == "" {
+		// An empty column leaves a number or boolean at its zero value.
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported type %v", v.Type())
+	}
+	return nil
+}
+
+// encode formats v, which has type f.typ.
+func (f *structField) encode(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	if v.CanAddr() && reflect.PtrTo(v.Type()).Implements(textMarshalerType) {
+		b, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("unsupported type %v", v.Type())
+}
@@ -0,0 +1,195 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csv
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type Base struct {
+	ID   int    `csv:"id"`
+	Note string `csv:"-"`
+}
+
+type Extra struct {
+	Score float64
+}
+
+type record struct {
+	Base
+	*Extra
+	Name    string `csv:"name"`
+	Active  bool
+	Age     *uint8
+	Born    time.Time
+	private string
+}
+
+func TestReadStruct(t *testing.T) {
+	const input = "ID,NAME,active,unknown,Score,Age,Born\n" +
+		"1,Alice,true,x,1.5,30,2019-01-02T00:00:00Z\n" +
+		"2,Bob,false,,,,0001-01-01T00:00:00Z\n"
+	r := NewReader(strings.NewReader(input))
+
+	age := uint8(30)
+	want := []record{{
+		Base:   Base{ID: 1},
+		Extra:  &Extra{Score: 1.5},
+		Name:   "Alice",
+		Active: true,
+		Age:    &age,
+		Born:   time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, {
+		Base:  Base{ID: 2},
+		Extra: &Extra{},
+		Name:  "Bob",
+	}}
+	for i, w := range want {
+		var got record
+		if err := r.ReadStruct(&got); err != nil {
+			t.Fatalf("ReadStruct #%d: %v", i, err)
+		}
+		if !reflect.DeepEqual(got, w) {
+			t.Errorf("ReadStruct #%d:\ngot  %+v\nwant %+v", i, got, w)
+		}
+	}
+	if err := r.ReadStruct(new(record)); err != io.EOF {
+		t.Errorf("ReadStruct at end = %v; want io.EOF", err)
+	}
+}
+
+func TestReadStructErrors(t *testing.T) {
+	type small struct {
+		A int
+		B string
+	}
+	tests := []struct {
+		Name  string
+		Input string
+		Dest  interface{}
+		Error string
+	}{{
+		Name:  "NotPointer",
+		Input: "A\n1\n",
+		Dest:  small{},
+		Error: "csv: ReadStruct destination must be a non-nil pointer to a struct, not csv.small",
+	}, {
+		Name:  "BadInt",
+		Input: "B,A\nx,\"1\nx\"\n",
+		Dest:  new(small),
+		Error: `parse error on line 2, column 2: cannot decode "1\nx" into field A of type int: strconv.ParseInt: parsing "1\nx": invalid syntax`,
+	}, {
+		Name:  "DuplicateColumn",
+		Input: "A,a\n1,2\n",
+		Dest:  new(small),
+		Error: `csv: columns "A" and "a" both map to field A`,
+	}, {
+		Name:  "UnsupportedType",
+		Input: "A\n1\n",
+		Dest:  new(struct{ A []int }),
+		Error: "csv: unsupported type []int for field A",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(tt.Input))
+			err := r.ReadStruct(tt.Dest)
+			if err == nil || err.Error() != tt.Error {
+				t.Errorf("ReadStruct error:\ngot  %v\nwant %s", err, tt.Error)
+			}
+		})
+	}
+}
+
+func TestWriteStruct(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b)
+	age := uint8(7)
+	recs := []interface{}{
+		record{Base: Base{ID: 1, Note: "skipped"}, Extra: &Extra{Score: 0.25}, Name: "a,b", Active: true, Age: &age},
+		&record{Base: Base{ID: 2}, Name: "c"},
+	}
+	for _, rec := range recs {
+		if err := w.WriteStruct(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.WriteStruct(struct{ X int }{}); err == nil {
+		t.Error("WriteStruct of a different type succeeded")
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		t.Fatal(err)
+	}
+	const want = "id,Score,name,Active,Age,Born\n" +
+		"1,0.25,\"a,b\",true,7,0001-01-01T00:00:00Z\n" +
+		"2,,c,false,,0001-01-01T00:00:00Z\n"
+	if got := b.String(); got != want {
+		t.Errorf("WriteStruct output:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	type row struct {
+		S   string
+		I   int64 `csv:"int"`
+		F   float32
+		P   *string
+		Tag struct{ X int } `csv:"-"`
+	}
+	s := "x"
+	in := []*row{{S: "a\"b", I: -3, F: 1.25, P: &s}, {S: "line\nbreak"}}
+	data, err := Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []*row
+	if err := Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("Unmarshal(Marshal(%v)) = %v", in, out)
+	}
+
+	data, err = Marshal([]row(nil))
+	if err != nil || string(data) != "S,int,F,P\n" {
+		t.Errorf("Marshal of empty slice = %q, %v; want header only", data, err)
+	}
+	if _, err := Marshal(row{}); err == nil {
+		t.Error("Marshal of non-slice succeeded")
+	}
+	if err := Unmarshal(data, out); err == nil {
+		t.Error("Unmarshal into non-pointer succeeded")
+	}
+}
+
+func TestStructFieldConflicts(t *testing.T) {
+	type A struct{ X, Y int }
+	type B struct {
+		X int
+		Y int `csv:"Y"`
+	}
+	type C struct {
+		A
+		B
+		Z int
+	}
+	fields, err := typeFields(reflect.TypeOf(C{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range fields {
+		names = append(names, f.name)
+	}
+	// X is ambiguous; the tagged Y in B wins.
+	if want := []string{"Y", "Z"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("fields of C = %q; want %q", names, want)
+	}
+}
@@ -18,7 +18,11 @@ import (
 // newline and uses ',' as the field delimiter. The exported fields can be
 // changed to customize the details before the first call to Write or WriteAll.
 //
-// Comma is the field delimiter.
+// Comma is the field delimiter. Delimiter, Quote and Escape, if set,
+// select another dialect, as described for the fields of Reader.
+// When Escape is set and differs from the quote character, quote and
+// escape characters within quoted fields are preceded by Escape rather
+// than doubled.
 //
 // If UseCRLF is true, the Writer ends each output line with \r\n instead of \n.
 //
@@ -28,9 +32,18 @@ import (
 // the underlying io.Writer.  Any errors that occurred should
 // be checked by calling the Error method.
 type Writer struct {
-	Comma   rune // Field delimiter (set to ',' by NewWriter)
-	UseCRLF bool // True to use \r\n as the line terminator
-	w       *bufio.Writer
+	Comma     rune   // Field delimiter (set to ',' by NewWriter)
+	Delimiter string // Field delimiter used instead of Comma, if not empty
+	Quote     rune   // Quote character, if not '"'
+	Escape    rune   // Escape character within quoted fields, if not the quote character
+	UseCRLF   bool   // True to use \r\n as the line terminator
+	w         *bufio.Writer
+
+	// d is the dialect used by the last call to Write.
+	d *dialect
+
+	// structState holds the fields written by WriteStruct.
+	structState *writeStructState
 }
 
 // NewWriter returns a new Writer that writes to w.
@@ -46,32 +59,34 @@ func NewWriter(w io.Writer) *Writer {
 // Writes are buffered, so Flush must eventually be called to ensure
 // that the record is written to the underlying io.Writer.
 func (w *Writer) Write(record []string) error {
-	if !validDelim(w.Comma) {
-		return errInvalidDelim
+	d, err := cachedDialect(w.d, dialectSettings{comma: w.Comma, delimiter: w.Delimiter, quote: w.Quote, escape: w.Escape})
+	if err != nil {
+		return err
 	}
+	w.d = d
 
 	for n, field := range record {
 		if n > 0 {
-			if _, err := w.w.WriteRune(w.Comma); err != nil {
+			if _, err := w.w.Write(d.delim); err != nil {
 				return err
 			}
 		}
 
 		// If we don't have to have a quoted field then just
 		// write out the field and continue to the next field.
-		if !w.fieldNeedsQuotes(field) {
+		if !fieldNeedsQuotes(d, field) {
 			if _, err := w.w.WriteString(field); err != nil {
 				return err
 			}
 			continue
 		}
 
-		if err := w.w.WriteByte('"'); err != nil {
+		if _, err := w.w.Write(d.quoteBytes); err != nil {
 			return err
 		}
 		for len(field) > 0 {
 			// Search for special characters.
-			i := strings.IndexAny(field, "\"\r\n")
+			i := strings.IndexAny(field, d.needQuotes)
 			if i < 0 {
 				i = len(field)
 			}
@@ -85,14 +100,17 @@ func (w *Writer) Write(record []string) error {
 			// Encode the special character.
 			if len(field) > 0 {
 				var err error
-				switch field[0] {
-				case '"':
-					_, err = w.w.WriteString(`""`)
-				case '\r':
+				switch rn, n := utf8.DecodeRuneInString(field); {
+				case rn == d.quote || rn == d.escape:
+					if _, err = w.w.WriteRune(d.escape); err == nil {
+						_, err = w.w.WriteString(field[:n])
+					}
+					field = field[n-1:]
+				case rn == '\r':
 					if !w.UseCRLF {
 						err = w.w.WriteByte('\r')
 					}
-				case '\n':
+				case rn == '\n':
 					if w.UseCRLF {
 						_, err = w.w.WriteString("\r\n")
 					} else {
@@ -105,11 +123,10 @@ func (w *Writer) Write(record []string) error {
 				}
 			}
 		}
-		if err := w.w.WriteByte('"'); err != nil {
+		if _, err := w.w.Write(d.quoteBytes); err != nil {
 			return err
 		}
 	}
-	var err error
 	if w.UseCRLF {
 		_, err = w.w.WriteString("\r\n")
 	} else {
@@ -154,11 +171,11 @@ func (w *Writer) WriteAll(records [][]string) error {
 // Not quoting the empty string also makes this package match the behavior
 // of Microsoft Excel and Google Drive.
 // For Postgres, quote the data terminating string `\.`.
-func (w *Writer) fieldNeedsQuotes(field string) bool {
+func fieldNeedsQuotes(d *dialect, field string) bool {
 	if field == "" {
 		return false
 	}
-	if field == `\.` || strings.ContainsRune(field, w.Comma) || strings.ContainsAny(field, "\"\r\n") {
+	if field == `\.` || strings.Contains(field, d.delimString) || strings.ContainsAny(field, d.needQuotes) {
 		return true
 	}
 
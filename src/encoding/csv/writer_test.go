@@ -11,11 +11,14 @@ import (
 )
 
 var writeTests = []struct {
-	Input   [][]string
-	Output  string
-	Error   error
-	UseCRLF bool
-	Comma   rune
+	Input     [][]string
+	Output    string
+	Error     error
+	UseCRLF   bool
+	Comma     rune
+	Delimiter string
+	Quote     rune
+	Escape    rune
 }{
 	{Input: [][]string{{"abc"}}, Output: "abc\n"},
 	{Input: [][]string{{"abc"}}, Output: "abc\r\n", UseCRLF: true},
@@ -46,6 +49,12 @@ var writeTests = []struct {
 	{Input: [][]string{{"a", "a", ""}}, Output: "a|a|\n", Comma: '|'},
 	{Input: [][]string{{",", ",", ""}}, Output: ",|,|\n", Comma: '|'},
 	{Input: [][]string{{"foo"}}, Comma: '"', Error: errInvalidDelim},
+	{Input: [][]string{{"a", "b|c", "d||e"}}, Output: "a||b|c||\"d||e\"\n", Delimiter: "||"},
+	{Input: [][]string{{"a'b", `"c"`}}, Output: `'a''b',"c"` + "\n", Quote: '\''},
+	{Input: [][]string{{`a"b`, `c\d`, "e"}}, Output: `"a\"b","c\\d",e` + "\n", Escape: '\\'},
+	{Input: [][]string{{"a|b", "c,d"}}, Output: `|a\|b|,|c,d|` + "\n", Quote: '|', Escape: '\\'},
+	{Input: [][]string{{"foo"}}, Delimiter: "a\nb", Error: errInvalidDelim},
+	{Input: [][]string{{"foo"}}, Quote: '\r', Error: errInvalidQuote},
 }
 
 func TestWrite(t *testing.T) {
@@ -56,6 +65,9 @@ func TestWrite(t *testing.T) {
 		if tt.Comma != 0 {
 			f.Comma = tt.Comma
 		}
+		f.Delimiter = tt.Delimiter
+		f.Quote = tt.Quote
+		f.Escape = tt.Escape
 		err := f.WriteAll(tt.Input)
 		if err != tt.Error {
 			t.Errorf("Unexpected error:\ngot  %v\nwant %v", err, tt.Error)
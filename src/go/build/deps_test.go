@@ -198,6 +198,7 @@ var pkgDeps = map[string][]string{
 
 	"testing":               {"L2", "flag", "fmt", "internal/race", "os", "runtime/debug", "runtime/pprof", "runtime/trace", "time"},
 	"testing/iotest":        {"L2", "log"},
+	"internal/coverage":     {"L2", "OS", "fmt"},
 	"testing/quick":         {"L2", "flag", "fmt", "reflect", "time"},
 	"internal/testenv":      {"L2", "OS", "flag", "testing", "syscall"},
 	"internal/lazyregexp":   {"L2", "OS", "regexp"},
@@ -242,13 +243,14 @@ var pkgDeps = map[string][]string{
 
 	// One of a kind.
 	"archive/tar":                    {"L4", "OS", "syscall", "os/user"},
-	"archive/zip":                    {"L4", "OS", "compress/flate"},
+	"archive/zip":                    {"L4", "OS", "compress/flate", "compress/zstd"},
 	"container/heap":                 {"sort"},
 	"compress/bzip2":                 {"L4"},
 	"compress/flate":                 {"L4"},
 	"compress/gzip":                  {"L4", "compress/flate"},
 	"compress/lzw":                   {"L4"},
 	"compress/zlib":                  {"L4", "compress/flate"},
+	"compress/zstd":                  {"L4"},
 	"context":                        {"errors", "internal/reflectlite", "sync", "time"},
 	"database/sql":                   {"L4", "container/list", "context", "database/sql/driver", "database/sql/internal"},
 	"database/sql/driver":            {"L4", "context", "time", "database/sql/internal"},
@@ -261,7 +263,7 @@ var pkgDeps = map[string][]string{
 	"encoding":                       {"L4"},
 	"encoding/ascii85":               {"L4"},
 	"encoding/asn1":                  {"L4", "math/big"},
-	"encoding/csv":                   {"L4"},
+	"encoding/csv":                   {"L4", "encoding"},
 	"encoding/gob":                   {"L4", "OS", "encoding"},
 	"encoding/hex":                   {"L4"},
 	"encoding/json":                  {"L4", "encoding"},
@@ -344,21 +346,37 @@ var pkgDeps = map[string][]string{
 	"net/textproto": {"L4", "OS", "net"},
 
 	// Core crypto.
-	"crypto/aes":               {"L3"},
-	"crypto/des":               {"L3"},
-	"crypto/hmac":              {"L3"},
-	"crypto/internal/randutil": {"io", "sync"},
-	"crypto/md5":               {"L3"},
-	"crypto/rc4":               {"L3"},
-	"crypto/sha1":              {"L3"},
-	"crypto/sha256":            {"L3"},
-	"crypto/sha512":            {"L3"},
+	"crypto/aes":                  {"L3", "crypto/internal/fips/aes"},
+	"crypto/des":                  {"L3"},
+	"crypto/hmac":                 {"L3", "crypto/internal/fips/hmac"},
+	"crypto/internal/fips":        {"L0", "syscall"},
+	"crypto/internal/fips/aes":    {"L3", "crypto/internal/fips"},
+	"crypto/internal/fips/drbg":   {"L3", "crypto/internal/fips", "crypto/internal/fips/aes"},
+	"crypto/internal/fips/hmac":   {"L3", "crypto/internal/fips", "crypto/internal/fips/sha256"},
+	"crypto/internal/fips/sha256": {"L3", "crypto/internal/fips"},
+	"crypto/internal/fips/sha512": {"L3", "crypto/internal/fips"},
+	"crypto/internal/mlkem768":    {"L3", "crypto/internal/sha3"},
+	"crypto/internal/randutil":    {"io", "sync"},
+	"crypto/internal/sha3":        {"L3"},
+	"crypto/md5":                  {"L3"},
+	"crypto/rc4":                  {"L3"},
+	"crypto/sha1":                 {"L3"},
+	"crypto/sha256":               {"L3", "crypto/internal/fips/sha256"},
+	"crypto/sha512":               {"L3", "crypto/internal/fips/sha512"},
 
 	"CRYPTO": {
 		"crypto/aes",
 		"crypto/des",
 		"crypto/hmac",
+		"crypto/internal/fips",
+		"crypto/internal/fips/aes",
+		"crypto/internal/fips/drbg",
+		"crypto/internal/fips/hmac",
+		"crypto/internal/fips/sha256",
+		"crypto/internal/fips/sha512",
+		"crypto/internal/mlkem768",
 		"crypto/internal/randutil",
+		"crypto/internal/sha3",
 		"crypto/md5",
 		"crypto/rc4",
 		"crypto/sha1",
@@ -376,26 +394,33 @@ var pkgDeps = map[string][]string{
 
 	// Mathematical crypto: dependencies on fmt (L4) and math/big.
 	// We could avoid some of the fmt, but math/big imports fmt anyway.
-	"crypto/dsa":      {"L4", "CRYPTO", "math/big"},
-	"crypto/ecdsa":    {"L4", "CRYPTO", "crypto/elliptic", "math/big", "encoding/asn1"},
-	"crypto/elliptic": {"L4", "CRYPTO", "math/big"},
-	"crypto/rsa":      {"L4", "CRYPTO", "crypto/rand", "math/big"},
+	"crypto/dsa":                 {"L4", "CRYPTO", "math/big"},
+	"crypto/ecdsa":               {"L4", "CRYPTO", "crypto/elliptic", "crypto/internal/fips/ecdsa", "math/big", "encoding/asn1"},
+	"crypto/elliptic":            {"L4", "CRYPTO", "math/big"},
+	"crypto/internal/fips/ecdsa": {"L4", "CRYPTO", "crypto/elliptic", "math/big"},
+	"crypto/internal/fips/rsa":   {"L4", "CRYPTO", "crypto/rand", "math/big"},
+	"crypto/rsa":                 {"L4", "CRYPTO", "crypto/internal/fips/rsa", "math/big"},
 
 	"CRYPTO-MATH": {
 		"CRYPTO",
 		"crypto/dsa",
 		"crypto/ecdsa",
 		"crypto/elliptic",
+		"crypto/internal/fips/ecdsa",
+		"crypto/internal/fips/rsa",
 		"crypto/rand",
 		"crypto/rsa",
 		"encoding/asn1",
 		"math/big",
 	},
 
+	// Hybrid public key encryption.
+	"crypto/hpke": {"L4", "CRYPTO-MATH", "golang.org/x/crypto/hkdf"},
+
 	// SSL/TLS.
 	"crypto/tls": {
 		"L4", "CRYPTO-MATH", "OS", "golang.org/x/crypto/cryptobyte", "golang.org/x/crypto/hkdf",
-		"container/list", "crypto/x509", "encoding/pem", "net", "syscall",
+		"container/list", "crypto/hpke", "crypto/x509", "encoding/pem", "net", "syscall",
 	},
 	"crypto/x509": {
 		"L4", "CRYPTO-MATH", "OS", "CGO",
@@ -412,6 +437,7 @@ var pkgDeps = map[string][]string{
 	"net/http": {
 		"L4", "NET", "OS",
 		"compress/gzip",
+		"compress/zstd",
 		"container/list",
 		"context",
 		"crypto/rand",
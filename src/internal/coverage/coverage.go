@@ -0,0 +1,139 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package coverage implements the run-time support for programs
+// built with "go build -cover".
+//
+// The go command arranges for the main package of such a program
+// to register the coverage counters of each instrumented source file
+// with RegisterFile and then call Initialize. When the program exits,
+// either by returning from main.main or by calling os.Exit, the
+// counters are written to a new counter data file in the directory
+// named by the GOCOVERDIR environment variable.
+//
+// A counter data file has the same format as the profiles written by
+// "go test -coverprofile", and is named
+//
+//	covcounters.<pid>.<time>
+//
+// where <pid> is the process ID and <time> the time at which the file
+// was written, in nanoseconds since the Unix epoch, so that repeated
+// runs of one or more programs can share a single directory.
+// The "go tool covdata" command merges and converts these files.
+package coverage
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// CounterFilePrefix is the prefix of the names of counter data files.
+const CounterFilePrefix = "covcounters."
+
+// A file holds the registered coverage data for one source file.
+type file struct {
+	name     string
+	counters []uint32
+	pos      []uint32
+	numStmts []uint16
+}
+
+var (
+	mode        string
+	files       []file
+	registered  = make(map[string]bool)
+	initialized bool
+)
+
+// RegisterFile registers the coverage counters for the named source file,
+// as generated by "go tool cover". It is called during initialization of
+// the main package, before Initialize.
+func RegisterFile(fileName string, counters []uint32, pos []uint32, numStmts []uint16) {
+	if 3*len(counters) != len(pos) || len(counters) != len(numStmts) {
+		panic("coverage: mismatched sizes")
+	}
+	if registered[fileName] {
+		return
+	}
+	registered[fileName] = true
+	files = append(files, file{fileName, counters, pos, numStmts})
+}
+
+// Initialize records the coverage mode ("set", "count" or "atomic")
+// and arranges for the counters to be written when the program exits.
+func Initialize(coverMode string) {
+	if initialized {
+		return
+	}
+	initialized = true
+	mode = coverMode
+	runtime_addExitHook(emitCounterData, true)
+}
+
+// runtime_addExitHook is provided by package runtime.
+func runtime_addExitHook(f func(), runOnNonZeroExit bool)
+
+// emitCounterData writes the current counter values
+// to a new counter data file in $GOCOVERDIR.
+func emitCounterData() {
+	dir := os.Getenv("GOCOVERDIR")
+	if dir == "" {
+		fmt.Fprintf(os.Stderr, "warning: GOCOVERDIR not set, no coverage data emitted\n")
+		return
+	}
+	if err := writeCounterData(dir); err != nil {
+		fmt.Fprintf(os.Stderr, "error: coverage data writing failed: %v\n", err)
+	}
+}
+
+func writeCounterData(dir string) error {
+	name := filepath.Join(dir, fmt.Sprintf("%s%d.%d", CounterFilePrefix, os.Getpid(), time.Now().UnixNano()))
+
+	// Write to a temporary file and rename it into place,
+	// so that a concurrent reader never sees a partial file.
+	tmp := name + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	writeProfile(w)
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// writeProfile writes the counters to w in the text profile format.
+func writeProfile(w *bufio.Writer) {
+	sorted := make([]file, len(files))
+	copy(sorted, files)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+
+	fmt.Fprintf(w, "mode: %s\n", mode)
+	for _, f := range sorted {
+		for i := range f.counters {
+			var count uint32
+			if mode == "atomic" {
+				count = atomic.LoadUint32(&f.counters[i])
+			} else {
+				count = f.counters[i]
+			}
+			line0, line1 := f.pos[3*i+0], f.pos[3*i+1]
+			col0, col1 := uint16(f.pos[3*i+2]), uint16(f.pos[3*i+2]>>16)
+			fmt.Fprintf(w, "%s:%d.%d,%d.%d %d %d\n", f.name, line0, col0, line1, col1, f.numStmts[i], count)
+		}
+	}
+}
@@ -12,6 +12,7 @@ package http
 import (
 	"bufio"
 	"compress/gzip"
+	"compress/zstd"
 	"container/list"
 	"context"
 	"crypto/tls"
@@ -188,6 +189,13 @@ type Transport struct {
 	// uncompressed.
 	DisableCompression bool
 
+	// AcceptZstd, if true, makes the Transport request compression
+	// with "Accept-Encoding: zstd, gzip" instead, and transparently
+	// decode responses with a Content-Encoding of zstd as well as
+	// gzip. It has no effect if DisableCompression is set.
+	// AcceptZstd currently applies only to HTTP/1 connections.
+	AcceptZstd bool
+
 	// MaxIdleConns controls the maximum number of idle (keep-alive)
 	// connections across all hosts. Zero means no limit.
 	MaxIdleConns int
@@ -1795,6 +1803,12 @@ func (pc *persistConn) readLoop() {
 			resp.Header.Del("Content-Length")
 			resp.ContentLength = -1
 			resp.Uncompressed = true
+		} else if rc.addedZstd && strings.EqualFold(resp.Header.Get("Content-Encoding"), "zstd") {
+			resp.Body = &zstdReader{body: body}
+			resp.Header.Del("Content-Encoding")
+			resp.Header.Del("Content-Length")
+			resp.ContentLength = -1
+			resp.Uncompressed = true
 		}
 
 		select {
@@ -2051,6 +2065,10 @@ type requestAndChan struct {
 	// set it, only then do we transparently decode the gzip.
 	addedGzip bool
 
+	// whether the Transport also added zstd to the Accept-Encoding
+	// header, and so transparently decodes zstd.
+	addedZstd bool
+
 	// Optional blocking chan for Expect: 100-continue (for send).
 	// If the request has an "Expect: 100-continue" header and
 	// the server responds 100 Continue, readLoop send a value
@@ -2123,7 +2141,7 @@ func (pc *persistConn) roundTrip(req *transportRequest) (resp *Response, err err
 	// own value for Accept-Encoding. We only attempt to
 	// uncompress the gzip stream if we were the layer that
 	// requested it.
-	requestedGzip := false
+	requestedGzip, requestedZstd := false, false
 	if !pc.t.DisableCompression &&
 		req.Header.Get("Accept-Encoding") == "" &&
 		req.Header.Get("Range") == "" &&
@@ -2141,7 +2159,12 @@ func (pc *persistConn) roundTrip(req *transportRequest) (resp *Response, err err
 		// auto-decoding a portion of a gzipped document will just fail
 		// anyway. See https://golang.org/issue/8923
 		requestedGzip = true
-		req.extraHeaders().Set("Accept-Encoding", "gzip")
+		if pc.t.AcceptZstd {
+			requestedZstd = true
+			req.extraHeaders().Set("Accept-Encoding", "zstd, gzip")
+		} else {
+			req.extraHeaders().Set("Accept-Encoding", "gzip")
+		}
 	}
 
 	var continueCh chan struct{}
@@ -2176,6 +2199,7 @@ func (pc *persistConn) roundTrip(req *transportRequest) (resp *Response, err err
 		req:        req.Request,
 		ch:         resc,
 		addedGzip:  requestedGzip,
+		addedZstd:  requestedZstd,
 		continueCh: continueCh,
 		callerGone: gone,
 	}
@@ -2411,6 +2435,34 @@ func (gz *gzipReader) Close() error {
 	return gz.body.Close()
 }
 
+// zstdReader wraps a response body so it can decode a
+// zstd stream, like gzipReader.
+type zstdReader struct {
+	body *bodyEOFSignal // underlying HTTP/1 response body framing
+	zr   *zstd.Reader   // lazily-initialized zstd reader
+}
+
+func (zs *zstdReader) Read(p []byte) (n int, err error) {
+	if zs.zr == nil {
+		zs.zr = zstd.NewReader(zs.body)
+	}
+
+	zs.body.mu.Lock()
+	if zs.body.closed {
+		err = errReadOnClosedResBody
+	}
+	zs.body.mu.Unlock()
+
+	if err != nil {
+		return 0, err
+	}
+	return zs.zr.Read(p)
+}
+
+func (zs *zstdReader) Close() error {
+	return zs.body.Close()
+}
+
 type readerAndCloser struct {
 	io.Reader
 	io.Closer
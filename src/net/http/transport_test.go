@@ -13,6 +13,7 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"compress/zstd"
 	"context"
 	"crypto/rand"
 	"crypto/tls"
@@ -1430,6 +1431,43 @@ func TestTransportGzipShort(t *testing.T) {
 	}
 }
 
+func TestTransportZstd(t *testing.T) {
+	setParallel(t)
+	defer afterTest(t)
+	const testString = "The test string aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	ts := httptest.NewServer(HandlerFunc(func(rw ResponseWriter, req *Request) {
+		if g, e := req.Header.Get("Accept-Encoding"), "zstd, gzip"; g != e {
+			t.Errorf("Accept-Encoding = %q, want %q", g, e)
+		}
+		rw.Header().Set("Content-Encoding", "zstd")
+		zw := zstd.NewWriter(rw)
+		io.WriteString(zw, testString)
+		zw.Close()
+	}))
+	defer ts.Close()
+
+	c := ts.Client()
+	c.Transport.(*Transport).AcceptZstd = true
+	res, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != testString {
+		t.Errorf("body = %q; want %q", body, testString)
+	}
+	if !res.Uncompressed {
+		t.Error("Response.Uncompressed = false; want true")
+	}
+	if g := res.Header.Get("Content-Encoding"); g != "" {
+		t.Errorf("Content-Encoding = %q; want none", g)
+	}
+}
+
 // Wait until number of goroutines is no greater than nmax, or time out.
 func waitNumGoroutine(nmax int) int {
 	nfinal := runtime.NumGoroutine()
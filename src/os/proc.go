@@ -59,12 +59,13 @@ func Getgroups() ([]int, error) {
 //
 // For portability, the status code should be in the range [0, 125].
 func Exit(code int) {
-	if code == 0 {
-		// Give race detector a chance to fail the program.
-		// Racy programs do not have the right to finish successfully.
-		runtime_beforeExit()
-	}
+	// Run the exit hooks registered with the runtime, such as the one
+	// that writes coverage data for binaries built with "go build -cover".
+	// If code is zero, this also gives the race detector a chance to
+	// fail the program: racy programs do not have the right to finish
+	// successfully.
+	runtime_beforeExit(code)
 	syscall.Exit(code)
 }
 
-func runtime_beforeExit() // implemented in runtime
+func runtime_beforeExit(exitCode int) // implemented in runtime
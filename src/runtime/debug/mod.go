@@ -5,6 +5,9 @@
 package debug
 
 import (
+	"bytes"
+	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -15,15 +18,42 @@ var modinfo string
 // in the running binary. The information is available only
 // in binaries built with module support.
 func ReadBuildInfo() (info *BuildInfo, ok bool) {
-	return readBuildInfo(modinfo)
+	if len(modinfo) < 32 {
+		return nil, false
+	}
+	info, err := ParseBuildInfo(modinfo[16 : len(modinfo)-16])
+	if err != nil {
+		return nil, false
+	}
+	return info, true
 }
 
-// BuildInfo represents the build information read from
-// the running binary.
+// BuildInfo represents the build information read from a Go binary.
 type BuildInfo struct {
-	Path string    // The main package path
-	Main Module    // The main module information
-	Deps []*Module // Module dependencies
+	GoVersion string    // Version of Go that produced this binary.
+	Path      string    // The main package path
+	Main      Module    // The main module information
+	Deps      []*Module // Module dependencies
+
+	// Settings describes the build flags and environment used to
+	// build the binary, and the state of the version control
+	// checkout holding the main module, if any. Keys include:
+	//
+	//	-buildmode, -compiler, -gcflags, -ldflags, -tags, ...
+	//		the build flags given on the command line
+	//	CGO_ENABLED, CGO_CFLAGS, ...
+	//		the cgo settings
+	//	GOARCH, GOOS, GOARM, GO386, ...
+	//		the target architecture and operating system
+	//	vcs
+	//		the version control system, such as "git"
+	//	vcs.revision
+	//		the revision identifier of the current commit
+	//	vcs.time
+	//		the commit time, in RFC3339 format
+	//	vcs.modified
+	//		"true" if the checkout had uncommitted changes
+	Settings []BuildSetting
 }
 
 // Module represents a module.
@@ -34,72 +64,169 @@ type Module struct {
 	Replace *Module // replaced by this module
 }
 
-func readBuildInfo(data string) (*BuildInfo, bool) {
-	if len(data) < 32 {
-		return nil, false
+// A BuildSetting is a key/value pair describing one setting
+// that influenced the build.
+type BuildSetting struct {
+	// Key and Value describe the build setting.
+	// Key must not contain an equals sign, space, tab, or newline.
+	// Value must not contain newlines ('\n').
+	Key, Value string
+}
+
+// String returns the build information in the line-oriented
+// format parsed by ParseBuildInfo.
+func (bi *BuildInfo) String() string {
+	buf := new(bytes.Buffer)
+	if bi.GoVersion != "" {
+		fmt.Fprintf(buf, "go\t%s\n", bi.GoVersion)
+	}
+	if bi.Path != "" {
+		fmt.Fprintf(buf, "path\t%s\n", bi.Path)
+	}
+	var formatMod func(string, Module)
+	formatMod = func(word string, m Module) {
+		buf.WriteString(word)
+		buf.WriteByte('\t')
+		buf.WriteString(m.Path)
+		buf.WriteByte('\t')
+		buf.WriteString(m.Version)
+		if m.Replace == nil {
+			if m.Sum != "" {
+				buf.WriteByte('\t')
+				buf.WriteString(m.Sum)
+			}
+			buf.WriteByte('\n')
+		} else {
+			buf.WriteByte('\n')
+			formatMod("=>", *m.Replace)
+		}
+	}
+	if bi.Main != (Module{}) {
+		formatMod("mod", bi.Main)
+	}
+	for _, dep := range bi.Deps {
+		formatMod("dep", *dep)
+	}
+	for _, s := range bi.Settings {
+		value := s.Value
+		if quoteValue(value) {
+			value = strconv.Quote(value)
+		}
+		fmt.Fprintf(buf, "build\t%s=%s\n", s.Key, value)
 	}
-	data = data[16 : len(data)-16]
+	return buf.String()
+}
+
+// quoteValue reports whether value must be quoted
+// to be written on a single "build" line.
+func quoteValue(value string) bool {
+	return strings.ContainsAny(value, " \t\r\n\"`")
+}
+
+// ParseBuildInfo parses the string returned by BuildInfo.String,
+// restoring the original BuildInfo.
+func ParseBuildInfo(data string) (bi *BuildInfo, err error) {
+	lineNum := 1
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("could not parse Go build info: line %d: %v", lineNum, err)
+		}
+	}()
 
 	const (
-		pathLine = "path\t"
-		modLine  = "mod\t"
-		depLine  = "dep\t"
-		repLine  = "=>\t"
+		goLine    = "go\t"
+		pathLine  = "path\t"
+		modLine   = "mod\t"
+		depLine   = "dep\t"
+		repLine   = "=>\t"
+		buildLine = "build\t"
 	)
 
-	info := &BuildInfo{}
+	readModuleLine := func(elem []string) (Module, error) {
+		if len(elem) != 2 && len(elem) != 3 {
+			return Module{}, fmt.Errorf("expected 2 or 3 columns; got %d", len(elem))
+		}
+		sum := ""
+		if len(elem) == 3 {
+			sum = elem[2]
+		}
+		return Module{
+			Path:    elem[0],
+			Version: elem[1],
+			Sum:     sum,
+		}, nil
+	}
 
-	var line string
-	// Reverse of cmd/go/internal/modload.PackageBuildInfo
+	bi = new(BuildInfo)
+	var (
+		last *Module
+		line string
+		ok   bool
+	)
+	// Reverse of BuildInfo.String.
 	for len(data) > 0 {
-		i := strings.IndexByte(data, '\n')
-		if i < 0 {
+		line, data, ok = cut(data, "\n")
+		if !ok {
 			break
 		}
-		line, data = data[:i], data[i+1:]
 		switch {
+		case strings.HasPrefix(line, goLine):
+			bi.GoVersion = line[len(goLine):]
 		case strings.HasPrefix(line, pathLine):
-			elem := line[len(pathLine):]
-			info.Path = elem
+			bi.Path = line[len(pathLine):]
 		case strings.HasPrefix(line, modLine):
 			elem := strings.Split(line[len(modLine):], "\t")
-			if len(elem) != 3 {
-				return nil, false
-			}
-			info.Main = Module{
-				Path:    elem[0],
-				Version: elem[1],
-				Sum:     elem[2],
+			last = &bi.Main
+			*last, err = readModuleLine(elem)
+			if err != nil {
+				return nil, err
 			}
 		case strings.HasPrefix(line, depLine):
 			elem := strings.Split(line[len(depLine):], "\t")
-			if len(elem) != 2 && len(elem) != 3 {
-				return nil, false
-			}
-			sum := ""
-			if len(elem) == 3 {
-				sum = elem[2]
+			last = new(Module)
+			bi.Deps = append(bi.Deps, last)
+			*last, err = readModuleLine(elem)
+			if err != nil {
+				return nil, err
 			}
-			info.Deps = append(info.Deps, &Module{
-				Path:    elem[0],
-				Version: elem[1],
-				Sum:     sum,
-			})
 		case strings.HasPrefix(line, repLine):
 			elem := strings.Split(line[len(repLine):], "\t")
-			if len(elem) != 3 {
-				return nil, false
+			if last == nil {
+				return nil, fmt.Errorf("replacement with no module on previous line")
+			}
+			last.Replace = new(Module)
+			*last.Replace, err = readModuleLine(elem)
+			if err != nil {
+				return nil, err
+			}
+			last = nil
+		case strings.HasPrefix(line, buildLine):
+			kv := line[len(buildLine):]
+			key, value, ok := cut(kv, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid build line")
 			}
-			last := len(info.Deps) - 1
-			if last < 0 {
-				return nil, false
+			if key == "" {
+				return nil, fmt.Errorf("empty key")
 			}
-			info.Deps[last].Replace = &Module{
-				Path:    elem[0],
-				Version: elem[1],
-				Sum:     elem[2],
+			if strings.HasPrefix(value, `"`) {
+				value, err = strconv.Unquote(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid quoted value: %v", err)
+				}
 			}
+			bi.Settings = append(bi.Settings, BuildSetting{Key: key, Value: value})
 		}
+		lineNum++
 	}
-	return info, true
+	return bi, nil
+}
+
+// cut slices s around the first instance of sep,
+// returning the text before and after sep.
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
 }
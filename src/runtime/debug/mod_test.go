@@ -0,0 +1,67 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package debug_test
+
+import (
+	"reflect"
+	. "runtime/debug"
+	"strings"
+	"testing"
+)
+
+func TestParseBuildInfoRoundTrip(t *testing.T) {
+	for _, bi := range []*BuildInfo{
+		{},
+		{
+			GoVersion: "go1.13",
+			Path:      "example.com/m/cmd/hello",
+			Main:      Module{Path: "example.com/m", Version: "(devel)"},
+		},
+		{
+			GoVersion: "go1.13",
+			Path:      "example.com/m",
+			Main:      Module{Path: "example.com/m", Version: "v1.2.3", Sum: "h1:abc="},
+			Deps: []*Module{
+				{Path: "example.com/dep", Version: "v0.1.0", Sum: "h1:def="},
+				{Path: "example.com/rep", Version: "v1.0.0", Replace: &Module{Path: "../rep", Version: ""}},
+			},
+			Settings: []BuildSetting{
+				{Key: "-compiler", Value: "gc"},
+				{Key: "-ldflags", Value: `-X main.version="1.0" -s`},
+				{Key: "-tags", Value: "netgo,osusergo"},
+				{Key: "CGO_ENABLED", Value: "0"},
+				{Key: "vcs.revision", Value: "0123456789abcdef"},
+				{Key: "vcs.modified", Value: "true"},
+			},
+		},
+	} {
+		s := bi.String()
+		got, err := ParseBuildInfo(s)
+		if err != nil {
+			t.Errorf("ParseBuildInfo(%q): %v", s, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, bi) {
+			t.Errorf("ParseBuildInfo(%q):\nhave %#v\nwant %#v", s, got, bi)
+		}
+	}
+}
+
+func TestParseBuildInfoErrors(t *testing.T) {
+	for _, tt := range []struct {
+		in, err string
+	}{
+		{"mod\texample.com/m\n", "line 1: expected 2 or 3 columns; got 1"},
+		{"path\tp\n=>\texample.com/m\tv1.0.0\th1:abc=\n", "line 2: replacement with no module on previous line"},
+		{"build\tnovalue\n", "line 1: invalid build line"},
+		{"build\t=x\n", "line 1: empty key"},
+		{"build\t-ldflags=\"-s\n", "line 1: invalid quoted value"},
+	} {
+		_, err := ParseBuildInfo(tt.in)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("ParseBuildInfo(%q): error %v, want %q", tt.in, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import _ "unsafe" // for go:linkname
+
+// An exitHook is a function to be run when the program exits,
+// either by returning from main.main or by calling os.Exit.
+type exitHook struct {
+	f                func() // function to run
+	runOnNonZeroExit bool   // run even if the exit status is non-zero
+}
+
+// exitHooks holds the hooks registered with addExitHook.
+// Hooks are only registered during package initialization,
+// before any user goroutines are started, so no locking is needed.
+var exitHooks struct {
+	hooks   []exitHook
+	running bool
+}
+
+// addExitHook registers f to be run when the program exits.
+// If runOnNonZeroExit is false, f is only run when the program
+// exits with status zero.
+// Exit hooks run in the reverse order of registration.
+// They must not panic, and a call to os.Exit from a hook
+// does not run the remaining hooks again.
+func addExitHook(f func(), runOnNonZeroExit bool) {
+	exitHooks.hooks = append(exitHooks.hooks, exitHook{f: f, runOnNonZeroExit: runOnNonZeroExit})
+}
+
+// runExitHooks runs the registered exit hooks for a program
+// exiting with the given status.
+func runExitHooks(exitCode int) {
+	if exitHooks.running {
+		// A hook called os.Exit; don't start over.
+		return
+	}
+	exitHooks.running = true
+	for i := len(exitHooks.hooks) - 1; i >= 0; i-- {
+		h := exitHooks.hooks[i]
+		if exitCode != 0 && !h.runOnNonZeroExit {
+			continue
+		}
+		h.f()
+	}
+	exitHooks.hooks = nil
+	exitHooks.running = false
+}
+
+// coverage_addExitHook is used by the coverage runtime support
+// to write counter data when a program built with "go build -cover" exits.
+//go:linkname coverage_addExitHook internal/coverage.runtime_addExitHook
+func coverage_addExitHook(f func(), runOnNonZeroExit bool) {
+	addExitHook(f, runOnNonZeroExit)
+}
@@ -283,7 +283,7 @@ func TestIntString(t *testing.T) {
 	// Non-escaping result of intstring.
 	s := ""
 	for i := 0; i < 4; i++ {
-		s += string(i+'0') + string(i+'0'+1)
+		s += string(rune(i+'0')) + string(rune(i+'0'+1))
 	}
 	if want := "01122334"; s != want {
 		t.Fatalf("want '%v', got '%v'", want, s)
@@ -292,7 +292,7 @@ func TestIntString(t *testing.T) {
 	// Escaping result of intstring.
 	var a [4]string
 	for i := 0; i < 4; i++ {
-		a[i] = string(i + '0')
+		a[i] = string(rune(i + '0'))
 	}
 	s = a[0] + a[1] + a[2] + a[3]
 	if want := "0123"; s != want {
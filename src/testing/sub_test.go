@@ -7,6 +7,8 @@ package testing
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"regexp"
 	"runtime"
 	"strings"
@@ -434,6 +436,44 @@ func TestTRun(t *T) {
 			<-ch
 			t.Errorf("error")
 		},
+	}, {
+		desc:   "attributes, chatty",
+		ok:     true,
+		chatty: true,
+		output: `
+=== RUN   attributes, chatty
+=== ATTR  attributes, chatty key value
+=== RUN   attributes, chatty/sub
+=== ATTR  attributes, chatty/sub key2 a value with spaces
+--- PASS: attributes, chatty (N.NNs)
+    --- PASS: attributes, chatty/sub (N.NNs)`,
+		f: func(t *T) {
+			t.Attr("key", "value")
+			t.Run("sub", func(t *T) {
+				t.Attr("key2", "a value with spaces")
+			})
+		},
+	}, {
+		desc: "attributes, not chatty",
+		ok:   true,
+		f: func(t *T) {
+			t.Attr("key", "value")
+		},
+	}, {
+		desc: "invalid attributes",
+		ok:   false,
+		output: `
+--- FAIL: invalid attributes (N.NNs)
+    sub_test.go:NNN: disallowed whitespace in attribute key ""
+    sub_test.go:NNN: disallowed whitespace in attribute key "a key"
+    sub_test.go:NNN: disallowed newline in attribute value "a\nvalue"
+    sub_test.go:NNN: disallowed newline in attribute value "a\rvalue"`,
+		f: func(t *T) {
+			t.Attr("", "value")
+			t.Attr("a key", "value")
+			t.Attr("key", "a\nvalue")
+			t.Attr("key", "a\rvalue")
+		},
 	}}
 	for _, tc := range testCases {
 		ctx := newTestContext(tc.maxPar, newMatcher(regexp.MatchString, "", ""))
@@ -615,6 +655,90 @@ func TestBRun(t *T) {
 	}
 }
 
+func TestArtifactDir(t *T) {
+	run := func(chatty bool, f func(t *T)) string {
+		ctx := newTestContext(1, newMatcher(regexp.MatchString, "", ""))
+		buf := &bytes.Buffer{}
+		root := &T{
+			common: common{
+				signal: make(chan bool),
+				name:   "Test",
+				w:      buf,
+				chatty: chatty,
+			},
+			context: ctx,
+		}
+		if !root.Run("artifacts", f) {
+			t.Errorf("test failed:\n%s", buf)
+		}
+		ctx.release()
+		return buf.String()
+	}
+	isDir := func(dir string) bool {
+		fi, err := os.Stat(dir)
+		return err == nil && fi.IsDir()
+	}
+
+	// Without -test.artifacts, the directories are temporary.
+	var dir, subDir string
+	run(false, func(t2 *T) {
+		dir = t2.ArtifactDir()
+		if again := t2.ArtifactDir(); again != dir {
+			t.Errorf("second call to ArtifactDir returned %q, first returned %q", again, dir)
+		}
+		t2.Run("sub", func(t3 *T) {
+			subDir = t3.ArtifactDir()
+		})
+	})
+	if dir == subDir {
+		t.Errorf("test and subtest share artifact directory %q", dir)
+	}
+	for _, d := range []string{dir, subDir} {
+		if !isDir(d) {
+			t.Errorf("artifact directory %q does not exist", d)
+		}
+		if !strings.HasPrefix(d, os.TempDir()+string(os.PathSeparator)) {
+			t.Errorf("artifact directory %q is not in %q", d, os.TempDir())
+		}
+	}
+	removeTempArtifacts()
+	if isDir(dir) || isDir(subDir) {
+		t.Errorf("removeTempArtifacts did not remove %q and %q", dir, subDir)
+	}
+
+	// With -test.artifacts, they are kept in the output directory.
+	out, err := ioutil.TempDir("", "TestArtifactDir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(out)
+	defer func(keep bool, dir, importPath string) {
+		*artifacts, *outputDir, testImportPath = keep, dir, importPath
+	}(*artifacts, *outputDir, testImportPath)
+	*artifacts, *outputDir, testImportPath = true, out, "example.com/pkg"
+
+	log := run(true, func(t2 *T) {
+		t2.Run("sub", func(t3 *T) {
+			subDir = t3.ArtifactDir()
+		})
+	})
+	parent := out + string(os.PathSeparator) + "_artifacts" + string(os.PathSeparator) +
+		"example.com" + string(os.PathSeparator) + "pkg" + string(os.PathSeparator)
+	if !strings.HasPrefix(subDir, parent+"artifacts_sub-") {
+		t.Errorf("ArtifactDir returned %q, want a directory in %q beginning with artifacts_sub-", subDir, parent)
+	}
+	if !isDir(subDir) {
+		t.Errorf("artifact directory %q does not exist", subDir)
+	}
+	if want := "=== ARTIFACTS artifacts/sub " + subDir + "\n"; !strings.Contains(log, want) {
+		t.Errorf("test log does not contain %q:\n%s", want, log)
+	}
+	removeTempArtifacts()
+	if !isDir(subDir) {
+		t.Errorf("removeTempArtifacts removed %q", subDir)
+	}
+}
+
 func makeRegexp(s string) string {
 	s = regexp.QuoteMeta(s)
 	s = strings.ReplaceAll(s, ":NNN:", `:\d\d\d:`)
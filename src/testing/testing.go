@@ -247,6 +247,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+	"unicode"
 )
 
 var (
@@ -266,6 +267,11 @@ var (
 	// the "go test" command is run.
 	outputDir = flag.String("test.outputdir", "", "write profiles to `dir`")
 
+	// The artifacts flag requests that the directories returned by
+	// ArtifactDir be kept in the output directory after the test binary
+	// exits, rather than created as temporary directories.
+	artifacts = flag.Bool("test.artifacts", false, "keep test artifacts in test.outputdir")
+
 	// Report as tests are run; default is silent for success.
 	chatty               = flag.Bool("test.v", false, "verbose: print additional output")
 	count                = flag.Uint("test.count", 1, "run tests and benchmarks `n` times")
@@ -287,6 +293,11 @@ var (
 
 	haveExamples bool // are there examples?
 
+	testImportPath string // import path of the package under test
+
+	tempArtifactsMu sync.Mutex
+	tempArtifacts   []string // temporary artifact directories to remove on exit
+
 	cpuList     []int
 	testlogFile *os.File
 
@@ -324,6 +335,9 @@ type common struct {
 	barrier  chan bool // To signal parallel subtests they may start.
 	signal   chan bool // To signal a test is done.
 	sub      []*T      // Queue of subtests to be run in parallel.
+
+	artifactDir    string // Directory returned by ArtifactDir, once created.
+	artifactDirErr error
 }
 
 // Short reports whether the -test.short flag is set.
@@ -511,6 +525,8 @@ type TB interface {
 	Skipf(format string, args ...interface{})
 	Skipped() bool
 	Helper()
+	Attr(key, value string)
+	ArtifactDir() string
 
 	// A private method to prevent users implementing the
 	// interface and so future additions to it will not
@@ -723,6 +739,142 @@ func (c *common) Helper() {
 	c.helpers[callerName(1)] = struct{}{}
 }
 
+// Attr emits a test attribute associated with this test.
+//
+// The key must not contain whitespace.
+// The value must not contain newlines or carriage returns.
+//
+// The meaning of different attribute keys is left up to
+// continuous integration systems and test frameworks.
+//
+// Test attributes are emitted immediately in the test log,
+// but they are intended to be treated as unordered.
+// They are only reported when the test runs with -test.v
+// (as with go test -v or go test -json).
+func (c *common) Attr(key, value string) {
+	c.Helper()
+	if key == "" || strings.IndexFunc(key, unicode.IsSpace) >= 0 {
+		c.Errorf("disallowed whitespace in attribute key %q", key)
+		return
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		c.Errorf("disallowed newline in attribute value %q", value)
+		return
+	}
+	c.printChatty("=== ATTR  %s %v %v\n", c.name, key, value)
+}
+
+// ArtifactDir returns a directory in which the test should store
+// output files, such as logs, screenshots or generated data that
+// help diagnose a failure.
+//
+// When the -test.artifacts flag is set (as with go test -artifacts),
+// the directory is created under the output directory, in
+// _artifacts/<import path>/, and is kept after the test binary exits;
+// a verbose test log reports its location in an "=== ARTIFACTS" line.
+// Otherwise, ArtifactDir returns a temporary directory that is
+// removed when the test binary exits.
+//
+// Each test or subtest has its own artifact directory.
+// Repeated calls to ArtifactDir in the same test or subtest
+// return the same directory.
+func (c *common) ArtifactDir() string {
+	c.Helper()
+	c.mu.Lock()
+	if c.artifactDir == "" && c.artifactDirErr == nil {
+		c.artifactDir, c.artifactDirErr = c.makeArtifactDir()
+		if c.artifactDirErr == nil && *artifacts {
+			c.mu.Unlock()
+			c.printChatty("=== ARTIFACTS %s %s\n", c.name, c.artifactDir)
+			c.mu.Lock()
+		}
+	}
+	dir, err := c.artifactDir, c.artifactDirErr
+	c.mu.Unlock()
+	if err != nil {
+		c.Fatalf("ArtifactDir: %v", err)
+	}
+	return dir
+}
+
+// makeArtifactDir creates the artifact directory for c.
+func (c *common) makeArtifactDir() (string, error) {
+	// Subtest names contain slashes, and names may contain
+	// other characters that are not safe in file names.
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' || !unicode.IsPrint(r) {
+			return '_'
+		}
+		return r
+	}, c.name)
+
+	if !*artifacts {
+		dir, err := mkdirUnique(os.TempDir(), name+"-artifacts-")
+		if err == nil {
+			tempArtifactsMu.Lock()
+			tempArtifacts = append(tempArtifacts, dir)
+			tempArtifactsMu.Unlock()
+		}
+		return dir, err
+	}
+
+	parent := toOutputDir("_artifacts" + string(os.PathSeparator) + strings.Replace(testImportPath, "/", string(os.PathSeparator), -1))
+	if err := os.MkdirAll(parent, 0777); err != nil {
+		return "", err
+	}
+	// The directory name is unique so that repeated runs,
+	// as with -test.count, do not share a directory.
+	return mkdirUnique(parent, name+"-")
+}
+
+// artifactDirSeq numbers the directories made by mkdirUnique.
+var artifactDirSeq uint32
+
+// mkdirUnique creates a new directory in parent whose name begins
+// with prefix and is unique among processes, and returns its name.
+// It does the work of ioutil.TempDir, which testing cannot import:
+// the tests of io/ioutil import testing.
+func mkdirUnique(parent, prefix string) (string, error) {
+	pid := strconv.Itoa(os.Getpid())
+	for {
+		seq := strconv.FormatUint(uint64(atomic.AddUint32(&artifactDirSeq, 1)), 10)
+		dir := parent + string(os.PathSeparator) + prefix + pid + "-" + seq
+		err := os.Mkdir(dir, 0777)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		return dir, nil
+	}
+}
+
+// printChatty prints a line directly to the root test's writer,
+// so that there is no delay, if the test is running in verbose mode.
+func (c *common) printChatty(format string, args ...interface{}) {
+	if !c.chatty {
+		return
+	}
+	root := c
+	for ; root.parent != nil; root = root.parent {
+	}
+	root.mu.Lock()
+	fmt.Fprintf(root.w, format, args...)
+	root.mu.Unlock()
+}
+
+// removeTempArtifacts removes the temporary directories
+// returned by ArtifactDir when -test.artifacts is not set.
+func removeTempArtifacts() {
+	tempArtifactsMu.Lock()
+	defer tempArtifactsMu.Unlock()
+	for _, dir := range tempArtifacts {
+		os.RemoveAll(dir)
+	}
+	tempArtifacts = nil
+}
+
 // callerName gives the function name (qualified with a package path)
 // for the caller after skip frames (where 0 means the current function).
 func callerName(skip int) string {
@@ -1052,6 +1204,8 @@ func (m *M) Run() int {
 		flag.Parse()
 	}
 
+	testImportPath = m.deps.ImportPath()
+
 	if *parallel < 1 {
 		fmt.Fprintln(os.Stderr, "testing: -parallel can only be given a positive integer")
 		flag.Usage()
@@ -1235,6 +1389,7 @@ func (m *M) before() {
 func (m *M) after() {
 	m.afterOnce.Do(func() {
 		m.writeProfiles()
+		removeTempArtifacts()
 	})
 }
 